@@ -10,6 +10,7 @@ import (
 	"github.com/kaspanet/kaspad/infrastructure/config"
 	"github.com/kaspanet/kaspad/infrastructure/db/database"
 	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/memorydb"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
 	"github.com/kaspanet/kaspad/infrastructure/os/execenv"
 	"github.com/kaspanet/kaspad/infrastructure/os/limits"
@@ -18,6 +19,7 @@ import (
 	"github.com/kaspanet/kaspad/util/panics"
 	"github.com/kaspanet/kaspad/util/profiling"
 	"github.com/kaspanet/kaspad/version"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -122,6 +124,14 @@ func (app *kaspadApp) main(startedChan chan<- struct{}) error {
 		return nil
 	}
 
+	if app.cfg.ExportSnapshot != "" || app.cfg.ImportSnapshot != "" {
+		return runSnapshotCommand(app.cfg, databaseContext)
+	}
+
+	if app.cfg.VerifyDB {
+		return runVerifyDBCommand(app.cfg, databaseContext)
+	}
+
 	// Create componentManager and start it.
 	componentManager, err := NewComponentManager(app.cfg, databaseContext, interrupt)
 	if err != nil {
@@ -172,15 +182,36 @@ func removeDatabase(cfg *config.Config) error {
 }
 
 func openDB(cfg *config.Config) (database.Database, error) {
+	if cfg.DbType == config.DbTypeMemory {
+		log.Infof("Using an in-memory database. Its data will be lost once kaspad shuts down")
+		return memorydb.NewMemoryDB(), nil
+	}
+
 	dbPath := databasePath(cfg)
 
+	// Verifying the database without repairing it must not change it in any way,
+	// so it isn't created if it doesn't exist yet
+	isReadOnly := cfg.VerifyDB && !cfg.VerifyDBRepair
+	if isReadOnly {
+		_, err := os.Stat(versionFilePath(dbPath))
+		if err != nil {
+			return nil, errors.Wrapf(err, "there's no database to verify in '%s'", dbPath)
+		}
+	}
+
 	err := checkDatabaseVersion(dbPath)
 	if err != nil {
 		return nil, err
 	}
 
-	log.Infof("Loading database from '%s'", dbPath)
-	db, err := ldb.NewLevelDB(dbPath, leveldbCacheSizeMiB)
+	var db *ldb.LevelDB
+	if isReadOnly {
+		log.Infof("Loading database from '%s' as read-only", dbPath)
+		db, err = ldb.NewReadOnlyLevelDB(dbPath, leveldbCacheSizeMiB)
+	} else {
+		log.Infof("Loading database from '%s'", dbPath)
+		db, err = ldb.NewLevelDB(dbPath, leveldbCacheSizeMiB)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +163,26 @@ const (
 	CmdGetMempoolEntriesByAddressesResponseMessage
 	CmdGetCoinSupplyRequestMessage
 	CmdGetCoinSupplyResponseMessage
+	CmdGetTransactionRequestMessage
+	CmdGetTransactionResponseMessage
+	CmdGetTransactionAcceptanceInfoRequestMessage
+	CmdGetTransactionAcceptanceInfoResponseMessage
+	CmdSubmitTransactionReplacementRequestMessage
+	CmdSubmitTransactionReplacementResponseMessage
+	CmdGetFeeEstimateRequestMessage
+	CmdGetFeeEstimateResponseMessage
+	CmdSaveMempoolRequestMessage
+	CmdSaveMempoolResponseMessage
+	CmdLoadMempoolRequestMessage
+	CmdLoadMempoolResponseMessage
+	CmdNotifyMempoolChangedRequestMessage
+	CmdNotifyMempoolChangedResponseMessage
+	CmdMempoolChangedNotificationMessage
+	CmdGetTransactionsByAddressRequestMessage
+	CmdGetTransactionsByAddressResponseMessage
+	CmdStreamUTXOsByAddressesRequestMessage
+	CmdStreamUTXOsByAddressesResponseMessage
+	CmdUTXOsByAddressesChunkNotificationMessage
 )
 
 // ProtocolMessageCommandToString maps all MessageCommands to their string representation
@@ -300,6 +320,26 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdGetMempoolEntriesByAddressesResponseMessage:                "GetMempoolEntriesByAddressesResponse",
 	CmdGetCoinSupplyRequestMessage:                                "GetCoinSupplyRequest",
 	CmdGetCoinSupplyResponseMessage:                               "GetCoinSupplyResponse",
+	CmdGetTransactionRequestMessage:                               "GetTransactionRequest",
+	CmdGetTransactionResponseMessage:                              "GetTransactionResponse",
+	CmdGetTransactionAcceptanceInfoRequestMessage:                 "GetTransactionAcceptanceInfoRequest",
+	CmdGetTransactionAcceptanceInfoResponseMessage:                "GetTransactionAcceptanceInfoResponse",
+	CmdSubmitTransactionReplacementRequestMessage:                 "SubmitTransactionReplacementRequest",
+	CmdSubmitTransactionReplacementResponseMessage:                "SubmitTransactionReplacementResponse",
+	CmdGetFeeEstimateRequestMessage:                               "GetFeeEstimateRequest",
+	CmdGetFeeEstimateResponseMessage:                              "GetFeeEstimateResponse",
+	CmdSaveMempoolRequestMessage:                                  "SaveMempoolRequest",
+	CmdSaveMempoolResponseMessage:                                 "SaveMempoolResponse",
+	CmdLoadMempoolRequestMessage:                                  "LoadMempoolRequest",
+	CmdLoadMempoolResponseMessage:                                 "LoadMempoolResponse",
+	CmdNotifyMempoolChangedRequestMessage:                         "NotifyMempoolChangedRequest",
+	CmdNotifyMempoolChangedResponseMessage:                        "NotifyMempoolChangedResponse",
+	CmdMempoolChangedNotificationMessage:                          "MempoolChangedNotification",
+	CmdGetTransactionsByAddressRequestMessage:                     "GetTransactionsByAddressRequest",
+	CmdGetTransactionsByAddressResponseMessage:                    "GetTransactionsByAddressResponse",
+	CmdStreamUTXOsByAddressesRequestMessage:                       "StreamUTXOsByAddressesRequest",
+	CmdStreamUTXOsByAddressesResponseMessage:                      "StreamUTXOsByAddressesResponse",
+	CmdUTXOsByAddressesChunkNotificationMessage:                   "UTXOsByAddressesChunkNotification",
 }
 
 // Message is an interface that describes a kaspa message. A type that
@@ -30,6 +30,9 @@ type GetBlockDAGInfoResponseMessage struct {
 	PruningPointHash    string
 	VirtualDAAScore     uint64
 
+	RetentionRootHash     string
+	RetentionRootDAAScore uint64
+
 	Error *RPCError
 }
 
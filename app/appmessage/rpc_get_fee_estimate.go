@@ -0,0 +1,53 @@
+package appmessage
+
+// GetFeeEstimateRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetFeeEstimateRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetFeeEstimateRequestMessage) Command() MessageCommand {
+	return CmdGetFeeEstimateRequestMessage
+}
+
+// NewGetFeeEstimateRequestMessage returns a instance of the message
+func NewGetFeeEstimateRequestMessage() *GetFeeEstimateRequestMessage {
+	return &GetFeeEstimateRequestMessage{}
+}
+
+// RPCFeeRateBucket is a fee rate, in sompi per gram of mass, alongside the
+// expected amount of seconds until a transaction paying it is included in a block
+type RPCFeeRateBucket struct {
+	FeeRate          float64
+	EstimatedSeconds float64
+}
+
+// RPCFeeEstimate is the set of fee rates a transaction may pay depending
+// on how soon it needs to be included in a block
+type RPCFeeEstimate struct {
+	PriorityBucket *RPCFeeRateBucket
+	NormalBucket   *RPCFeeRateBucket
+	LowBucket      *RPCFeeRateBucket
+}
+
+// GetFeeEstimateResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetFeeEstimateResponseMessage struct {
+	baseMessage
+	Estimate *RPCFeeEstimate
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetFeeEstimateResponseMessage) Command() MessageCommand {
+	return CmdGetFeeEstimateResponseMessage
+}
+
+// NewGetFeeEstimateResponseMessage returns a instance of the message
+func NewGetFeeEstimateResponseMessage(estimate *RPCFeeEstimate) *GetFeeEstimateResponseMessage {
+	return &GetFeeEstimateResponseMessage{
+		Estimate: estimate,
+	}
+}
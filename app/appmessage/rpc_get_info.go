@@ -26,6 +26,13 @@ type GetInfoResponseMessage struct {
 	IsUtxoIndexed bool
 	IsSynced      bool
 
+	// MempoolMass is the total mass of the transactions in the mempool, excluding orphans
+	MempoolMass uint64
+
+	// MempoolMinimumFeeRate is the lowest fee rate, in sompi per gram, a transaction
+	// currently must pay in order to enter the mempool
+	MempoolMinimumFeeRate float64
+
 	Error *RPCError
 }
 
@@ -35,12 +42,16 @@ func (msg *GetInfoResponseMessage) Command() MessageCommand {
 }
 
 // NewGetInfoResponseMessage returns a instance of the message
-func NewGetInfoResponseMessage(p2pID string, mempoolSize uint64, serverVersion string, isUtxoIndexed bool, isSynced bool) *GetInfoResponseMessage {
+func NewGetInfoResponseMessage(p2pID string, mempoolSize uint64, serverVersion string, isUtxoIndexed bool, isSynced bool,
+	mempoolMass uint64, mempoolMinimumFeeRate float64) *GetInfoResponseMessage {
+
 	return &GetInfoResponseMessage{
-		P2PID:         p2pID,
-		MempoolSize:   mempoolSize,
-		ServerVersion: serverVersion,
-		IsUtxoIndexed: isUtxoIndexed,
-		IsSynced:      isSynced,
+		P2PID:                 p2pID,
+		MempoolSize:           mempoolSize,
+		ServerVersion:         serverVersion,
+		IsUtxoIndexed:         isUtxoIndexed,
+		IsSynced:              isSynced,
+		MempoolMass:           mempoolMass,
+		MempoolMinimumFeeRate: mempoolMinimumFeeRate,
 	}
 }
@@ -27,6 +27,10 @@ type GetMempoolEntriesResponseMessage struct {
 	baseMessage
 	Entries []*MempoolEntry
 
+	// MinimumFeeRate is the lowest fee rate, in sompi per gram, a transaction
+	// currently must pay in order to enter the mempool
+	MinimumFeeRate float64
+
 	Error *RPCError
 }
 
@@ -36,8 +40,9 @@ func (msg *GetMempoolEntriesResponseMessage) Command() MessageCommand {
 }
 
 // NewGetMempoolEntriesResponseMessage returns a instance of the message
-func NewGetMempoolEntriesResponseMessage(entries []*MempoolEntry) *GetMempoolEntriesResponseMessage {
+func NewGetMempoolEntriesResponseMessage(entries []*MempoolEntry, minimumFeeRate float64) *GetMempoolEntriesResponseMessage {
 	return &GetMempoolEntriesResponseMessage{
-		Entries: entries,
+		Entries:        entries,
+		MinimumFeeRate: minimumFeeRate,
 	}
 }
@@ -0,0 +1,49 @@
+package appmessage
+
+// GetTransactionRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionRequestMessage struct {
+	baseMessage
+	TransactionID string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionRequestMessage) Command() MessageCommand {
+	return CmdGetTransactionRequestMessage
+}
+
+// NewGetTransactionRequestMessage returns a instance of the message
+func NewGetTransactionRequestMessage(transactionID string) *GetTransactionRequestMessage {
+	return &GetTransactionRequestMessage{
+		TransactionID: transactionID,
+	}
+}
+
+// GetTransactionResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionResponseMessage struct {
+	baseMessage
+	Transaction           *RPCTransaction
+	ContainingBlockHashes []string
+	AcceptingBlockHash    string
+	Confirmations         uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionResponseMessage) Command() MessageCommand {
+	return CmdGetTransactionResponseMessage
+}
+
+// NewGetTransactionResponseMessage returns a instance of the message
+func NewGetTransactionResponseMessage(transaction *RPCTransaction, containingBlockHashes []string,
+	acceptingBlockHash string, confirmations uint64) *GetTransactionResponseMessage {
+
+	return &GetTransactionResponseMessage{
+		Transaction:           transaction,
+		ContainingBlockHashes: containingBlockHashes,
+		AcceptingBlockHash:    acceptingBlockHash,
+		Confirmations:         confirmations,
+	}
+}
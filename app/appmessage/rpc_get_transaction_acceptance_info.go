@@ -0,0 +1,53 @@
+package appmessage
+
+// GetTransactionAcceptanceInfoRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionAcceptanceInfoRequestMessage struct {
+	baseMessage
+	TransactionIDs []string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionAcceptanceInfoRequestMessage) Command() MessageCommand {
+	return CmdGetTransactionAcceptanceInfoRequestMessage
+}
+
+// NewGetTransactionAcceptanceInfoRequestMessage returns a instance of the message
+func NewGetTransactionAcceptanceInfoRequestMessage(transactionIDs []string) *GetTransactionAcceptanceInfoRequestMessage {
+	return &GetTransactionAcceptanceInfoRequestMessage{
+		TransactionIDs: transactionIDs,
+	}
+}
+
+// TransactionAcceptanceInfo represents the acceptance status of a single transaction
+type TransactionAcceptanceInfo struct {
+	TransactionID      string
+	IsAccepted         bool
+	AcceptingBlockHash string
+	IncludingBlockHash string
+	AcceptingBlueScore uint64
+	Confirmations      uint64
+}
+
+// GetTransactionAcceptanceInfoResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionAcceptanceInfoResponseMessage struct {
+	baseMessage
+	AcceptanceInfos []*TransactionAcceptanceInfo
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionAcceptanceInfoResponseMessage) Command() MessageCommand {
+	return CmdGetTransactionAcceptanceInfoResponseMessage
+}
+
+// NewGetTransactionAcceptanceInfoResponseMessage returns a instance of the message
+func NewGetTransactionAcceptanceInfoResponseMessage(
+	acceptanceInfos []*TransactionAcceptanceInfo) *GetTransactionAcceptanceInfoResponseMessage {
+
+	return &GetTransactionAcceptanceInfoResponseMessage{
+		AcceptanceInfos: acceptanceInfos,
+	}
+}
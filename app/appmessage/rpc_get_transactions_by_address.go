@@ -0,0 +1,59 @@
+package appmessage
+
+// GetTransactionsByAddressRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionsByAddressRequestMessage struct {
+	baseMessage
+	Address            string
+	StartDAAScore      uint64
+	StartTransactionID string
+	Limit              uint32
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionsByAddressRequestMessage) Command() MessageCommand {
+	return CmdGetTransactionsByAddressRequestMessage
+}
+
+// NewGetTransactionsByAddressRequestMessage returns a instance of the message
+func NewGetTransactionsByAddressRequestMessage(address string, startDAAScore uint64, startTransactionID string,
+	limit uint32) *GetTransactionsByAddressRequestMessage {
+
+	return &GetTransactionsByAddressRequestMessage{
+		Address:            address,
+		StartDAAScore:      startDAAScore,
+		StartTransactionID: startTransactionID,
+		Limit:              limit,
+	}
+}
+
+// GetTransactionsByAddressResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionsByAddressResponseMessage struct {
+	baseMessage
+	Transactions []*AddressTransaction
+	HasMore      bool
+
+	Error *RPCError
+}
+
+// AddressTransaction represents a transaction that either pays to or spends from an address
+type AddressTransaction struct {
+	TransactionID     string
+	AcceptingDAAScore uint64
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionsByAddressResponseMessage) Command() MessageCommand {
+	return CmdGetTransactionsByAddressResponseMessage
+}
+
+// NewGetTransactionsByAddressResponseMessage returns a instance of the message
+func NewGetTransactionsByAddressResponseMessage(transactions []*AddressTransaction,
+	hasMore bool) *GetTransactionsByAddressResponseMessage {
+
+	return &GetTransactionsByAddressResponseMessage{
+		Transactions: transactions,
+		HasMore:      hasMore,
+	}
+}
@@ -4,7 +4,11 @@ package appmessage
 // its respective RPC message
 type GetUTXOsByAddressesRequestMessage struct {
 	baseMessage
-	Addresses []string
+	Addresses     []string
+	Filter        *UTXOsByAddressesFilter
+	Limit         uint32
+	StartAddress  string
+	StartOutpoint *RPCOutpoint
 }
 
 // Command returns the protocol command string for the message
@@ -19,11 +23,26 @@ func NewGetUTXOsByAddressesRequestMessage(addresses []string) *GetUTXOsByAddress
 	}
 }
 
+// NewGetUTXOsByAddressesPageRequestMessage returns a instance of the message
+// requesting a single page of UTXOs
+func NewGetUTXOsByAddressesPageRequestMessage(addresses []string, filter *UTXOsByAddressesFilter, limit uint32,
+	startAddress string, startOutpoint *RPCOutpoint) *GetUTXOsByAddressesRequestMessage {
+
+	return &GetUTXOsByAddressesRequestMessage{
+		Addresses:     addresses,
+		Filter:        filter,
+		Limit:         limit,
+		StartAddress:  startAddress,
+		StartOutpoint: startOutpoint,
+	}
+}
+
 // GetUTXOsByAddressesResponseMessage is an appmessage corresponding to
 // its respective RPC message
 type GetUTXOsByAddressesResponseMessage struct {
 	baseMessage
 	Entries []*UTXOsByAddressesEntry
+	HasMore bool
 
 	Error *RPCError
 }
@@ -34,8 +53,19 @@ func (msg *GetUTXOsByAddressesResponseMessage) Command() MessageCommand {
 }
 
 // NewGetUTXOsByAddressesResponseMessage returns a instance of the message
-func NewGetUTXOsByAddressesResponseMessage(entries []*UTXOsByAddressesEntry) *GetUTXOsByAddressesResponseMessage {
+func NewGetUTXOsByAddressesResponseMessage(entries []*UTXOsByAddressesEntry, hasMore bool) *GetUTXOsByAddressesResponseMessage {
 	return &GetUTXOsByAddressesResponseMessage{
 		Entries: entries,
+		HasMore: hasMore,
 	}
 }
+
+// UTXOsByAddressesFilter filters the UTXOs returned by GetUTXOsByAddresses
+// and StreamUTXOsByAddresses
+type UTXOsByAddressesFilter struct {
+	MinAmount               uint64
+	MaxAmount               uint64
+	MinBlockDAAScore        uint64
+	MaxBlockDAAScore        uint64
+	ExcludeImmatureCoinbase bool
+}
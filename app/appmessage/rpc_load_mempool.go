@@ -0,0 +1,38 @@
+package appmessage
+
+// LoadMempoolRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type LoadMempoolRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *LoadMempoolRequestMessage) Command() MessageCommand {
+	return CmdLoadMempoolRequestMessage
+}
+
+// NewLoadMempoolRequestMessage returns a instance of the message
+func NewLoadMempoolRequestMessage() *LoadMempoolRequestMessage {
+	return &LoadMempoolRequestMessage{}
+}
+
+// LoadMempoolResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type LoadMempoolResponseMessage struct {
+	baseMessage
+	LoadedTransactionCount uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *LoadMempoolResponseMessage) Command() MessageCommand {
+	return CmdLoadMempoolResponseMessage
+}
+
+// NewLoadMempoolResponseMessage returns a instance of the message
+func NewLoadMempoolResponseMessage(loadedTransactionCount uint64) *LoadMempoolResponseMessage {
+	return &LoadMempoolResponseMessage{
+		LoadedTransactionCount: loadedTransactionCount,
+	}
+}
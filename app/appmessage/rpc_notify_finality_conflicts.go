@@ -38,6 +38,7 @@ func NewNotifyFinalityConflictsResponseMessage() *NotifyFinalityConflictsRespons
 type FinalityConflictNotificationMessage struct {
 	baseMessage
 	ViolatingBlockHash string
+	FinalityBlockHash  string
 }
 
 // Command returns the protocol command string for the message
@@ -46,9 +47,12 @@ func (msg *FinalityConflictNotificationMessage) Command() MessageCommand {
 }
 
 // NewFinalityConflictNotificationMessage returns a instance of the message
-func NewFinalityConflictNotificationMessage(violatingBlockHash string) *FinalityConflictNotificationMessage {
+func NewFinalityConflictNotificationMessage(violatingBlockHash string,
+	finalityBlockHash string) *FinalityConflictNotificationMessage {
+
 	return &FinalityConflictNotificationMessage{
 		ViolatingBlockHash: violatingBlockHash,
+		FinalityBlockHash:  finalityBlockHash,
 	}
 }
 
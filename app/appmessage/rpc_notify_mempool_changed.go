@@ -0,0 +1,93 @@
+package appmessage
+
+// NotifyMempoolChangedRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifyMempoolChangedRequestMessage struct {
+	baseMessage
+	Addresses []string
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifyMempoolChangedRequestMessage) Command() MessageCommand {
+	return CmdNotifyMempoolChangedRequestMessage
+}
+
+// NewNotifyMempoolChangedRequestMessage returns a instance of the message
+func NewNotifyMempoolChangedRequestMessage(addresses []string) *NotifyMempoolChangedRequestMessage {
+	return &NotifyMempoolChangedRequestMessage{
+		Addresses: addresses,
+	}
+}
+
+// NotifyMempoolChangedResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifyMempoolChangedResponseMessage struct {
+	baseMessage
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifyMempoolChangedResponseMessage) Command() MessageCommand {
+	return CmdNotifyMempoolChangedResponseMessage
+}
+
+// NewNotifyMempoolChangedResponseMessage returns a instance of the message
+func NewNotifyMempoolChangedResponseMessage() *NotifyMempoolChangedResponseMessage {
+	return &NotifyMempoolChangedResponseMessage{}
+}
+
+// MempoolChangedNotificationMessage is an appmessage corresponding to
+// its respective RPC message
+type MempoolChangedNotificationMessage struct {
+	baseMessage
+	Changes []*MempoolChange
+}
+
+// MempoolChange describes a single transaction that was either added to or
+// removed from the mempool
+type MempoolChange struct {
+	Entry         *MempoolEntry
+	IsRemoved     bool
+	RemovalReason MempoolRemovalReason
+}
+
+// MempoolRemovalReason describes the reason why a transaction was removed from the mempool
+type MempoolRemovalReason byte
+
+// MempoolRemovalReason constants
+// Not using iota, since in the .proto file those are hardcoded
+const (
+	MempoolRemovalReasonNone            MempoolRemovalReason = 0
+	MempoolRemovalReasonIncludedInBlock MempoolRemovalReason = 1
+	MempoolRemovalReasonExpired         MempoolRemovalReason = 2
+	MempoolRemovalReasonDoubleSpent     MempoolRemovalReason = 3
+	MempoolRemovalReasonEvicted         MempoolRemovalReason = 4
+	MempoolRemovalReasonOrphanResolved  MempoolRemovalReason = 5
+	MempoolRemovalReasonInvalid         MempoolRemovalReason = 6
+)
+
+var mempoolRemovalReasonToString = map[MempoolRemovalReason]string{
+	MempoolRemovalReasonNone:            "None",
+	MempoolRemovalReasonIncludedInBlock: "Included in block",
+	MempoolRemovalReasonExpired:         "Expired",
+	MempoolRemovalReasonDoubleSpent:     "Double spent",
+	MempoolRemovalReasonEvicted:         "Evicted",
+	MempoolRemovalReasonOrphanResolved:  "Orphan resolved",
+	MempoolRemovalReasonInvalid:         "Invalid",
+}
+
+func (reason MempoolRemovalReason) String() string {
+	return mempoolRemovalReasonToString[reason]
+}
+
+// Command returns the protocol command string for the message
+func (msg *MempoolChangedNotificationMessage) Command() MessageCommand {
+	return CmdMempoolChangedNotificationMessage
+}
+
+// NewMempoolChangedNotificationMessage returns a instance of the message
+func NewMempoolChangedNotificationMessage(changes []*MempoolChange) *MempoolChangedNotificationMessage {
+	return &MempoolChangedNotificationMessage{
+		Changes: changes,
+	}
+}
@@ -57,7 +57,7 @@ type StopNotifyingPruningPointUTXOSetOverrideRequestMessage struct {
 
 // Command returns the protocol command string for the message
 func (msg *StopNotifyingPruningPointUTXOSetOverrideRequestMessage) Command() MessageCommand {
-	return CmdNotifyPruningPointUTXOSetOverrideRequestMessage
+	return CmdStopNotifyingPruningPointUTXOSetOverrideRequestMessage
 }
 
 // NewStopNotifyingPruningPointUTXOSetOverrideRequestMessage returns a instance of the message
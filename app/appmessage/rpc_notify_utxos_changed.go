@@ -4,7 +4,9 @@ package appmessage
 // its respective RPC message
 type NotifyUTXOsChangedRequestMessage struct {
 	baseMessage
-	Addresses []string
+	Addresses                 []string
+	ResumeAfterSequence       uint64
+	ResumeAfterChainBlockHash string
 }
 
 // Command returns the protocol command string for the message
@@ -19,6 +21,19 @@ func NewNotifyUTXOsChangedRequestMessage(addresses []string) *NotifyUTXOsChanged
 	}
 }
 
+// NewResumeUTXOsChangedRequestMessage returns an instance of the message that
+// also asks for the notifications sent after the given sequence or chain block
+// hash to be replayed
+func NewResumeUTXOsChangedRequestMessage(addresses []string,
+	resumeAfterSequence uint64, resumeAfterChainBlockHash string) *NotifyUTXOsChangedRequestMessage {
+
+	return &NotifyUTXOsChangedRequestMessage{
+		Addresses:                 addresses,
+		ResumeAfterSequence:       resumeAfterSequence,
+		ResumeAfterChainBlockHash: resumeAfterChainBlockHash,
+	}
+}
+
 // NotifyUTXOsChangedResponseMessage is an appmessage corresponding to
 // its respective RPC message
 type NotifyUTXOsChangedResponseMessage struct {
@@ -40,8 +55,9 @@ func NewNotifyUTXOsChangedResponseMessage() *NotifyUTXOsChangedResponseMessage {
 // its respective RPC message
 type UTXOsChangedNotificationMessage struct {
 	baseMessage
-	Added   []*UTXOsByAddressesEntry
-	Removed []*UTXOsByAddressesEntry
+	Added    []*UTXOsByAddressesEntry
+	Removed  []*UTXOsByAddressesEntry
+	Sequence uint64
 }
 
 // UTXOsByAddressesEntry represents a UTXO of some address
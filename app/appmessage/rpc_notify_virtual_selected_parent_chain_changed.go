@@ -5,6 +5,8 @@ package appmessage
 type NotifyVirtualSelectedParentChainChangedRequestMessage struct {
 	baseMessage
 	IncludeAcceptedTransactionIDs bool
+	ResumeAfterSequence           uint64
+	ResumeAfterChainBlockHash     string
 }
 
 // Command returns the protocol command string for the message
@@ -21,6 +23,19 @@ func NewNotifyVirtualSelectedParentChainChangedRequestMessage(
 	}
 }
 
+// NewResumeVirtualSelectedParentChainChangedRequestMessage returns an instance of the
+// message that also asks for the notifications sent after the given sequence or chain
+// block hash to be replayed
+func NewResumeVirtualSelectedParentChainChangedRequestMessage(includeAcceptedTransactionIDs bool,
+	resumeAfterSequence uint64, resumeAfterChainBlockHash string) *NotifyVirtualSelectedParentChainChangedRequestMessage {
+
+	return &NotifyVirtualSelectedParentChainChangedRequestMessage{
+		IncludeAcceptedTransactionIDs: includeAcceptedTransactionIDs,
+		ResumeAfterSequence:           resumeAfterSequence,
+		ResumeAfterChainBlockHash:     resumeAfterChainBlockHash,
+	}
+}
+
 // NotifyVirtualSelectedParentChainChangedResponseMessage is an appmessage corresponding to
 // its respective RPC message
 type NotifyVirtualSelectedParentChainChangedResponseMessage struct {
@@ -45,6 +60,7 @@ type VirtualSelectedParentChainChangedNotificationMessage struct {
 	RemovedChainBlockHashes []string
 	AddedChainBlockHashes   []string
 	AcceptedTransactionIDs  []*AcceptedTransactionIDs
+	Sequence                uint64
 }
 
 // Command returns the protocol command string for the message
@@ -0,0 +1,66 @@
+package appmessage
+
+// StreamUTXOsByAddressesRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type StreamUTXOsByAddressesRequestMessage struct {
+	baseMessage
+	Addresses []string
+	Filter    *UTXOsByAddressesFilter
+	ChunkSize uint32
+}
+
+// Command returns the protocol command string for the message
+func (msg *StreamUTXOsByAddressesRequestMessage) Command() MessageCommand {
+	return CmdStreamUTXOsByAddressesRequestMessage
+}
+
+// NewStreamUTXOsByAddressesRequestMessage returns a instance of the message
+func NewStreamUTXOsByAddressesRequestMessage(addresses []string, filter *UTXOsByAddressesFilter,
+	chunkSize uint32) *StreamUTXOsByAddressesRequestMessage {
+
+	return &StreamUTXOsByAddressesRequestMessage{
+		Addresses: addresses,
+		Filter:    filter,
+		ChunkSize: chunkSize,
+	}
+}
+
+// StreamUTXOsByAddressesResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type StreamUTXOsByAddressesResponseMessage struct {
+	baseMessage
+	EntryCount uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *StreamUTXOsByAddressesResponseMessage) Command() MessageCommand {
+	return CmdStreamUTXOsByAddressesResponseMessage
+}
+
+// NewStreamUTXOsByAddressesResponseMessage returns a instance of the message
+func NewStreamUTXOsByAddressesResponseMessage(entryCount uint64) *StreamUTXOsByAddressesResponseMessage {
+	return &StreamUTXOsByAddressesResponseMessage{
+		EntryCount: entryCount,
+	}
+}
+
+// UTXOsByAddressesChunkNotificationMessage is an appmessage corresponding to
+// its respective RPC message
+type UTXOsByAddressesChunkNotificationMessage struct {
+	baseMessage
+	Entries []*UTXOsByAddressesEntry
+}
+
+// Command returns the protocol command string for the message
+func (msg *UTXOsByAddressesChunkNotificationMessage) Command() MessageCommand {
+	return CmdUTXOsByAddressesChunkNotificationMessage
+}
+
+// NewUTXOsByAddressesChunkNotificationMessage returns a instance of the message
+func NewUTXOsByAddressesChunkNotificationMessage(entries []*UTXOsByAddressesEntry) *UTXOsByAddressesChunkNotificationMessage {
+	return &UTXOsByAddressesChunkNotificationMessage{
+		Entries: entries,
+	}
+}
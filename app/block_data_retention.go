@@ -0,0 +1,73 @@
+package app
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/util/panics"
+)
+
+// blockDataRetentionInterval is the interval in which blockDataRetention
+// checks whether there's block data that is no longer retained
+const blockDataRetentionInterval = time.Minute
+
+// blockDataRetention deletes, in the background, the block data that a node
+// running in block data retention mode no longer retains
+type blockDataRetention struct {
+	domain domain.Domain
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newBlockDataRetention(domain domain.Domain) *blockDataRetention {
+	return &blockDataRetention{
+		domain: domain,
+		quit:   make(chan struct{}),
+	}
+}
+
+func (bdr *blockDataRetention) start() {
+	bdr.wg.Add(1)
+	spawn("blockDataRetention-loop", func() {
+		defer bdr.wg.Done()
+
+		ticker := time.NewTicker(blockDataRetentionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-bdr.quit:
+				return
+			case <-ticker.C:
+				bdr.deleteExpiredBlockData()
+			}
+		}
+	})
+}
+
+// deleteExpiredBlockData deletes the expired block data chunk by chunk, so that
+// both the consensus and the shutdown of the node don't have to wait for all of it
+func (bdr *blockDataRetention) deleteExpiredBlockData() {
+	for {
+		select {
+		case <-bdr.quit:
+			return
+		default:
+		}
+
+		isCompletelyDeleted, err := bdr.domain.Consensus().DeleteExpiredBlockData()
+		if err != nil {
+			panics.Exit(log, fmt.Sprintf("Error deleting expired block data: %+v", err))
+		}
+		if isCompletelyDeleted {
+			return
+		}
+	}
+}
+
+func (bdr *blockDataRetention) stop() {
+	close(bdr.quit)
+	bdr.wg.Wait()
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"os"
 	"sync/atomic"
 
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
@@ -10,16 +11,22 @@ import (
 
 	"github.com/kaspanet/kaspad/app/protocol"
 	"github.com/kaspanet/kaspad/app/rpc"
+	"github.com/kaspanet/kaspad/app/rpc/notificationjournal"
+	"github.com/kaspanet/kaspad/app/stratum"
 	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/addressindex"
 	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/txindex"
 	"github.com/kaspanet/kaspad/domain/utxoindex"
 	"github.com/kaspanet/kaspad/infrastructure/config"
 	infrastructuredatabase "github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
 	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/connmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/id"
 	"github.com/kaspanet/kaspad/util/panics"
+	"github.com/pkg/errors"
 )
 
 // ComponentManager is a wrapper for all the kaspad services
@@ -30,6 +37,10 @@ type ComponentManager struct {
 	rpcManager        *rpc.Manager
 	connectionManager *connmanager.ConnectionManager
 	netAdapter        *netadapter.NetAdapter
+	metricsServer     *metrics.Server
+	stratumServer     *stratum.Server
+
+	blockDataRetention *blockDataRetention
 
 	started, shutdown int32
 }
@@ -43,12 +54,34 @@ func (a *ComponentManager) Start() {
 
 	log.Trace("Starting kaspad")
 
+	if a.shouldPersistMempool() {
+		a.loadMempool()
+	}
+
 	err := a.netAdapter.Start()
 	if err != nil {
 		panics.Exit(log, fmt.Sprintf("Error starting the net adapter: %+v", err))
 	}
 
 	a.connectionManager.Start()
+
+	if a.metricsServer != nil {
+		err := a.metricsServer.Start()
+		if err != nil {
+			panics.Exit(log, fmt.Sprintf("Error starting the metrics server: %+v", err))
+		}
+	}
+
+	if a.stratumServer != nil {
+		err := a.stratumServer.Start()
+		if err != nil {
+			panics.Exit(log, fmt.Sprintf("Error starting the Stratum server: %+v", err))
+		}
+	}
+
+	if a.blockDataRetention != nil {
+		a.blockDataRetention.start()
+	}
 }
 
 // Stop gracefully shuts down all the kaspad services.
@@ -61,6 +94,24 @@ func (a *ComponentManager) Stop() {
 
 	log.Warnf("Kaspad shutting down")
 
+	if a.stratumServer != nil {
+		err := a.stratumServer.Stop()
+		if err != nil {
+			log.Errorf("Error stopping the Stratum server: %+v", err)
+		}
+	}
+
+	if a.metricsServer != nil {
+		err := a.metricsServer.Stop()
+		if err != nil {
+			log.Errorf("Error stopping the metrics server: %+v", err)
+		}
+	}
+
+	if a.blockDataRetention != nil {
+		a.blockDataRetention.stop()
+	}
+
 	a.connectionManager.Stop()
 
 	err := a.netAdapter.Stop()
@@ -69,26 +120,53 @@ func (a *ComponentManager) Stop() {
 	}
 
 	a.protocolManager.Close()
+
+	if a.shouldPersistMempool() {
+		a.saveMempool()
+	}
+
 	close(a.protocolManager.Context().Domain().ConsensusEventsChannel())
+	close(a.protocolManager.Context().Domain().MempoolEventsChannel())
 
 	return
 }
 
+// shouldPersistMempool returns whether the mempool should be saved on
+// shutdown and loaded back on startup
+func (a *ComponentManager) shouldPersistMempool() bool {
+	// There's no point in saving the mempool if the DAG it's built upon is lost
+	return !a.cfg.NoPersistMempool && a.cfg.DbType != config.DbTypeMemory
+}
+
+func (a *ComponentManager) loadMempool() {
+	mempoolFile := a.cfg.MempoolFile()
+	log.Infof("Loading the mempool from %s", mempoolFile)
+	_, err := a.protocolManager.Context().Domain().MiningManager().LoadMempool(mempoolFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Infof("No saved mempool was found")
+			return
+		}
+		log.Warnf("Error loading the mempool: %+v", err)
+	}
+}
+
+func (a *ComponentManager) saveMempool() {
+	mempoolFile := a.cfg.MempoolFile()
+	savedCount, err := a.protocolManager.Context().Domain().MiningManager().SaveMempool(mempoolFile)
+	if err != nil {
+		log.Errorf("Error saving the mempool: %+v", err)
+		return
+	}
+	log.Infof("Saved %d mempool transactions into %s", savedCount, mempoolFile)
+}
+
 // NewComponentManager returns a new ComponentManager instance.
 // Use Start() to begin all services within this ComponentManager
 func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database, interrupt chan<- struct{}) (
 	*ComponentManager, error) {
 
-	consensusConfig := consensus.Config{
-		Params:                          *cfg.ActiveNetParams,
-		IsArchival:                      cfg.IsArchivalNode,
-		EnableSanityCheckPruningUTXOSet: cfg.EnableSanityCheckPruningUTXOSet,
-	}
-	mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
-	mempoolConfig.MaximumOrphanTransactionCount = cfg.MaxOrphanTxs
-	mempoolConfig.MinimumRelayTransactionFee = cfg.MinRelayTxFee
-
-	domain, err := domain.New(&consensusConfig, mempoolConfig, db)
+	domain, err := newDomain(cfg, db)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +191,34 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		log.Infof("UTXO index started")
 	}
 
+	var txIndex *txindex.TXIndex
+	if cfg.TXIndex {
+		txIndex, err = txindex.New(domain, db)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("TX index started")
+	}
+
+	var addressIndex *addressindex.AddressIndex
+	if cfg.AddressIndex {
+		addressIndex, err = addressindex.New(domain, db)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Address index started")
+	}
+
+	notificationJournal, err := notificationjournal.New(db, cfg.NotificationJournalSize)
+	if err != nil {
+		return nil, err
+	}
+	if notificationJournal.IsEnabled() {
+		log.Infof("Notification journal started")
+	}
+
 	connectionManager, err := connmanager.New(cfg, netAdapter, addressManager)
 	if err != nil {
 		return nil, err
@@ -121,7 +227,27 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 	if err != nil {
 		return nil, err
 	}
-	rpcManager := setupRPC(cfg, domain, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, domain.ConsensusEventsChannel(), interrupt)
+	rpcManager := setupRPC(cfg, domain, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, txIndex, addressIndex, notificationJournal, domain.ConsensusEventsChannel(), interrupt)
+
+	var metricsServer *metrics.Server
+	if cfg.MetricsListen != "" {
+		metricsServer = metrics.NewServer(cfg.MetricsListen, log,
+			newMetricsRegistry(domain, protocolManager, connectionManager))
+	}
+
+	var stratumServer *stratum.Server
+	if len(cfg.StratumListeners) > 0 {
+		stratumServer = stratum.NewServer(cfg, domain, protocolManager)
+		protocolManager.SetOnNewBlockTemplateHandler(func() error {
+			stratumServer.NotifyNewBlockTemplate()
+			return rpcManager.NotifyNewBlockTemplate()
+		})
+	}
+
+	var blockDataRetention *blockDataRetention
+	if cfg.RetentionPruningPeriods > 0 || cfg.RetentionDAAScoreWindow > 0 {
+		blockDataRetention = newBlockDataRetention(domain)
+	}
 
 	return &ComponentManager{
 		cfg:               cfg,
@@ -130,6 +256,10 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		connectionManager: connectionManager,
 		netAdapter:        netAdapter,
 		addressManager:    addressManager,
+		metricsServer:     metricsServer,
+		stratumServer:     stratumServer,
+
+		blockDataRetention: blockDataRetention,
 	}, nil
 
 }
@@ -142,6 +272,9 @@ func setupRPC(
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	addressIndex *addressindex.AddressIndex,
+	notificationJournal *notificationjournal.Journal,
 	consensusEventsChan chan externalapi.ConsensusEvent,
 	shutDownChan chan<- struct{},
 ) *rpc.Manager {
@@ -154,7 +287,11 @@ func setupRPC(
 		connectionManager,
 		addressManager,
 		utxoIndex,
+		txIndex,
+		addressIndex,
+		notificationJournal,
 		consensusEventsChan,
+		domain.MempoolEventsChannel(),
 		shutDownChan,
 	)
 	protocolManager.SetOnNewBlockTemplateHandler(rpcManager.NotifyNewBlockTemplate)
@@ -172,3 +309,23 @@ func (a *ComponentManager) P2PNodeID() *id.ID {
 func (a *ComponentManager) AddressManager() *addressmanager.AddressManager {
 	return a.addressManager
 }
+
+func newDomain(cfg *config.Config, db infrastructuredatabase.Database) (domain.Domain, error) {
+	consensusConfig := newConsensusConfig(cfg)
+	mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+	mempoolConfig.MaximumOrphanTransactionCount = cfg.MaxOrphanTxs
+	mempoolConfig.MaximumTransactionsMass = cfg.MaxMempoolMass
+	mempoolConfig.MinimumRelayTransactionFee = cfg.MinRelayTxFee
+
+	return domain.New(consensusConfig, mempoolConfig, db)
+}
+
+func newConsensusConfig(cfg *config.Config) *consensus.Config {
+	return &consensus.Config{
+		Params:                          *cfg.ActiveNetParams,
+		IsArchival:                      cfg.IsArchivalNode,
+		RetentionPruningPeriods:         cfg.RetentionPruningPeriods,
+		RetentionDAAScoreWindow:         cfg.RetentionDAAScoreWindow,
+		EnableSanityCheckPruningUTXOSet: cfg.EnableSanityCheckPruningUTXOSet,
+	}
+}
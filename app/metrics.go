@@ -0,0 +1,50 @@
+package app
+
+import (
+	"github.com/kaspanet/kaspad/app/protocol"
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
+	"github.com/kaspanet/kaspad/infrastructure/network/connmanager"
+)
+
+// newMetricsRegistry creates a registry of the metrics that are computed
+// from the state of the kaspad services whenever they are exported
+func newMetricsRegistry(domain domain.Domain, protocolManager *protocol.Manager,
+	connectionManager *connmanager.ConnectionManager) *metrics.Registry {
+
+	registry := metrics.NewRegistry()
+
+	registry.NewGaugeFunc("kaspad_mempool_transactions", "The number of transactions in the mempool",
+		func() float64 {
+			return float64(domain.MiningManager().TransactionCount(true, false))
+		})
+	registry.NewGaugeFunc("kaspad_mempool_orphan_transactions", "The number of orphan transactions in the mempool",
+		func() float64 {
+			return float64(domain.MiningManager().TransactionCount(false, true))
+		})
+	registry.NewGaugeFunc("kaspad_mempool_mass", "The total mass of the transactions in the mempool",
+		func() float64 {
+			return float64(domain.MiningManager().TransactionMass(true, false))
+		})
+
+	registry.NewGaugeFunc("kaspad_peers_inbound", "The number of connected inbound peers",
+		func() float64 {
+			inbound, _ := connectionManager.PeerCounts()
+			return float64(inbound)
+		})
+	registry.NewGaugeFunc("kaspad_peers_outbound", "The number of connected outbound peers",
+		func() float64 {
+			_, outbound := connectionManager.PeerCounts()
+			return float64(outbound)
+		})
+
+	registry.NewGaugeFunc("kaspad_ibd_running", "Whether IBD is currently running (1) or not (0)",
+		func() float64 {
+			if protocolManager.Context().IsIBDRunning() {
+				return 1
+			}
+			return 0
+		})
+
+	return registry
+}
@@ -22,6 +22,25 @@ func (f *FlowContext) AddTransaction(tx *externalapi.DomainTransaction, allowOrp
 	return f.EnqueueTransactionIDsForPropagation(acceptedTransactionIDs)
 }
 
+// AddTransactionReplacement adds the given transaction to the mempool, replacing the
+// transactions it double spends, and propagates it. Returns the replaced transactions.
+func (f *FlowContext) AddTransactionReplacement(tx *externalapi.DomainTransaction) (
+	replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	acceptedTransactions, replacedTransactions, err :=
+		f.Domain().MiningManager().ValidateAndInsertTransactionReplacement(tx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptedTransactionIDs := consensushashing.TransactionIDs(acceptedTransactions)
+	err = f.EnqueueTransactionIDsForPropagation(acceptedTransactionIDs)
+	if err != nil {
+		return nil, err
+	}
+	return replacedTransactions, nil
+}
+
 func (f *FlowContext) shouldRebroadcastTransactions() bool {
 	const rebroadcastInterval = 30 * time.Second
 	return time.Since(f.lastRebroadcastTime) > rebroadcastInterval
@@ -6,6 +6,7 @@ import (
 	"github.com/kaspanet/kaspad/app/protocol/protocolerrors"
 	"github.com/kaspanet/kaspad/domain"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
 	"github.com/kaspanet/kaspad/infrastructure/config"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"sync/atomic"
@@ -53,82 +54,19 @@ func HandlePruningPointAndItsAnticoneRequests(context PruningPointAndItsAnticone
 				return err
 			}
 
-			pointAndItsAnticone, err := context.Domain().Consensus().PruningPointAndItsAnticone()
+			trustedData, blocksWithTrustedData, err := PruningPointAndItsAnticoneWithTrustedData(
+				context.Domain().Consensus(), context.Config().NetParams())
 			if err != nil {
 				return err
 			}
 
-			windowSize := context.Config().NetParams().DifficultyAdjustmentWindowSize
-			daaWindowBlocks := make([]*externalapi.TrustedDataDataDAAHeader, 0, windowSize)
-			daaWindowHashesToIndex := make(map[externalapi.DomainHash]int, windowSize)
-			trustedDataDAABlockIndexes := make(map[externalapi.DomainHash][]uint64)
-
-			ghostdagData := make([]*externalapi.BlockGHOSTDAGDataHashPair, 0)
-			ghostdagDataHashToIndex := make(map[externalapi.DomainHash]int)
-			trustedDataGHOSTDAGDataIndexes := make(map[externalapi.DomainHash][]uint64)
-			for _, blockHash := range pointAndItsAnticone {
-				blockDAAWindowHashes, err := context.Domain().Consensus().BlockDAAWindowHashes(blockHash)
-				if err != nil {
-					return err
-				}
-
-				trustedDataDAABlockIndexes[*blockHash] = make([]uint64, 0, windowSize)
-				for i, daaBlockHash := range blockDAAWindowHashes {
-					index, exists := daaWindowHashesToIndex[*daaBlockHash]
-					if !exists {
-						trustedDataDataDAAHeader, err := context.Domain().Consensus().TrustedDataDataDAAHeader(blockHash, daaBlockHash, uint64(i))
-						if err != nil {
-							return err
-						}
-						daaWindowBlocks = append(daaWindowBlocks, trustedDataDataDAAHeader)
-						index = len(daaWindowBlocks) - 1
-						daaWindowHashesToIndex[*daaBlockHash] = index
-					}
-
-					trustedDataDAABlockIndexes[*blockHash] = append(trustedDataDAABlockIndexes[*blockHash], uint64(index))
-				}
-
-				ghostdagDataBlockHashes, err := context.Domain().Consensus().TrustedBlockAssociatedGHOSTDAGDataBlockHashes(blockHash)
-				if err != nil {
-					return err
-				}
-
-				trustedDataGHOSTDAGDataIndexes[*blockHash] = make([]uint64, 0, context.Config().NetParams().K)
-				for _, ghostdagDataBlockHash := range ghostdagDataBlockHashes {
-					index, exists := ghostdagDataHashToIndex[*ghostdagDataBlockHash]
-					if !exists {
-						data, err := context.Domain().Consensus().TrustedGHOSTDAGData(ghostdagDataBlockHash)
-						if err != nil {
-							return err
-						}
-						ghostdagData = append(ghostdagData, &externalapi.BlockGHOSTDAGDataHashPair{
-							Hash:         ghostdagDataBlockHash,
-							GHOSTDAGData: data,
-						})
-						index = len(ghostdagData) - 1
-						ghostdagDataHashToIndex[*ghostdagDataBlockHash] = index
-					}
-
-					trustedDataGHOSTDAGDataIndexes[*blockHash] = append(trustedDataGHOSTDAGDataIndexes[*blockHash], uint64(index))
-				}
-			}
-
-			err = outgoingRoute.Enqueue(appmessage.DomainTrustedDataToTrustedData(daaWindowBlocks, ghostdagData))
+			err = outgoingRoute.Enqueue(trustedData)
 			if err != nil {
 				return err
 			}
 
-			for i, blockHash := range pointAndItsAnticone {
-				block, found, err := context.Domain().Consensus().GetBlock(blockHash)
-				if err != nil {
-					return err
-				}
-
-				if !found {
-					return protocolerrors.Errorf(false, "pruning point anticone block %s not found", blockHash)
-				}
-
-				err = outgoingRoute.Enqueue(appmessage.DomainBlockWithTrustedDataToBlockWithTrustedDataV4(block, trustedDataDAABlockIndexes[*blockHash], trustedDataGHOSTDAGDataIndexes[*blockHash]))
+			for i, blockWithTrustedData := range blocksWithTrustedData {
+				err = outgoingRoute.Enqueue(blockWithTrustedData)
 				if err != nil {
 					return err
 				}
@@ -160,3 +98,86 @@ func HandlePruningPointAndItsAnticoneRequests(context PruningPointAndItsAnticone
 		}
 	}
 }
+
+// PruningPointAndItsAnticoneWithTrustedData returns the pruning point and its anticone, each block
+// along with the indexes of its trusted data within the returned MsgTrustedData.
+func PruningPointAndItsAnticoneWithTrustedData(consensus externalapi.Consensus, params *dagconfig.Params) (
+	*appmessage.MsgTrustedData, []*appmessage.MsgBlockWithTrustedDataV4, error) {
+
+	pointAndItsAnticone, err := consensus.PruningPointAndItsAnticone()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	windowSize := params.DifficultyAdjustmentWindowSize
+	daaWindowBlocks := make([]*externalapi.TrustedDataDataDAAHeader, 0, windowSize)
+	daaWindowHashesToIndex := make(map[externalapi.DomainHash]int, windowSize)
+	trustedDataDAABlockIndexes := make(map[externalapi.DomainHash][]uint64)
+
+	ghostdagData := make([]*externalapi.BlockGHOSTDAGDataHashPair, 0)
+	ghostdagDataHashToIndex := make(map[externalapi.DomainHash]int)
+	trustedDataGHOSTDAGDataIndexes := make(map[externalapi.DomainHash][]uint64)
+	for _, blockHash := range pointAndItsAnticone {
+		blockDAAWindowHashes, err := consensus.BlockDAAWindowHashes(blockHash)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		trustedDataDAABlockIndexes[*blockHash] = make([]uint64, 0, windowSize)
+		for i, daaBlockHash := range blockDAAWindowHashes {
+			index, exists := daaWindowHashesToIndex[*daaBlockHash]
+			if !exists {
+				trustedDataDataDAAHeader, err := consensus.TrustedDataDataDAAHeader(blockHash, daaBlockHash, uint64(i))
+				if err != nil {
+					return nil, nil, err
+				}
+				daaWindowBlocks = append(daaWindowBlocks, trustedDataDataDAAHeader)
+				index = len(daaWindowBlocks) - 1
+				daaWindowHashesToIndex[*daaBlockHash] = index
+			}
+
+			trustedDataDAABlockIndexes[*blockHash] = append(trustedDataDAABlockIndexes[*blockHash], uint64(index))
+		}
+
+		ghostdagDataBlockHashes, err := consensus.TrustedBlockAssociatedGHOSTDAGDataBlockHashes(blockHash)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		trustedDataGHOSTDAGDataIndexes[*blockHash] = make([]uint64, 0, params.K)
+		for _, ghostdagDataBlockHash := range ghostdagDataBlockHashes {
+			index, exists := ghostdagDataHashToIndex[*ghostdagDataBlockHash]
+			if !exists {
+				data, err := consensus.TrustedGHOSTDAGData(ghostdagDataBlockHash)
+				if err != nil {
+					return nil, nil, err
+				}
+				ghostdagData = append(ghostdagData, &externalapi.BlockGHOSTDAGDataHashPair{
+					Hash:         ghostdagDataBlockHash,
+					GHOSTDAGData: data,
+				})
+				index = len(ghostdagData) - 1
+				ghostdagDataHashToIndex[*ghostdagDataBlockHash] = index
+			}
+
+			trustedDataGHOSTDAGDataIndexes[*blockHash] = append(trustedDataGHOSTDAGDataIndexes[*blockHash], uint64(index))
+		}
+	}
+
+	blocksWithTrustedData := make([]*appmessage.MsgBlockWithTrustedDataV4, len(pointAndItsAnticone))
+	for i, blockHash := range pointAndItsAnticone {
+		block, found, err := consensus.GetBlock(blockHash)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !found {
+			return nil, nil, protocolerrors.Errorf(false, "pruning point anticone block %s not found", blockHash)
+		}
+
+		blocksWithTrustedData[i] = appmessage.DomainBlockWithTrustedDataToBlockWithTrustedDataV4(
+			block, trustedDataDAABlockIndexes[*blockHash], trustedDataGHOSTDAGDataIndexes[*blockHash])
+	}
+
+	return appmessage.DomainTrustedDataToTrustedData(daaWindowBlocks, ghostdagData), blocksWithTrustedData, nil
+}
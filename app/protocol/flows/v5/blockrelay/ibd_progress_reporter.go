@@ -38,6 +38,8 @@ func (ipr *ibdProgressReporter) reportProgress(processedDelta int, highestProces
 		relativeDAAScore = highestProcessedDAAScore - ipr.lowDAAScore
 	}
 	progressPercent := int((float64(relativeDAAScore) / float64(ipr.totalDAAScoreDifference)) * 100)
+	ibdProgressPercent.WithLabelValue(ipr.objectName).Set(float64(progressPercent))
+	ibdProcessedObjects.WithLabelValue(ipr.objectName).Set(float64(ipr.processed))
 	if progressPercent > ipr.lastReportedProgressPercent {
 		log.Infof("IBD: Processed %d %s (%d%%)", ipr.processed, ipr.objectName, progressPercent)
 		ipr.lastReportedProgressPercent = progressPercent
@@ -0,0 +1,12 @@
+package blockrelay
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
+)
+
+var (
+	ibdProgressPercent = metrics.NewGaugeVec("kaspad_ibd_progress_percent",
+		"The progress of the latest IBD, per type of synced object", "object")
+	ibdProcessedObjects = metrics.NewGaugeVec("kaspad_ibd_processed_objects",
+		"The number of objects processed during the latest IBD, per type of synced object", "object")
+)
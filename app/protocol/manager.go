@@ -68,6 +68,14 @@ func (m *Manager) AddTransaction(tx *externalapi.DomainTransaction, allowOrphan
 	return m.context.AddTransaction(tx, allowOrphan)
 }
 
+// AddTransactionReplacement adds the given transaction to the mempool, replacing the
+// transactions it double spends, and propagates it.
+func (m *Manager) AddTransactionReplacement(tx *externalapi.DomainTransaction) (
+	replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	return m.context.AddTransactionReplacement(tx)
+}
+
 // AddBlock adds the given block to the DAG and propagates it.
 func (m *Manager) AddBlock(block *externalapi.DomainBlock) error {
 	return m.context.AddBlock(block)
@@ -3,9 +3,13 @@ package rpc
 import (
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/protocol"
+	"github.com/kaspanet/kaspad/app/rpc/notificationjournal"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
 	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/addressindex"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+	"github.com/kaspanet/kaspad/domain/txindex"
 	"github.com/kaspanet/kaspad/domain/utxoindex"
 	"github.com/kaspanet/kaspad/infrastructure/config"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
@@ -29,7 +33,11 @@ func NewManager(
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	addressIndex *addressindex.AddressIndex,
+	notificationJournal *notificationjournal.Journal,
 	consensusEventsChan chan externalapi.ConsensusEvent,
+	mempoolEventsChan chan *miningmanagermodel.MempoolChangeSet,
 	shutDownChan chan<- struct{}) *Manager {
 
 	manager := Manager{
@@ -41,12 +49,16 @@ func NewManager(
 			connectionManager,
 			addressManager,
 			utxoIndex,
+			txIndex,
+			addressIndex,
+			notificationJournal,
 			shutDownChan,
 		),
 	}
 	netAdapter.SetRPCRouterInitializer(manager.routerInitializer)
 
 	manager.initConsensusEventsHandler(consensusEventsChan)
+	manager.initMempoolEventsHandler(mempoolEventsChan)
 
 	return &manager
 }
@@ -69,6 +81,16 @@ func (m *Manager) initConsensusEventsHandler(consensusEventsChan chan externalap
 				if err != nil {
 					panic(err)
 				}
+			case *externalapi.FinalityConflict:
+				err := m.NotifyFinalityConflict(event.ViolatingBlockHash.String(), event.FinalityBlockHash.String())
+				if err != nil {
+					panic(err)
+				}
+			case *externalapi.FinalityConflictResolved:
+				err := m.NotifyFinalityConflictResolved(event.FinalityBlockHash.String())
+				if err != nil {
+					panic(err)
+				}
 			default:
 				panic(errors.Errorf("Got event of unsupported type %T", consensusEvent))
 			}
@@ -76,6 +98,52 @@ func (m *Manager) initConsensusEventsHandler(consensusEventsChan chan externalap
 	})
 }
 
+func (m *Manager) initMempoolEventsHandler(mempoolEventsChan chan *miningmanagermodel.MempoolChangeSet) {
+	spawn("mempoolEventsHandler", func() {
+		for {
+			mempoolChangeSet, ok := <-mempoolEventsChan
+			if !ok {
+				return
+			}
+			err := m.notifyMempoolChanged(mempoolChangeSet)
+			if err != nil {
+				panic(err)
+			}
+		}
+	})
+}
+
+// notifyMempoolChanged notifies the manager that transactions were added to or removed from the mempool
+func (m *Manager) notifyMempoolChanged(mempoolChangeSet *miningmanagermodel.MempoolChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.notifyMempoolChanged")
+	defer onEnd()
+
+	// Before converting and populating the transactions, we check if any listeners are interested.
+	// This is done since most nodes do not use this event.
+	if !m.context.NotificationManager.HasMempoolChangedListeners() {
+		return nil
+	}
+
+	changes := make([]*appmessage.MempoolChange, len(mempoolChangeSet.Changes))
+	for i, change := range mempoolChangeSet.Changes {
+		rpcTransaction := appmessage.DomainTransactionToRPCTransaction(change.Transaction)
+		err := m.context.PopulateTransactionWithVerboseData(rpcTransaction, nil)
+		if err != nil {
+			return err
+		}
+		changes[i] = &appmessage.MempoolChange{
+			Entry: &appmessage.MempoolEntry{
+				Fee:         change.Transaction.Fee,
+				Transaction: rpcTransaction,
+				IsOrphan:    change.IsOrphan,
+			},
+			IsRemoved:     change.IsRemoved,
+			RemovalReason: appmessage.MempoolRemovalReason(change.RemovalReason),
+		}
+	}
+	return m.context.NotificationManager.NotifyMempoolChanged(mempoolChangeSet, changes)
+}
+
 // notifyBlockAddedToDAG notifies the manager that a block has been added to the DAG
 func (m *Manager) notifyBlockAddedToDAG(block *externalapi.DomainBlock) error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.notifyBlockAddedToDAG")
@@ -113,6 +181,20 @@ func (m *Manager) notifyVirtualChange(virtualChangeSet *externalapi.VirtualChang
 		}
 	}
 
+	if m.context.Config.TXIndex {
+		err := m.updateTXIndex(virtualChangeSet)
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.context.Config.AddressIndex {
+		err := m.updateAddressIndex(virtualChangeSet)
+		if err != nil {
+			return err
+		}
+	}
+
 	err := m.notifyVirtualSelectedParentBlueScoreChanged(virtualChangeSet.VirtualSelectedParentBlueScore)
 	if err != nil {
 		return err
@@ -151,6 +233,27 @@ func (m *Manager) NotifyPruningPointUTXOSetOverride() error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.NotifyPruningPointUTXOSetOverride")
 	defer onEnd()
 
+	if m.context.Config.TXIndex {
+		err := m.context.TXIndex.Reset()
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.context.Config.AddressIndex {
+		err := m.context.AddressIndex.Reset()
+		if err != nil {
+			return err
+		}
+	}
+
+	// The journaled notifications describe a DAG that was replaced, so
+	// clients can't resume from them
+	err := m.context.NotificationJournal.Clear()
+	if err != nil {
+		return err
+	}
+
 	if m.context.Config.UTXOIndex {
 		err := m.notifyPruningPointUTXOSetOverride()
 		if err != nil {
@@ -162,11 +265,11 @@ func (m *Manager) NotifyPruningPointUTXOSetOverride() error {
 }
 
 // NotifyFinalityConflict notifies the manager that there's a finality conflict in the DAG
-func (m *Manager) NotifyFinalityConflict(violatingBlockHash string) error {
+func (m *Manager) NotifyFinalityConflict(violatingBlockHash string, finalityBlockHash string) error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.NotifyFinalityConflict")
 	defer onEnd()
 
-	notification := appmessage.NewFinalityConflictNotificationMessage(violatingBlockHash)
+	notification := appmessage.NewFinalityConflictNotificationMessage(violatingBlockHash, finalityBlockHash)
 	return m.context.NotificationManager.NotifyFinalityConflict(notification)
 }
 
@@ -191,6 +294,20 @@ func (m *Manager) notifyUTXOsChanged(virtualChangeSet *externalapi.VirtualChange
 	return m.context.NotificationManager.NotifyUTXOsChanged(utxoIndexChanges)
 }
 
+func (m *Manager) updateTXIndex(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.updateTXIndex")
+	defer onEnd()
+
+	return m.context.TXIndex.Update(virtualChangeSet)
+}
+
+func (m *Manager) updateAddressIndex(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.updateAddressIndex")
+	defer onEnd()
+
+	return m.context.AddressIndex.Update(virtualChangeSet)
+}
+
 func (m *Manager) notifyPruningPointUTXOSetOverride() error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.notifyPruningPointUTXOSetOverride")
 	defer onEnd()
@@ -225,6 +342,13 @@ func (m *Manager) notifyVirtualSelectedParentChainChanged(virtualChangeSet *exte
 
 	hasListeners, includeAcceptedTransactionIDs := m.context.NotificationManager.HasListenersThatPropagateVirtualSelectedParentChainChanged()
 
+	// Journaled notifications always include the accepted transaction IDs, since
+	// clients that resume their notifications may require them
+	if m.context.NotificationJournal.IsEnabled() {
+		hasListeners = true
+		includeAcceptedTransactionIDs = true
+	}
+
 	if hasListeners {
 		notification, err := m.context.ConvertVirtualSelectedParentChainChangesToChainChangedNotificationMessage(
 			virtualChangeSet.VirtualSelectedParentChainChanges, includeAcceptedTransactionIDs)
@@ -0,0 +1,8 @@
+package rpc
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
+)
+
+var requestDuration = metrics.NewHistogramVec("kaspad_rpc_request_duration_seconds",
+	"The time it takes to handle an RPC request, per method", "method", metrics.DefaultLatencyBuckets)
@@ -0,0 +1,290 @@
+// Package notificationjournal implements a bounded on-disk journal of the
+// chain and UTXO notifications sent by the RPC server.
+//
+// Every journaled notification gets a sequence number that is one larger than
+// the sequence of the notification journaled before it. A client that
+// reconnects may ask for every notification after the last sequence it has
+// seen, as long as they haven't been pruned from the journal yet.
+package notificationjournal
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+var journalBucket = database.MakeBucket([]byte("notification-journal"))
+var lastSequenceKey = database.MakeBucket([]byte("")).Key([]byte("notification-journal-last-sequence"))
+
+// ErrNotInJournal indicates that the requested notifications were either
+// pruned from the journal or never journaled to begin with
+var ErrNotInJournal = errors.New("notifications are not in the journal")
+
+// Entry is a journaled notification alongside its sequence
+type Entry struct {
+	Sequence     uint64
+	Notification appmessage.Message
+}
+
+// Journal is a bounded on-disk journal of notifications
+type Journal struct {
+	mutex    sync.Mutex
+	database database.Database
+	maxSize  uint64
+
+	// The journal holds the notifications with sequences between
+	// oldestSequence and lastSequence, inclusive. When it's empty,
+	// oldestSequence is lastSequence + 1
+	oldestSequence uint64
+	lastSequence   uint64
+}
+
+// New creates a journal that holds up to maxSize notifications. A maxSize of 0
+// disables the journal, in which case the notifications of previous runs are
+// deleted, since the journal won't have the notifications sent until it's
+// enabled again.
+func New(database database.Database, maxSize uint64) (*Journal, error) {
+	journal := &Journal{
+		database: database,
+		maxSize:  maxSize,
+	}
+
+	lastSequence, err := journal.loadLastSequence()
+	if err != nil {
+		return nil, err
+	}
+	journal.lastSequence = lastSequence
+
+	oldestSequence, err := journal.loadOldestSequence()
+	if err != nil {
+		return nil, err
+	}
+	journal.oldestSequence = oldestSequence
+
+	if !journal.IsEnabled() {
+		err := journal.Clear()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return journal, nil
+}
+
+// IsEnabled returns whether notifications are journaled
+func (j *Journal) IsEnabled() bool {
+	return j.maxSize > 0
+}
+
+// Append journals the given notification and returns its sequence. The oldest
+// notifications are pruned if the journal grows beyond its maximum size.
+// Append does nothing and returns 0 if the journal is disabled.
+func (j *Journal) Append(notification appmessage.Message) (uint64, error) {
+	if !j.IsEnabled() {
+		return 0, nil
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	serializedNotification, err := serializeNotification(notification)
+	if err != nil {
+		return 0, err
+	}
+
+	dbTransaction, err := j.database.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	sequence := j.lastSequence + 1
+	err = dbTransaction.Put(entryKey(sequence), serializedNotification)
+	if err != nil {
+		return 0, err
+	}
+	err = dbTransaction.Put(lastSequenceKey, serializeSequence(sequence))
+	if err != nil {
+		return 0, err
+	}
+
+	oldestSequence := j.oldestSequence
+	for ; sequence-oldestSequence+1 > j.maxSize; oldestSequence++ {
+		err := dbTransaction.Delete(entryKey(oldestSequence))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = dbTransaction.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	j.lastSequence = sequence
+	j.oldestSequence = oldestSequence
+	return sequence, nil
+}
+
+// EntriesAfter returns all the journaled notifications whose sequence is larger
+// than the given one, ordered by their sequence. It returns ErrNotInJournal if
+// any of them is no longer in the journal, or if the given sequence was never
+// journaled.
+func (j *Journal) EntriesAfter(sequence uint64) ([]*Entry, error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if sequence > j.lastSequence {
+		return nil, errors.Wrapf(ErrNotInJournal, "sequence %d is after the last journaled sequence %d",
+			sequence, j.lastSequence)
+	}
+	if sequence+1 < j.oldestSequence {
+		return nil, errors.Wrapf(ErrNotInJournal, "the notifications after sequence %d were pruned from "+
+			"the journal, whose oldest sequence is %d", sequence, j.oldestSequence)
+	}
+
+	entries := make([]*Entry, 0, j.lastSequence-sequence)
+	for entrySequence := sequence + 1; entrySequence <= j.lastSequence; entrySequence++ {
+		notification, err := j.getNotification(entrySequence)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &Entry{Sequence: entrySequence, Notification: notification})
+	}
+	return entries, nil
+}
+
+// SequenceOfChainBlock returns the sequence of the latest journaled
+// VirtualSelectedParentChainChanged notification that added the given
+// chain block. It returns ErrNotInJournal if there's no such notification.
+func (j *Journal) SequenceOfChainBlock(chainBlockHash string) (uint64, error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	for sequence := j.lastSequence; sequence >= j.oldestSequence && sequence > 0; sequence-- {
+		notification, err := j.getNotification(sequence)
+		if err != nil {
+			return 0, err
+		}
+		chainChangedNotification, ok := notification.(*appmessage.VirtualSelectedParentChainChangedNotificationMessage)
+		if !ok {
+			continue
+		}
+		for _, addedChainBlockHash := range chainChangedNotification.AddedChainBlockHashes {
+			if addedChainBlockHash == chainBlockHash {
+				return sequence, nil
+			}
+		}
+	}
+	return 0, errors.Wrapf(ErrNotInJournal, "chain block %s is not in the journal", chainBlockHash)
+}
+
+// Clear deletes all the journaled notifications. Sequences keep increasing
+// after the journal is cleared, so that clients can't resume from a sequence
+// they've seen before it.
+func (j *Journal) Clear() error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	dbTransaction, err := j.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	for sequence := j.oldestSequence; sequence <= j.lastSequence; sequence++ {
+		err := dbTransaction.Delete(entryKey(sequence))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = dbTransaction.Commit()
+	if err != nil {
+		return err
+	}
+
+	j.oldestSequence = j.lastSequence + 1
+	return nil
+}
+
+func (j *Journal) getNotification(sequence uint64) (appmessage.Message, error) {
+	serializedNotification, err := j.database.Get(entryKey(sequence))
+	if err != nil {
+		return nil, err
+	}
+	return deserializeNotification(serializedNotification)
+}
+
+func (j *Journal) loadLastSequence() (uint64, error) {
+	serializedSequence, err := j.database.Get(lastSequenceKey)
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return deserializeSequence(serializedSequence)
+}
+
+func (j *Journal) loadOldestSequence() (uint64, error) {
+	cursor, err := j.database.Cursor(journalBucket)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close()
+
+	if !cursor.First() {
+		return j.lastSequence + 1, nil
+	}
+	key, err := cursor.Key()
+	if err != nil {
+		return 0, err
+	}
+	return deserializeSequence(key.Suffix())
+}
+
+// entryKey returns the key of the entry with the given sequence. Sequences are
+// serialized as big endian so that the entries are ordered by their sequence
+func entryKey(sequence uint64) *database.Key {
+	return journalBucket.Key(serializeSequence(sequence))
+}
+
+func serializeSequence(sequence uint64) []byte {
+	serializedSequence := make([]byte, 8)
+	binary.BigEndian.PutUint64(serializedSequence, sequence)
+	return serializedSequence
+}
+
+func deserializeSequence(serializedSequence []byte) (uint64, error) {
+	if len(serializedSequence) != 8 {
+		return 0, errors.Errorf("invalid serialized sequence length %d", len(serializedSequence))
+	}
+	return binary.BigEndian.Uint64(serializedSequence), nil
+}
+
+func serializeNotification(notification appmessage.Message) ([]byte, error) {
+	protoMessage, err := protowire.FromAppMessage(notification)
+	if err != nil {
+		return nil, err
+	}
+	serializedNotification, err := proto.Marshal(protoMessage)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return serializedNotification, nil
+}
+
+func deserializeNotification(serializedNotification []byte) (appmessage.Message, error) {
+	protoMessage := &protowire.KaspadMessage{}
+	err := proto.Unmarshal(serializedNotification, protoMessage)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed deserializing a journaled notification")
+	}
+	return protoMessage.ToAppMessage()
+}
@@ -0,0 +1,141 @@
+package notificationjournal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/memorydb"
+	"github.com/pkg/errors"
+)
+
+func chainChangedNotification(i int) *appmessage.VirtualSelectedParentChainChangedNotificationMessage {
+	return appmessage.NewVirtualSelectedParentChainChangedNotificationMessage(
+		[]string{}, []string{fmt.Sprintf("%064x", i)}, nil)
+}
+
+func TestJournal(t *testing.T) {
+	database := memorydb.NewMemoryDB()
+	const maxSize = 5
+	journal, err := New(database, maxSize)
+	if err != nil {
+		t.Fatalf("New: %+v", err)
+	}
+
+	// Journal chain changed notifications for the odd i's and UTXOs changed
+	// notifications for the even ones
+	const notificationAmount = 8
+	for i := 1; i <= notificationAmount; i++ {
+		var notification appmessage.Message = chainChangedNotification(i)
+		if i%2 == 0 {
+			notification = appmessage.NewUTXOsChangedNotificationMessage()
+		}
+		sequence, err := journal.Append(notification)
+		if err != nil {
+			t.Fatalf("Append: %+v", err)
+		}
+		if sequence != uint64(i) {
+			t.Fatalf("Expected sequence %d, got %d", i, sequence)
+		}
+	}
+
+	// Only the last maxSize notifications are expected to remain in the journal
+	for _, sequence := range []uint64{0, 1, 2} {
+		_, err = journal.EntriesAfter(sequence)
+		if !errors.Is(err, ErrNotInJournal) {
+			t.Fatalf("Expected ErrNotInJournal after sequence %d, got %v", sequence, err)
+		}
+	}
+	_, err = journal.EntriesAfter(notificationAmount + 1)
+	if !errors.Is(err, ErrNotInJournal) {
+		t.Fatalf("Expected ErrNotInJournal for a future sequence, got %v", err)
+	}
+
+	entries, err := journal.EntriesAfter(5)
+	if err != nil {
+		t.Fatalf("EntriesAfter: %+v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d", len(entries))
+	}
+	for i, entry := range entries {
+		expectedSequence := uint64(6 + i)
+		if entry.Sequence != expectedSequence {
+			t.Fatalf("Expected sequence %d at position %d, got %d", expectedSequence, i, entry.Sequence)
+		}
+		_, isChainChanged := entry.Notification.(*appmessage.VirtualSelectedParentChainChangedNotificationMessage)
+		if isChainChanged != (expectedSequence%2 == 1) {
+			t.Fatalf("Unexpected notification %s with sequence %d", entry.Notification.Command(), entry.Sequence)
+		}
+	}
+	entries, err = journal.EntriesAfter(notificationAmount)
+	if err != nil {
+		t.Fatalf("EntriesAfter: %+v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("Expected no entries after the last sequence, got %d", len(entries))
+	}
+
+	sequence, err := journal.SequenceOfChainBlock(chainChangedNotification(7).AddedChainBlockHashes[0])
+	if err != nil {
+		t.Fatalf("SequenceOfChainBlock: %+v", err)
+	}
+	if sequence != 7 {
+		t.Fatalf("Expected chain block sequence 7, got %d", sequence)
+	}
+	_, err = journal.SequenceOfChainBlock(chainChangedNotification(1).AddedChainBlockHashes[0])
+	if !errors.Is(err, ErrNotInJournal) {
+		t.Fatalf("Expected ErrNotInJournal for a pruned chain block, got %v", err)
+	}
+
+	// Reopening the journal is expected to keep both its notifications and its sequence
+	journal, err = New(database, maxSize)
+	if err != nil {
+		t.Fatalf("New: %+v", err)
+	}
+	entries, err = journal.EntriesAfter(3)
+	if err != nil {
+		t.Fatalf("EntriesAfter: %+v", err)
+	}
+	if len(entries) != maxSize {
+		t.Fatalf("Expected %d entries after reopening the journal, got %d", maxSize, len(entries))
+	}
+
+	// Clearing the journal is expected to keep its sequence
+	err = journal.Clear()
+	if err != nil {
+		t.Fatalf("Clear: %+v", err)
+	}
+	_, err = journal.EntriesAfter(7)
+	if !errors.Is(err, ErrNotInJournal) {
+		t.Fatalf("Expected ErrNotInJournal after clearing the journal, got %v", err)
+	}
+	sequence, err = journal.Append(chainChangedNotification(notificationAmount + 1))
+	if err != nil {
+		t.Fatalf("Append: %+v", err)
+	}
+	if sequence != notificationAmount+1 {
+		t.Fatalf("Expected sequence %d after clearing the journal, got %d", notificationAmount+1, sequence)
+	}
+
+	// Disabling the journal is expected to delete its notifications
+	journal, err = New(database, 0)
+	if err != nil {
+		t.Fatalf("New: %+v", err)
+	}
+	sequence, err = journal.Append(chainChangedNotification(notificationAmount + 2))
+	if err != nil {
+		t.Fatalf("Append: %+v", err)
+	}
+	if sequence != 0 {
+		t.Fatalf("Expected sequence 0 from a disabled journal, got %d", sequence)
+	}
+	journal, err = New(database, maxSize)
+	if err != nil {
+		t.Fatalf("New: %+v", err)
+	}
+	_, err = journal.EntriesAfter(notificationAmount)
+	if !errors.Is(err, ErrNotInJournal) {
+		t.Fatalf("Expected ErrNotInJournal after disabling the journal, got %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package rpc
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
+)
+
+// handlerRoles maps every RPC request to the role that is required in order to call it
+var handlerRoles = map[appmessage.MessageCommand]rpcauth.Role{
+	appmessage.CmdGetCurrentNetworkRequestMessage:                           rpcauth.RoleReadOnly,
+	appmessage.CmdSubmitBlockRequestMessage:                                 rpcauth.RoleMining,
+	appmessage.CmdGetBlockTemplateRequestMessage:                            rpcauth.RoleMining,
+	appmessage.CmdNotifyBlockAddedRequestMessage:                            rpcauth.RoleReadOnly,
+	appmessage.CmdGetPeerAddressesRequestMessage:                            rpcauth.RoleAdmin,
+	appmessage.CmdGetSelectedTipHashRequestMessage:                          rpcauth.RoleReadOnly,
+	appmessage.CmdGetMempoolEntryRequestMessage:                             rpcauth.RoleReadOnly,
+	appmessage.CmdGetConnectedPeerInfoRequestMessage:                        rpcauth.RoleAdmin,
+	appmessage.CmdAddPeerRequestMessage:                                     rpcauth.RoleAdmin,
+	appmessage.CmdSubmitTransactionRequestMessage:                           rpcauth.RoleWalletSubmit,
+	appmessage.CmdNotifyVirtualSelectedParentChainChangedRequestMessage:     rpcauth.RoleReadOnly,
+	appmessage.CmdGetBlockRequestMessage:                                    rpcauth.RoleReadOnly,
+	appmessage.CmdGetSubnetworkRequestMessage:                               rpcauth.RoleReadOnly,
+	appmessage.CmdGetVirtualSelectedParentChainFromBlockRequestMessage:      rpcauth.RoleReadOnly,
+	appmessage.CmdGetBlocksRequestMessage:                                   rpcauth.RoleReadOnly,
+	appmessage.CmdGetBlockCountRequestMessage:                               rpcauth.RoleReadOnly,
+	appmessage.CmdGetBalanceByAddressRequestMessage:                         rpcauth.RoleReadOnly,
+	appmessage.CmdGetBlockDAGInfoRequestMessage:                             rpcauth.RoleReadOnly,
+	appmessage.CmdResolveFinalityConflictRequestMessage:                     rpcauth.RoleAdmin,
+	appmessage.CmdNotifyFinalityConflictsRequestMessage:                     rpcauth.RoleReadOnly,
+	appmessage.CmdGetMempoolEntriesRequestMessage:                           rpcauth.RoleReadOnly,
+	appmessage.CmdShutDownRequestMessage:                                    rpcauth.RoleAdmin,
+	appmessage.CmdGetHeadersRequestMessage:                                  rpcauth.RoleReadOnly,
+	appmessage.CmdNotifyUTXOsChangedRequestMessage:                          rpcauth.RoleReadOnly,
+	appmessage.CmdStopNotifyingUTXOsChangedRequestMessage:                   rpcauth.RoleReadOnly,
+	appmessage.CmdGetUTXOsByAddressesRequestMessage:                         rpcauth.RoleReadOnly,
+	appmessage.CmdGetBalancesByAddressesRequestMessage:                      rpcauth.RoleReadOnly,
+	appmessage.CmdGetVirtualSelectedParentBlueScoreRequestMessage:           rpcauth.RoleReadOnly,
+	appmessage.CmdNotifyVirtualSelectedParentBlueScoreChangedRequestMessage: rpcauth.RoleReadOnly,
+	appmessage.CmdBanRequestMessage:                                         rpcauth.RoleAdmin,
+	appmessage.CmdUnbanRequestMessage:                                       rpcauth.RoleAdmin,
+	appmessage.CmdGetInfoRequestMessage:                                     rpcauth.RoleReadOnly,
+	appmessage.CmdNotifyPruningPointUTXOSetOverrideRequestMessage:           rpcauth.RoleReadOnly,
+	appmessage.CmdStopNotifyingPruningPointUTXOSetOverrideRequestMessage:    rpcauth.RoleReadOnly,
+	appmessage.CmdEstimateNetworkHashesPerSecondRequestMessage:              rpcauth.RoleReadOnly,
+	appmessage.CmdNotifyVirtualDaaScoreChangedRequestMessage:                rpcauth.RoleReadOnly,
+	appmessage.CmdNotifyNewBlockTemplateRequestMessage:                      rpcauth.RoleMining,
+	appmessage.CmdGetCoinSupplyRequestMessage:                               rpcauth.RoleReadOnly,
+	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage:                rpcauth.RoleReadOnly,
+	appmessage.CmdGetTransactionRequestMessage:                              rpcauth.RoleReadOnly,
+	appmessage.CmdGetTransactionAcceptanceInfoRequestMessage:                rpcauth.RoleReadOnly,
+	appmessage.CmdSubmitTransactionReplacementRequestMessage:                rpcauth.RoleWalletSubmit,
+	appmessage.CmdGetFeeEstimateRequestMessage:                              rpcauth.RoleReadOnly,
+	appmessage.CmdSaveMempoolRequestMessage:                                 rpcauth.RoleAdmin,
+	appmessage.CmdLoadMempoolRequestMessage:                                 rpcauth.RoleAdmin,
+	appmessage.CmdNotifyMempoolChangedRequestMessage:                        rpcauth.RoleReadOnly,
+	appmessage.CmdGetTransactionsByAddressRequestMessage:                    rpcauth.RoleReadOnly,
+	appmessage.CmdStreamUTXOsByAddressesRequestMessage:                      rpcauth.RoleReadOnly,
+}
@@ -0,0 +1,100 @@
+package rpc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestHandlerRoles(t *testing.T) {
+	for command := range handlers {
+		if _, ok := handlerRoles[command]; !ok {
+			t.Errorf("%s has no required role", command)
+		}
+	}
+	for command := range handlerRoles {
+		if _, ok := handlers[command]; !ok {
+			t.Errorf("%s has a required role but no handler", command)
+		}
+	}
+}
+
+func TestHandlerRoleAssignments(t *testing.T) {
+	expectedRoles := map[appmessage.MessageCommand]rpcauth.Role{
+		appmessage.CmdGetBlockDAGInfoRequestMessage:                rpcauth.RoleReadOnly,
+		appmessage.CmdEstimateNetworkHashesPerSecondRequestMessage: rpcauth.RoleReadOnly,
+		appmessage.CmdGetFeeEstimateRequestMessage:                 rpcauth.RoleReadOnly,
+		appmessage.CmdGetBlockTemplateRequestMessage:               rpcauth.RoleMining,
+		appmessage.CmdSubmitBlockRequestMessage:                    rpcauth.RoleMining,
+		appmessage.CmdSubmitTransactionRequestMessage:              rpcauth.RoleWalletSubmit,
+		appmessage.CmdSubmitTransactionReplacementRequestMessage:   rpcauth.RoleWalletSubmit,
+		appmessage.CmdAddPeerRequestMessage:                        rpcauth.RoleAdmin,
+		appmessage.CmdBanRequestMessage:                            rpcauth.RoleAdmin,
+		appmessage.CmdShutDownRequestMessage:                       rpcauth.RoleAdmin,
+		appmessage.CmdResolveFinalityConflictRequestMessage:        rpcauth.RoleAdmin,
+	}
+	for command, expectedRole := range expectedRoles {
+		role, ok := handlerRoles[command]
+		if !ok {
+			t.Errorf("%s has no required role", command)
+			continue
+		}
+		if role != expectedRole {
+			t.Errorf("%s requires the %s role, but is expected to require the %s role", command, role, expectedRole)
+		}
+	}
+}
+
+func TestAccessDeniedResponses(t *testing.T) {
+	// These requests can't be converted without some of their fields set
+	transaction := &appmessage.RPCTransaction{SubnetworkID: "0000000000000000000000000000000000000000"}
+	requests := []appmessage.Message{
+		appmessage.NewSubmitBlockRequestMessage(&appmessage.RPCBlock{Header: &appmessage.RPCBlockHeader{}}, false),
+		appmessage.NewSubmitTransactionRequestMessage(transaction, false),
+		appmessage.NewSubmitTransactionReplacementRequestMessage(transaction),
+	}
+
+	payloadOneof := (&protowire.KaspadMessage{}).ProtoReflect().Descriptor().Oneofs().ByName("payload")
+	payloadFields := payloadOneof.Fields()
+	for i := 0; i < payloadFields.Len(); i++ {
+		field := payloadFields.Get(i)
+		if !strings.HasSuffix(string(field.Name()), "Request") {
+			continue
+		}
+		message := &protowire.KaspadMessage{}
+		message.ProtoReflect().Set(field, protoreflect.ValueOfMessage(message.ProtoReflect().NewField(field).Message()))
+		request, err := message.ToAppMessage()
+		if err != nil {
+			continue
+		}
+		requests = append(requests, request)
+	}
+
+	testedCommands := make(map[appmessage.MessageCommand]struct{})
+	for _, request := range requests {
+		if _, ok := handlers[request.Command()]; !ok {
+			continue
+		}
+		response, err := protowire.NewRPCErrorResponse(request, "access denied")
+		if err != nil {
+			t.Fatalf("NewRPCErrorResponse for %s: %s", request.Command(), err)
+		}
+		errorField := reflect.ValueOf(response).Elem().FieldByName("Error")
+		rpcError, ok := errorField.Interface().(*appmessage.RPCError)
+		if !ok || rpcError == nil || rpcError.Message != "access denied" {
+			t.Fatalf("The response of %s does not have the expected error", request.Command())
+		}
+		testedCommands[request.Command()] = struct{}{}
+	}
+
+	for command := range handlers {
+		if _, ok := testedCommands[command]; !ok {
+			t.Errorf("No access denied response was tested for %s", command)
+		}
+	}
+}
@@ -1,11 +1,15 @@
 package rpc
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
 	"github.com/kaspanet/kaspad/app/rpc/rpchandlers"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/pkg/errors"
 )
 
@@ -51,6 +55,15 @@ var handlers = map[appmessage.MessageCommand]handler{
 	appmessage.CmdNotifyNewBlockTemplateRequestMessage:                      rpchandlers.HandleNotifyNewBlockTemplate,
 	appmessage.CmdGetCoinSupplyRequestMessage:                               rpchandlers.HandleGetCoinSupply,
 	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage:                rpchandlers.HandleGetMempoolEntriesByAddresses,
+	appmessage.CmdGetTransactionRequestMessage:                              rpchandlers.HandleGetTransaction,
+	appmessage.CmdGetTransactionAcceptanceInfoRequestMessage:                rpchandlers.HandleGetTransactionAcceptanceInfo,
+	appmessage.CmdSubmitTransactionReplacementRequestMessage:                rpchandlers.HandleSubmitTransactionReplacement,
+	appmessage.CmdGetFeeEstimateRequestMessage:                              rpchandlers.HandleGetFeeEstimate,
+	appmessage.CmdSaveMempoolRequestMessage:                                 rpchandlers.HandleSaveMempool,
+	appmessage.CmdLoadMempoolRequestMessage:                                 rpchandlers.HandleLoadMempool,
+	appmessage.CmdNotifyMempoolChangedRequestMessage:                        rpchandlers.HandleNotifyMempoolChanged,
+	appmessage.CmdGetTransactionsByAddressRequestMessage:                    rpchandlers.HandleGetTransactionsByAddress,
+	appmessage.CmdStreamUTXOsByAddressesRequestMessage:                      rpchandlers.HandleStreamUTXOsByAddresses,
 }
 
 func (m *Manager) routerInitializer(router *router.Router, netConnection *netadapter.NetConnection) {
@@ -67,12 +80,14 @@ func (m *Manager) routerInitializer(router *router.Router, netConnection *netada
 	spawn("routerInitializer-handleIncomingMessages", func() {
 		defer m.context.NotificationManager.RemoveListener(router)
 
-		err := m.handleIncomingMessages(router, incomingRoute)
+		err := m.handleIncomingMessages(router, incomingRoute, netConnection)
 		m.handleError(err, netConnection)
 	})
 }
 
-func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *router.Route) error {
+func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *router.Route,
+	netConnection *netadapter.NetConnection) error {
+
 	outgoingRoute := router.OutgoingRoute()
 	for {
 		request, err := incomingRoute.Dequeue()
@@ -83,10 +98,26 @@ func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *r
 		if !ok {
 			return err
 		}
-		response, err := handler(m.context, router, request)
+		method := appmessage.RPCMessageCommandToString[request.Command()]
+		start := time.Now()
+		var response appmessage.Message
+		requiredRole := handlerRoles[request.Command()]
+		if netConnection.RPCRoles().Allows(requiredRole) {
+			response, err = handler(m.context, router, request)
+		} else {
+			log.Warnf("Rejected %s from %s: the %s role is required", method, netConnection, requiredRole)
+			response, err = protowire.NewRPCErrorResponse(request,
+				fmt.Sprintf("Access denied: %s requires the %s role", method, requiredRole))
+		}
 		if err != nil {
 			return err
 		}
+		requestDuration.WithLabelValue(method).ObserveDuration(start)
+		// Handlers that have to send messages right after their response,
+		// such as replayed notifications, send the response themselves
+		if response == nil {
+			continue
+		}
 		err = outgoingRoute.Enqueue(response)
 		if err != nil {
 			return err
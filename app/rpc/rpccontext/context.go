@@ -2,7 +2,10 @@ package rpccontext
 
 import (
 	"github.com/kaspanet/kaspad/app/protocol"
+	"github.com/kaspanet/kaspad/app/rpc/notificationjournal"
 	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/addressindex"
+	"github.com/kaspanet/kaspad/domain/txindex"
 	"github.com/kaspanet/kaspad/domain/utxoindex"
 	"github.com/kaspanet/kaspad/infrastructure/config"
 	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
@@ -12,14 +15,17 @@ import (
 
 // Context represents the RPC context
 type Context struct {
-	Config            *config.Config
-	NetAdapter        *netadapter.NetAdapter
-	Domain            domain.Domain
-	ProtocolManager   *protocol.Manager
-	ConnectionManager *connmanager.ConnectionManager
-	AddressManager    *addressmanager.AddressManager
-	UTXOIndex         *utxoindex.UTXOIndex
-	ShutDownChan      chan<- struct{}
+	Config              *config.Config
+	NetAdapter          *netadapter.NetAdapter
+	Domain              domain.Domain
+	ProtocolManager     *protocol.Manager
+	ConnectionManager   *connmanager.ConnectionManager
+	AddressManager      *addressmanager.AddressManager
+	UTXOIndex           *utxoindex.UTXOIndex
+	TXIndex             *txindex.TXIndex
+	AddressIndex        *addressindex.AddressIndex
+	NotificationJournal *notificationjournal.Journal
+	ShutDownChan        chan<- struct{}
 
 	NotificationManager *NotificationManager
 }
@@ -32,19 +38,25 @@ func NewContext(cfg *config.Config,
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	addressIndex *addressindex.AddressIndex,
+	notificationJournal *notificationjournal.Journal,
 	shutDownChan chan<- struct{}) *Context {
 
 	context := &Context{
-		Config:            cfg,
-		NetAdapter:        netAdapter,
-		Domain:            domain,
-		ProtocolManager:   protocolManager,
-		ConnectionManager: connectionManager,
-		AddressManager:    addressManager,
-		UTXOIndex:         utxoIndex,
-		ShutDownChan:      shutDownChan,
+		Config:              cfg,
+		NetAdapter:          netAdapter,
+		Domain:              domain,
+		ProtocolManager:     protocolManager,
+		ConnectionManager:   connectionManager,
+		AddressManager:      addressManager,
+		UTXOIndex:           utxoIndex,
+		TXIndex:             txIndex,
+		AddressIndex:        addressIndex,
+		NotificationJournal: notificationJournal,
+		ShutDownChan:        shutDownChan,
 	}
-	context.NotificationManager = NewNotificationManager(cfg.ActiveNetParams)
+	context.NotificationManager = NewNotificationManager(cfg.ActiveNetParams, notificationJournal)
 
 	return context
 }
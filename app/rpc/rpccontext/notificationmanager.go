@@ -3,7 +3,9 @@ package rpccontext
 import (
 	"sync"
 
+	"github.com/kaspanet/kaspad/app/rpc/notificationjournal"
 	"github.com/kaspanet/kaspad/domain/dagconfig"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
 
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
@@ -19,6 +21,7 @@ type NotificationManager struct {
 	sync.RWMutex
 	listeners map[*routerpkg.Router]*NotificationListener
 	params    *dagconfig.Params
+	journal   *notificationjournal.Journal
 }
 
 // UTXOsChangedNotificationAddress represents a kaspad address.
@@ -41,16 +44,19 @@ type NotificationListener struct {
 	propagateVirtualDaaScoreChangedNotifications                bool
 	propagatePruningPointUTXOSetOverrideNotifications           bool
 	propagateNewBlockTemplateNotifications                      bool
+	propagateMempoolChangedNotifications                        bool
 
 	propagateUTXOsChangedNotificationAddresses                                    map[utxoindex.ScriptPublicKeyString]*UTXOsChangedNotificationAddress
+	propagateMempoolChangedNotificationAddresses                                  map[utxoindex.ScriptPublicKeyString]*UTXOsChangedNotificationAddress
 	includeAcceptedTransactionIDsInVirtualSelectedParentChainChangedNotifications bool
 }
 
 // NewNotificationManager creates a new NotificationManager
-func NewNotificationManager(params *dagconfig.Params) *NotificationManager {
+func NewNotificationManager(params *dagconfig.Params, journal *notificationjournal.Journal) *NotificationManager {
 	return &NotificationManager{
 		params:    params,
 		listeners: make(map[*routerpkg.Router]*NotificationListener),
+		journal:   journal,
 	}
 }
 
@@ -119,9 +125,16 @@ func (nm *NotificationManager) NotifyVirtualSelectedParentChainChanged(
 	nm.RLock()
 	defer nm.RUnlock()
 
+	sequence, err := nm.journal.Append(notification)
+	if err != nil {
+		return err
+	}
+	notification.Sequence = sequence
+
 	notificationWithoutAcceptedTransactionIDs := &appmessage.VirtualSelectedParentChainChangedNotificationMessage{
 		RemovedChainBlockHashes: notification.RemovedChainBlockHashes,
 		AddedChainBlockHashes:   notification.AddedChainBlockHashes,
+		Sequence:                notification.Sequence,
 	}
 
 	for router, listener := range nm.listeners {
@@ -142,6 +155,47 @@ func (nm *NotificationManager) NotifyVirtualSelectedParentChainChanged(
 	return nil
 }
 
+// ResumeVirtualSelectedParentChainChangedNotifications instructs the listener to send chain
+// changed notifications to the remote listener, and sends it the given response followed by the
+// journaled chain changed notifications that came after the given sequence or chain block hash.
+// It returns an error that wraps notificationjournal.ErrNotInJournal if any of those notifications
+// are not in the journal, in which case the listener is left as is.
+func (nm *NotificationManager) ResumeVirtualSelectedParentChainChangedNotifications(router *routerpkg.Router,
+	nl *NotificationListener, includeAcceptedTransactionIDs bool, resumeAfterSequence uint64,
+	resumeAfterChainBlockHash string, response appmessage.Message) error {
+
+	// Apply a write-lock so that no new notification is sent before the missed ones
+	nm.Lock()
+	defer nm.Unlock()
+
+	entries, err := nm.journalEntriesAfter(resumeAfterSequence, resumeAfterChainBlockHash)
+	if err != nil {
+		return err
+	}
+
+	nl.PropagateVirtualSelectedParentChainChangedNotifications(includeAcceptedTransactionIDs)
+	err = router.OutgoingRoute().Enqueue(response)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		notification, ok := entry.Notification.(*appmessage.VirtualSelectedParentChainChangedNotificationMessage)
+		if !ok {
+			continue
+		}
+		notification.Sequence = entry.Sequence
+		if !includeAcceptedTransactionIDs {
+			notification.AcceptedTransactionIDs = nil
+		}
+		err := router.OutgoingRoute().Enqueue(notification)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // HasListenersThatPropagateVirtualSelectedParentChainChanged returns whether there's any listener that is
 // subscribed to VirtualSelectedParentChainChanged notifications as well as checks if any such listener requested
 // to include AcceptedTransactionIDs.
@@ -204,6 +258,11 @@ func (nm *NotificationManager) NotifyUTXOsChanged(utxoChanges *utxoindex.UTXOCha
 	nm.RLock()
 	defer nm.RUnlock()
 
+	sequence, err := nm.journalUTXOsChanged(utxoChanges)
+	if err != nil {
+		return err
+	}
+
 	for router, listener := range nm.listeners {
 		if listener.propagateUTXOsChangedNotifications {
 			// Filter utxoChanges and create a notification
@@ -216,6 +275,7 @@ func (nm *NotificationManager) NotifyUTXOsChanged(utxoChanges *utxoindex.UTXOCha
 			if len(notification.Added) == 0 && len(notification.Removed) == 0 {
 				continue
 			}
+			notification.Sequence = sequence
 
 			// Enqueue the notification
 			err = router.OutgoingRoute().MaybeEnqueue(notification)
@@ -227,6 +287,82 @@ func (nm *NotificationManager) NotifyUTXOsChanged(utxoChanges *utxoindex.UTXOCha
 	return nil
 }
 
+// journalUTXOsChanged journals the given UTXO changes for all addresses and returns
+// their sequence. Empty changes are not journaled, since they're never sent
+func (nm *NotificationManager) journalUTXOsChanged(utxoChanges *utxoindex.UTXOChanges) (uint64, error) {
+	if !nm.journal.IsEnabled() || (len(utxoChanges.Added) == 0 && len(utxoChanges.Removed) == 0) {
+		return 0, nil
+	}
+
+	// A listener that isn't registered for any address converts the changes for all addresses
+	notification, err := newNotificationListener(nm.params).convertUTXOChangesToUTXOsChangedNotification(utxoChanges)
+	if err != nil {
+		return 0, err
+	}
+	return nm.journal.Append(notification)
+}
+
+// ResumeUTXOsChangedNotifications instructs the listener to send UTXOs changed notifications
+// to the remote listener for the given addresses, the same way PropagateUTXOsChangedNotifications
+// does, and sends it the given response followed by the journaled UTXOs changed notifications that
+// came after the given sequence or chain block hash, filtered by all the addresses the listener is
+// registered for. It returns an error that wraps notificationjournal.ErrNotInJournal if any of those
+// notifications are not in the journal, in which case the listener is left as is.
+func (nm *NotificationManager) ResumeUTXOsChangedNotifications(router *routerpkg.Router, nl *NotificationListener,
+	addresses []*UTXOsChangedNotificationAddress, resumeAfterSequence uint64, resumeAfterChainBlockHash string,
+	response appmessage.Message) error {
+
+	// Apply a write-lock so that no new notification is sent before the missed ones
+	nm.Lock()
+	defer nm.Unlock()
+
+	entries, err := nm.journalEntriesAfter(resumeAfterSequence, resumeAfterChainBlockHash)
+	if err != nil {
+		return err
+	}
+
+	nl.propagateUTXOsChangedNotificationsForAddresses(addresses)
+	err = router.OutgoingRoute().Enqueue(response)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		journaledNotification, ok := entry.Notification.(*appmessage.UTXOsChangedNotificationMessage)
+		if !ok {
+			continue
+		}
+		notification, err := nl.filterUTXOsChangedNotification(journaledNotification)
+		if err != nil {
+			return err
+		}
+		if len(notification.Added) == 0 && len(notification.Removed) == 0 {
+			continue
+		}
+		notification.Sequence = entry.Sequence
+		err = router.OutgoingRoute().Enqueue(notification)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// journalEntriesAfter returns the journaled notifications that came after either the given
+// sequence or the notification that added the given chain block hash, whichever is set
+func (nm *NotificationManager) journalEntriesAfter(resumeAfterSequence uint64,
+	resumeAfterChainBlockHash string) ([]*notificationjournal.Entry, error) {
+
+	if resumeAfterChainBlockHash != "" {
+		var err error
+		resumeAfterSequence, err = nm.journal.SequenceOfChainBlock(resumeAfterChainBlockHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nm.journal.EntriesAfter(resumeAfterSequence)
+}
+
 // NotifyVirtualSelectedParentBlueScoreChanged notifies the notification manager that the DAG's
 // virtual selected parent blue score has changed
 func (nm *NotificationManager) NotifyVirtualSelectedParentBlueScoreChanged(
@@ -284,6 +420,47 @@ func (nm *NotificationManager) NotifyNewBlockTemplate(
 	return nil
 }
 
+// HasMempoolChangedListeners indicates if the notification manager has any listeners for `MempoolChanged` events
+func (nm *NotificationManager) HasMempoolChangedListeners() bool {
+	nm.RLock()
+	defer nm.RUnlock()
+
+	for _, listener := range nm.listeners {
+		if listener.propagateMempoolChangedNotifications {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifyMempoolChanged notifies the notification manager that transactions were added to or
+// removed from the mempool. changes[i] is expected to be the RPC representation of
+// changeSet.Changes[i]
+func (nm *NotificationManager) NotifyMempoolChanged(
+	changeSet *miningmanagermodel.MempoolChangeSet, changes []*appmessage.MempoolChange) error {
+
+	nm.RLock()
+	defer nm.RUnlock()
+
+	for router, listener := range nm.listeners {
+		if listener.propagateMempoolChangedNotifications {
+			// Filter the changes and create a notification
+			notification := listener.filterMempoolChanges(changeSet, changes)
+
+			// Don't send the notification if it's empty
+			if len(notification.Changes) == 0 {
+				continue
+			}
+
+			err := router.OutgoingRoute().MaybeEnqueue(notification)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // NotifyPruningPointUTXOSetOverride notifies the notification manager that the UTXO index
 // reset due to pruning point change via IBD.
 func (nm *NotificationManager) NotifyPruningPointUTXOSetOverride() error {
@@ -313,6 +490,7 @@ func newNotificationListener(params *dagconfig.Params) *NotificationListener {
 		propagateVirtualSelectedParentBlueScoreChangedNotifications: false,
 		propagateNewBlockTemplateNotifications:                      false,
 		propagatePruningPointUTXOSetOverrideNotifications:           false,
+		propagateMempoolChangedNotifications:                        false,
 	}
 }
 
@@ -356,6 +534,10 @@ func (nm *NotificationManager) PropagateUTXOsChangedNotifications(nl *Notificati
 	nm.Lock()
 	defer nm.Unlock()
 
+	nl.propagateUTXOsChangedNotificationsForAddresses(addresses)
+}
+
+func (nl *NotificationListener) propagateUTXOsChangedNotificationsForAddresses(addresses []*UTXOsChangedNotificationAddress) {
 	if !nl.propagateUTXOsChangedNotifications {
 		nl.propagateUTXOsChangedNotifications = true
 		nl.propagateUTXOsChangedNotificationAddresses =
@@ -442,6 +624,49 @@ func (nl *NotificationListener) convertUTXOChangesToUTXOsChangedNotification(
 	return notification, nil
 }
 
+// filterUTXOsChangedNotification filters a journaled notification, which holds the UTXO changes
+// of all addresses, by the addresses the listener is registered for
+func (nl *NotificationListener) filterUTXOsChangedNotification(
+	journaledNotification *appmessage.UTXOsChangedNotificationMessage) (*appmessage.UTXOsChangedNotificationMessage, error) {
+
+	if len(nl.propagateUTXOsChangedNotificationAddresses) == 0 {
+		return journaledNotification, nil
+	}
+
+	notification := &appmessage.UTXOsChangedNotificationMessage{}
+	var err error
+	notification.Added, err = nl.filterUTXOsByAddressesEntries(journaledNotification.Added)
+	if err != nil {
+		return nil, err
+	}
+	notification.Removed, err = nl.filterUTXOsByAddressesEntries(journaledNotification.Removed)
+	if err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
+
+func (nl *NotificationListener) filterUTXOsByAddressesEntries(
+	entries []*appmessage.UTXOsByAddressesEntry) ([]*appmessage.UTXOsByAddressesEntry, error) {
+
+	var filteredEntries []*appmessage.UTXOsByAddressesEntry
+	for _, entry := range entries {
+		utxoEntry, err := appmessage.RPCUTXOEntryToUTXOEntry(entry.UTXOEntry)
+		if err != nil {
+			return nil, err
+		}
+		scriptPublicKeyString := utxoindex.ScriptPublicKeyString(utxoEntry.ScriptPublicKey().String())
+		if listenerAddress, ok := nl.propagateUTXOsChangedNotificationAddresses[scriptPublicKeyString]; ok {
+			filteredEntries = append(filteredEntries, &appmessage.UTXOsByAddressesEntry{
+				Address:   listenerAddress.Address,
+				Outpoint:  entry.Outpoint,
+				UTXOEntry: entry.UTXOEntry,
+			})
+		}
+	}
+	return filteredEntries, nil
+}
+
 func (nl *NotificationListener) scriptPubKeyStringToAddressString(scriptPublicKeyString utxoindex.ScriptPublicKeyString) (string, error) {
 	scriptPubKey := externalapi.NewScriptPublicKeyFromString(string(scriptPublicKeyString))
 
@@ -489,3 +714,61 @@ func (nl *NotificationListener) PropagatePruningPointUTXOSetOverrideNotification
 func (nl *NotificationListener) StopPropagatingPruningPointUTXOSetOverrideNotifications() {
 	nl.propagatePruningPointUTXOSetOverrideNotifications = false
 }
+
+// PropagateMempoolChangedNotifications instructs the listener to send mempool changed notifications
+// to the remote listener for transactions concerning the given addresses. Subsequent calls instruct
+// the listener to send mempool changed notifications for those addresses along with the old ones.
+// An empty list of addresses instructs the listener to send notifications for all transactions.
+func (nm *NotificationManager) PropagateMempoolChangedNotifications(nl *NotificationListener, addresses []*UTXOsChangedNotificationAddress) {
+	// Apply a write-lock since the internal listener address map is modified
+	nm.Lock()
+	defer nm.Unlock()
+
+	if !nl.propagateMempoolChangedNotifications {
+		nl.propagateMempoolChangedNotifications = true
+		nl.propagateMempoolChangedNotificationAddresses =
+			make(map[utxoindex.ScriptPublicKeyString]*UTXOsChangedNotificationAddress, len(addresses))
+	}
+
+	for _, address := range addresses {
+		nl.propagateMempoolChangedNotificationAddresses[address.ScriptPublicKeyString] = address
+	}
+}
+
+func (nl *NotificationListener) filterMempoolChanges(changeSet *miningmanagermodel.MempoolChangeSet,
+	changes []*appmessage.MempoolChange) *appmessage.MempoolChangedNotificationMessage {
+
+	if len(nl.propagateMempoolChangedNotificationAddresses) == 0 {
+		return appmessage.NewMempoolChangedNotificationMessage(changes)
+	}
+
+	filteredChanges := make([]*appmessage.MempoolChange, 0)
+	for i, change := range changeSet.Changes {
+		if nl.isTransactionOfMempoolChangedAddresses(change.Transaction) {
+			filteredChanges = append(filteredChanges, changes[i])
+		}
+	}
+	return appmessage.NewMempoolChangedNotificationMessage(filteredChanges)
+}
+
+// isTransactionOfMempoolChangedAddresses returns whether the given transaction either pays
+// to or spends from one of the addresses the listener sends mempool changed notifications for.
+// Inputs whose UTXO entries are unknown, such as the missing inputs of orphans, are skipped.
+func (nl *NotificationListener) isTransactionOfMempoolChangedAddresses(transaction *externalapi.DomainTransaction) bool {
+	for _, output := range transaction.Outputs {
+		scriptPublicKeyString := utxoindex.ScriptPublicKeyString(output.ScriptPublicKey.String())
+		if _, ok := nl.propagateMempoolChangedNotificationAddresses[scriptPublicKeyString]; ok {
+			return true
+		}
+	}
+	for _, input := range transaction.Inputs {
+		if input.UTXOEntry == nil {
+			continue
+		}
+		scriptPublicKeyString := utxoindex.ScriptPublicKeyString(input.UTXOEntry.ScriptPublicKey().String())
+		if _, ok := nl.propagateMempoolChangedNotificationAddresses[scriptPublicKeyString]; ok {
+			return true
+		}
+	}
+	return false
+}
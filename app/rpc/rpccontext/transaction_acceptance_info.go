@@ -0,0 +1,55 @@
+package rpccontext
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+)
+
+// BuildTransactionAcceptanceInfos builds the acceptance info of the given
+// transactions out of the TX index
+func (ctx *Context) BuildTransactionAcceptanceInfos(transactionIDs []*externalapi.DomainTransactionID) (
+	[]*appmessage.TransactionAcceptanceInfo, error) {
+
+	virtualSelectedParent, err := ctx.Domain.Consensus().GetVirtualSelectedParent()
+	if err != nil {
+		return nil, err
+	}
+	virtualSelectedParentInfo, err := ctx.Domain.Consensus().GetBlockInfo(virtualSelectedParent)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptanceInfos := make([]*appmessage.TransactionAcceptanceInfo, len(transactionIDs))
+	for i, transactionID := range transactionIDs {
+		acceptanceInfos[i] = &appmessage.TransactionAcceptanceInfo{
+			TransactionID: transactionID.String(),
+		}
+
+		txAcceptanceData, found, err := ctx.TXIndex.TXAcceptanceData(transactionID)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+		acceptanceInfos[i].IsAccepted = true
+		acceptanceInfos[i].AcceptingBlockHash = txAcceptanceData.AcceptingBlockHash.String()
+		acceptanceInfos[i].IncludingBlockHash = txAcceptanceData.IncludingBlockHash.String()
+
+		acceptingBlockInfo, err := ctx.Domain.Consensus().GetBlockInfo(txAcceptanceData.AcceptingBlockHash)
+		if err != nil {
+			return nil, err
+		}
+		// The accepting block might have already been pruned, in which case
+		// its blue score and the amount of confirmations are unknown
+		if !acceptingBlockInfo.Exists {
+			continue
+		}
+		acceptanceInfos[i].AcceptingBlueScore = acceptingBlockInfo.BlueScore
+		if virtualSelectedParentInfo.BlueScore >= acceptingBlockInfo.BlueScore {
+			acceptanceInfos[i].Confirmations = virtualSelectedParentInfo.BlueScore - acceptingBlockInfo.BlueScore + 1
+		}
+	}
+
+	return acceptanceInfos, nil
+}
@@ -2,6 +2,7 @@ package rpccontext
 
 import (
 	"encoding/hex"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
 	"github.com/kaspanet/kaspad/util"
 	"github.com/pkg/errors"
@@ -32,6 +33,57 @@ func ConvertUTXOOutpointEntryPairsToUTXOsByAddressesEntries(address string, pair
 	return utxosByAddressesEntries
 }
 
+// ConvertOutpointAndUTXOEntryPairsToUTXOsByAddressesEntries converts
+// a slice of OutpointAndUTXOEntryPair to a slice of UTXOsByAddressesEntry
+// while preserving their order
+func ConvertOutpointAndUTXOEntryPairsToUTXOsByAddressesEntries(address string,
+	pairs []*externalapi.OutpointAndUTXOEntryPair) []*appmessage.UTXOsByAddressesEntry {
+
+	utxosByAddressesEntries := make([]*appmessage.UTXOsByAddressesEntry, len(pairs))
+	for i, pair := range pairs {
+		utxosByAddressesEntries[i] = &appmessage.UTXOsByAddressesEntry{
+			Address: address,
+			Outpoint: &appmessage.RPCOutpoint{
+				TransactionID: pair.Outpoint.TransactionID.String(),
+				Index:         pair.Outpoint.Index,
+			},
+			UTXOEntry: &appmessage.RPCUTXOEntry{
+				Amount:          pair.UTXOEntry.Amount(),
+				ScriptPublicKey: &appmessage.RPCScriptPublicKey{Script: hex.EncodeToString(pair.UTXOEntry.ScriptPublicKey().Script), Version: pair.UTXOEntry.ScriptPublicKey().Version},
+				BlockDAAScore:   pair.UTXOEntry.BlockDAAScore(),
+				IsCoinbase:      pair.UTXOEntry.IsCoinbase(),
+			},
+		}
+	}
+	return utxosByAddressesEntries
+}
+
+// ConvertUTXOsByAddressesFilterToUTXOFilter converts an RPC UTXOsByAddressesFilter
+// to a utxoindex.UTXOFilter. A nil filter is converted to a nil UTXOFilter
+func (ctx *Context) ConvertUTXOsByAddressesFilterToUTXOFilter(
+	filter *appmessage.UTXOsByAddressesFilter) (*utxoindex.UTXOFilter, error) {
+
+	if filter == nil {
+		return nil, nil
+	}
+	utxoFilter := &utxoindex.UTXOFilter{
+		MinAmount:               filter.MinAmount,
+		MaxAmount:               filter.MaxAmount,
+		MinBlockDAAScore:        filter.MinBlockDAAScore,
+		MaxBlockDAAScore:        filter.MaxBlockDAAScore,
+		ExcludeImmatureCoinbase: filter.ExcludeImmatureCoinbase,
+	}
+	if filter.ExcludeImmatureCoinbase {
+		virtualDAAScore, err := ctx.Domain.Consensus().GetVirtualDAAScore()
+		if err != nil {
+			return nil, err
+		}
+		utxoFilter.VirtualDAAScore = virtualDAAScore
+		utxoFilter.CoinbaseMaturity = ctx.Config.ActiveNetParams.BlockCoinbaseMaturity
+	}
+	return utxoFilter, nil
+}
+
 // ConvertAddressStringsToUTXOsChangedNotificationAddresses converts address strings
 // to UTXOsChangedNotificationAddresses
 func (ctx *Context) ConvertAddressStringsToUTXOsChangedNotificationAddresses(
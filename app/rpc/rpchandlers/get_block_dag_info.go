@@ -4,6 +4,7 @@ import (
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/hashes"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 )
 
@@ -43,5 +44,24 @@ func HandleGetBlockDAGInfo(context *rpccontext.Context, _ *router.Router, _ appm
 	}
 	response.PruningPointHash = pruningPoint.String()
 
+	// The retention root might not be resolved or stored yet (e.g. while the node is
+	// syncing), in which case the retention root fields are left empty
+	retentionRoot, err := consensus.RetentionRoot()
+	if err != nil {
+		if !database.IsNotFoundError(err) {
+			return nil, err
+		}
+		return response, nil
+	}
+	retentionRootHeader, err := consensus.GetBlockHeader(retentionRoot)
+	if err != nil {
+		if !database.IsNotFoundError(err) {
+			return nil, err
+		}
+		return response, nil
+	}
+	response.RetentionRootHash = retentionRoot.String()
+	response.RetentionRootDAAScore = retentionRootHeader.DAAScore()
+
 	return response, nil
 }
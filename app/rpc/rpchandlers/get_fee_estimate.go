@@ -0,0 +1,26 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/miningmanager/feeestimator"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleGetFeeEstimate handles the respectively named RPC command
+func HandleGetFeeEstimate(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	estimate := context.Domain.MiningManager().GetFeeEstimate()
+
+	return appmessage.NewGetFeeEstimateResponseMessage(&appmessage.RPCFeeEstimate{
+		PriorityBucket: feeRateBucketToRPC(estimate.PriorityBucket),
+		NormalBucket:   feeRateBucketToRPC(estimate.NormalBucket),
+		LowBucket:      feeRateBucketToRPC(estimate.LowBucket),
+	}), nil
+}
+
+func feeRateBucketToRPC(bucket *feeestimator.FeeRateBucket) *appmessage.RPCFeeRateBucket {
+	return &appmessage.RPCFeeRateBucket{
+		FeeRate:          bucket.FeeRate,
+		EstimatedSeconds: bucket.EstimatedSeconds,
+	}
+}
@@ -20,6 +20,8 @@ func HandleGetInfo(context *rpccontext.Context, _ *router.Router, _ appmessage.M
 		version.Version(),
 		context.Config.UTXOIndex,
 		context.ProtocolManager.Context().HasPeers() && isNearlySynced,
+		context.Domain.MiningManager().TransactionMass(true, false),
+		context.Domain.MiningManager().MempoolMinimumFeeRate(),
 	)
 
 	return response, nil
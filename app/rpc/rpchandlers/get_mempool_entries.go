@@ -43,5 +43,5 @@ func HandleGetMempoolEntries(context *rpccontext.Context, _ *router.Router, requ
 		}
 	}
 
-	return appmessage.NewGetMempoolEntriesResponseMessage(entries), nil
+	return appmessage.NewGetMempoolEntriesResponseMessage(entries, context.Domain.MiningManager().MempoolMinimumFeeRate()), nil
 }
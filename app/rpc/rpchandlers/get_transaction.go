@@ -0,0 +1,86 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/hashes"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/transactionid"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleGetTransaction handles the respectively named RPC command
+func HandleGetTransaction(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.TXIndex {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when kaspad is run without --txindex")
+		return errorMessage, nil
+	}
+
+	getTransactionRequest := request.(*appmessage.GetTransactionRequestMessage)
+
+	transactionID, err := transactionid.FromString(getTransactionRequest.TransactionID)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction ID could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+
+	containingBlockHashes, err := context.TXIndex.TXContainingBlockHashes(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(containingBlockHashes) == 0 {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction %s was not found", transactionID)
+		return errorMessage, nil
+	}
+
+	acceptanceInfos, err := context.BuildTransactionAcceptanceInfos([]*externalapi.DomainTransactionID{transactionID})
+	if err != nil {
+		return nil, err
+	}
+	acceptanceInfo := acceptanceInfos[0]
+
+	// Prefer taking the transaction from the block it was accepted from
+	blockHashToSearch := containingBlockHashes[0]
+	if acceptanceInfo.IsAccepted {
+		blockHashToSearch, err = externalapi.NewDomainHashFromString(acceptanceInfo.IncludingBlockHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	block, found, err := context.Domain.Consensus().GetBlock(blockHashToSearch)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Block %s containing transaction %s was not found. "+
+			"It may have been pruned", blockHashToSearch, transactionID)
+		return errorMessage, nil
+	}
+
+	var transaction *externalapi.DomainTransaction
+	for _, blockTransaction := range block.Transactions {
+		if consensushashing.TransactionID(blockTransaction).Equal(transactionID) {
+			transaction = blockTransaction
+			break
+		}
+	}
+	if transaction == nil {
+		return nil, errors.Errorf("transaction %s is missing from its containing block %s",
+			transactionID, blockHashToSearch)
+	}
+
+	rpcTransaction := appmessage.DomainTransactionToRPCTransaction(transaction)
+	err = context.PopulateTransactionWithVerboseData(rpcTransaction, block.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	return appmessage.NewGetTransactionResponseMessage(rpcTransaction, hashes.ToStrings(containingBlockHashes),
+		acceptanceInfo.AcceptingBlockHash, acceptanceInfo.Confirmations), nil
+}
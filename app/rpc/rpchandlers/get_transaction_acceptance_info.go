@@ -0,0 +1,38 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/transactionid"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleGetTransactionAcceptanceInfo handles the respectively named RPC command
+func HandleGetTransactionAcceptanceInfo(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.TXIndex {
+		errorMessage := &appmessage.GetTransactionAcceptanceInfoResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when kaspad is run without --txindex")
+		return errorMessage, nil
+	}
+
+	getTransactionAcceptanceInfoRequest := request.(*appmessage.GetTransactionAcceptanceInfoRequestMessage)
+
+	transactionIDs := make([]*externalapi.DomainTransactionID, len(getTransactionAcceptanceInfoRequest.TransactionIDs))
+	for i, transactionIDString := range getTransactionAcceptanceInfoRequest.TransactionIDs {
+		transactionID, err := transactionid.FromString(transactionIDString)
+		if err != nil {
+			errorMessage := &appmessage.GetTransactionAcceptanceInfoResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Transaction ID '%s' could not be parsed: %s", transactionIDString, err)
+			return errorMessage, nil
+		}
+		transactionIDs[i] = transactionID
+	}
+
+	acceptanceInfos, err := context.BuildTransactionAcceptanceInfos(transactionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return appmessage.NewGetTransactionAcceptanceInfoResponseMessage(acceptanceInfos), nil
+}
@@ -0,0 +1,73 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/addressindex"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/transactionid"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/kaspanet/kaspad/util"
+)
+
+// maxGetTransactionsByAddressLimit is the maximum amount of transactions
+// returned by a single GetTransactionsByAddress request
+const maxGetTransactionsByAddressLimit = 1000
+
+// HandleGetTransactionsByAddress handles the respectively named RPC command
+func HandleGetTransactionsByAddress(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.AddressIndex {
+		errorMessage := &appmessage.GetTransactionsByAddressResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when kaspad is run without --addressindex")
+		return errorMessage, nil
+	}
+
+	getTransactionsByAddressRequest := request.(*appmessage.GetTransactionsByAddressRequestMessage)
+
+	address, err := util.DecodeAddress(getTransactionsByAddressRequest.Address, context.Config.ActiveNetParams.Prefix)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionsByAddressResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not decode address '%s': %s",
+			getTransactionsByAddressRequest.Address, err)
+		return errorMessage, nil
+	}
+	scriptPublicKey, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionsByAddressResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not create a scriptPublicKey for address '%s': %s",
+			getTransactionsByAddressRequest.Address, err)
+		return errorMessage, nil
+	}
+
+	// A zero transaction ID is ordered before any real one, so leaving StartTransactionID
+	// empty starts from the first transaction accepted at StartDAAScore
+	start := &addressindex.AddressTransaction{AcceptingDAAScore: getTransactionsByAddressRequest.StartDAAScore}
+	if getTransactionsByAddressRequest.StartTransactionID != "" {
+		startTransactionID, err := transactionid.FromString(getTransactionsByAddressRequest.StartTransactionID)
+		if err != nil {
+			errorMessage := &appmessage.GetTransactionsByAddressResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Start transaction ID could not be parsed: %s", err)
+			return errorMessage, nil
+		}
+		start.TransactionID = *startTransactionID
+	}
+
+	limit := int(getTransactionsByAddressRequest.Limit)
+	if limit == 0 || limit > maxGetTransactionsByAddressLimit {
+		limit = maxGetTransactionsByAddressLimit
+	}
+
+	addressTransactions, hasMore, err := context.AddressIndex.AddressTransactions(scriptPublicKey, start, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*appmessage.AddressTransaction, len(addressTransactions))
+	for i, addressTransaction := range addressTransactions {
+		transactions[i] = &appmessage.AddressTransaction{
+			TransactionID:     addressTransaction.TransactionID.String(),
+			AcceptingDAAScore: addressTransaction.AcceptingDAAScore,
+		}
+	}
+	return appmessage.NewGetTransactionsByAddressResponseMessage(transactions, hasMore), nil
+}
@@ -3,6 +3,7 @@ package rpchandlers
 import (
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/kaspanet/kaspad/util"
@@ -18,8 +19,9 @@ func HandleGetUTXOsByAddresses(context *rpccontext.Context, _ *router.Router, re
 
 	getUTXOsByAddressesRequest := request.(*appmessage.GetUTXOsByAddressesRequestMessage)
 
-	allEntries := make([]*appmessage.UTXOsByAddressesEntry, 0)
-	for _, addressString := range getUTXOsByAddressesRequest.Addresses {
+	addresses := uniqueAddresses(getUTXOsByAddressesRequest.Addresses)
+	scriptPublicKeys := make([]*externalapi.ScriptPublicKey, len(addresses))
+	for i, addressString := range addresses {
 		address, err := util.DecodeAddress(addressString, context.Config.ActiveNetParams.Prefix)
 		if err != nil {
 			errorMessage := &appmessage.GetUTXOsByAddressesResponseMessage{}
@@ -32,14 +34,93 @@ func HandleGetUTXOsByAddresses(context *rpccontext.Context, _ *router.Router, re
 			errorMessage.Error = appmessage.RPCErrorf("Could not create a scriptPublicKey for address '%s': %s", addressString, err)
 			return errorMessage, nil
 		}
-		utxoOutpointEntryPairs, err := context.UTXOIndex.UTXOs(scriptPublicKey)
+		scriptPublicKeys[i] = scriptPublicKey
+	}
+
+	// Addresses are paged through in the order in which they appear in the request
+	startIndex := 0
+	if getUTXOsByAddressesRequest.StartAddress != "" {
+		startIndex = -1
+		for i, addressString := range addresses {
+			if addressString == getUTXOsByAddressesRequest.StartAddress {
+				startIndex = i
+				break
+			}
+		}
+		if startIndex == -1 {
+			errorMessage := &appmessage.GetUTXOsByAddressesResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Start address '%s' is not one of the requested addresses",
+				getUTXOsByAddressesRequest.StartAddress)
+			return errorMessage, nil
+		}
+	}
+	var startOutpoint *externalapi.DomainOutpoint
+	if getUTXOsByAddressesRequest.StartOutpoint != nil {
+		if getUTXOsByAddressesRequest.StartAddress == "" {
+			errorMessage := &appmessage.GetUTXOsByAddressesResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Start outpoint cannot be set without a start address")
+			return errorMessage, nil
+		}
+		var err error
+		startOutpoint, err = appmessage.RPCOutpointToDomainOutpoint(getUTXOsByAddressesRequest.StartOutpoint)
+		if err != nil {
+			errorMessage := &appmessage.GetUTXOsByAddressesResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Start outpoint could not be parsed: %s", err)
+			return errorMessage, nil
+		}
+	}
+
+	filter, err := context.ConvertUTXOsByAddressesFilterToUTXOFilter(getUTXOsByAddressesRequest.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(getUTXOsByAddressesRequest.Limit)
+	allEntries := make([]*appmessage.UTXOsByAddressesEntry, 0)
+	for i := startIndex; i < len(addresses); i++ {
+		// Once the page is full, the remaining addresses are only checked for
+		// whether they have any matching UTXOs at all
+		pageLimit := 0
+		if limit > 0 {
+			pageLimit = limit - len(allEntries)
+			if pageLimit == 0 {
+				pageLimit = 1
+			}
+		}
+		pairs, hasMore, err := context.UTXOIndex.UTXOsPage(scriptPublicKeys[i], startOutpoint, pageLimit, filter)
 		if err != nil {
 			return nil, err
 		}
-		entries := rpccontext.ConvertUTXOOutpointEntryPairsToUTXOsByAddressesEntries(addressString, utxoOutpointEntryPairs)
+		startOutpoint = nil
+
+		if limit > 0 && len(allEntries) == limit {
+			if len(pairs) > 0 {
+				return appmessage.NewGetUTXOsByAddressesResponseMessage(allEntries, true), nil
+			}
+			continue
+		}
+		entries := rpccontext.ConvertOutpointAndUTXOEntryPairsToUTXOsByAddressesEntries(addresses[i], pairs)
 		allEntries = append(allEntries, entries...)
+		if hasMore {
+			return appmessage.NewGetUTXOsByAddressesResponseMessage(allEntries, true), nil
+		}
 	}
 
-	response := appmessage.NewGetUTXOsByAddressesResponseMessage(allEntries)
+	response := appmessage.NewGetUTXOsByAddressesResponseMessage(allEntries, false)
 	return response, nil
 }
+
+// uniqueAddresses returns the given addresses without duplicates,
+// keeping the order in which they first appear
+func uniqueAddresses(addresses []string) []string {
+	seen := make(map[string]struct{}, len(addresses))
+	unique := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		if _, ok := seen[address]; ok {
+			continue
+		}
+		seen[address] = struct{}{}
+		unique = append(unique, address)
+	}
+	return unique
+}
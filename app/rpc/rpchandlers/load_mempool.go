@@ -0,0 +1,47 @@
+package rpchandlers
+
+import (
+	"os"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleLoadMempool handles the respectively named RPC command
+func HandleLoadMempool(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	if context.Config.SafeRPC {
+		log.Warn("LoadMempool RPC command called while node in safe RPC mode -- ignoring.")
+		errorMessage := &appmessage.LoadMempoolResponseMessage{}
+		errorMessage.Error =
+			appmessage.RPCErrorf("LoadMempool RPC command called while node in safe RPC mode")
+		return errorMessage, nil
+	}
+
+	mempoolFile := context.Config.MempoolFile()
+	loadedTransactions, err := context.Domain.MiningManager().LoadMempool(mempoolFile)
+	if err != nil {
+		errorMessage := &appmessage.LoadMempoolResponseMessage{}
+		if errors.Is(err, os.ErrNotExist) {
+			errorMessage.Error = appmessage.RPCErrorf("No saved mempool was found at %s", mempoolFile)
+		} else {
+			errorMessage.Error = appmessage.RPCErrorf("Could not load the mempool from %s: %s", mempoolFile, err)
+		}
+		return errorMessage, nil
+	}
+
+	// Relay the loaded transactions, since peers may have dropped them in the meantime
+	loadedTransactionIDs := make([]*externalapi.DomainTransactionID, len(loadedTransactions))
+	for i, transaction := range loadedTransactions {
+		loadedTransactionIDs[i] = consensushashing.TransactionID(transaction)
+	}
+	err = context.ProtocolManager.Context().EnqueueTransactionIDsForPropagation(loadedTransactionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return appmessage.NewLoadMempoolResponseMessage(uint64(len(loadedTransactions))), nil
+}
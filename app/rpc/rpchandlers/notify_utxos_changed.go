@@ -2,8 +2,10 @@ package rpchandlers
 
 import (
 	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/notificationjournal"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
 )
 
 // HandleNotifyUTXOsChanged handles the respectively named RPC command
@@ -26,6 +28,32 @@ func HandleNotifyUTXOsChanged(context *rpccontext.Context, router *router.Router
 	if err != nil {
 		return nil, err
 	}
+
+	if notifyUTXOsChangedRequest.ResumeAfterSequence != 0 || notifyUTXOsChangedRequest.ResumeAfterChainBlockHash != "" {
+		rpcError := validateResumeNotifications(context, notifyUTXOsChangedRequest.ResumeAfterSequence,
+			notifyUTXOsChangedRequest.ResumeAfterChainBlockHash)
+		if rpcError != nil {
+			errorMessage := appmessage.NewNotifyUTXOsChangedResponseMessage()
+			errorMessage.Error = rpcError
+			return errorMessage, nil
+		}
+
+		err := context.NotificationManager.ResumeUTXOsChangedNotifications(router, listener, addresses,
+			notifyUTXOsChangedRequest.ResumeAfterSequence, notifyUTXOsChangedRequest.ResumeAfterChainBlockHash,
+			appmessage.NewNotifyUTXOsChangedResponseMessage())
+		if err != nil {
+			if errors.Is(err, notificationjournal.ErrNotInJournal) {
+				errorMessage := appmessage.NewNotifyUTXOsChangedResponseMessage()
+				errorMessage.Error = appmessage.RPCErrorf("Could not resume notifications: %s", err)
+				return errorMessage, nil
+			}
+			return nil, err
+		}
+
+		// The response was already sent, followed by the missed notifications
+		return nil, nil
+	}
+
 	context.NotificationManager.PropagateUTXOsChangedNotifications(listener, addresses)
 
 	response := appmessage.NewNotifyUTXOsChangedResponseMessage()
@@ -2,8 +2,10 @@ package rpchandlers
 
 import (
 	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/notificationjournal"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
 )
 
 // HandleNotifyVirtualSelectedParentChainChanged handles the respectively named RPC command
@@ -16,9 +18,53 @@ func HandleNotifyVirtualSelectedParentChainChanged(context *rpccontext.Context,
 	if err != nil {
 		return nil, err
 	}
+
+	if notifyVirtualSelectedParentChainChangedRequest.ResumeAfterSequence != 0 ||
+		notifyVirtualSelectedParentChainChangedRequest.ResumeAfterChainBlockHash != "" {
+
+		rpcError := validateResumeNotifications(context, notifyVirtualSelectedParentChainChangedRequest.ResumeAfterSequence,
+			notifyVirtualSelectedParentChainChangedRequest.ResumeAfterChainBlockHash)
+		if rpcError != nil {
+			errorMessage := appmessage.NewNotifyVirtualSelectedParentChainChangedResponseMessage()
+			errorMessage.Error = rpcError
+			return errorMessage, nil
+		}
+
+		err := context.NotificationManager.ResumeVirtualSelectedParentChainChangedNotifications(router, listener,
+			notifyVirtualSelectedParentChainChangedRequest.IncludeAcceptedTransactionIDs,
+			notifyVirtualSelectedParentChainChangedRequest.ResumeAfterSequence,
+			notifyVirtualSelectedParentChainChangedRequest.ResumeAfterChainBlockHash,
+			appmessage.NewNotifyVirtualSelectedParentChainChangedResponseMessage())
+		if err != nil {
+			if errors.Is(err, notificationjournal.ErrNotInJournal) {
+				errorMessage := appmessage.NewNotifyVirtualSelectedParentChainChangedResponseMessage()
+				errorMessage.Error = appmessage.RPCErrorf("Could not resume notifications: %s", err)
+				return errorMessage, nil
+			}
+			return nil, err
+		}
+
+		// The response was already sent, followed by the missed notifications
+		return nil, nil
+	}
+
 	listener.PropagateVirtualSelectedParentChainChangedNotifications(
 		notifyVirtualSelectedParentChainChangedRequest.IncludeAcceptedTransactionIDs)
 
 	response := appmessage.NewNotifyVirtualSelectedParentChainChangedResponseMessage()
 	return response, nil
 }
+
+// validateResumeNotifications returns an RPCError if notifications can't be resumed
+// after the given sequence or chain block hash, regardless of what the journal holds
+func validateResumeNotifications(context *rpccontext.Context,
+	resumeAfterSequence uint64, resumeAfterChainBlockHash string) *appmessage.RPCError {
+
+	if !context.NotificationJournal.IsEnabled() {
+		return appmessage.RPCErrorf("Resuming notifications is unavailable when kaspad is run without --notificationjournalsize")
+	}
+	if resumeAfterSequence != 0 && resumeAfterChainBlockHash != "" {
+		return appmessage.RPCErrorf("Only one of resumeAfterSequence and resumeAfterChainBlockHash may be set")
+	}
+	return nil
+}
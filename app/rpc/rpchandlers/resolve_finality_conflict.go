@@ -3,7 +3,10 @@ package rpchandlers
 import (
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
 )
 
 // HandleResolveFinalityConflict handles the respectively named RPC command
@@ -16,7 +19,32 @@ func HandleResolveFinalityConflict(context *rpccontext.Context, _ *router.Router
 		return response, nil
 	}
 
-	response := &appmessage.ResolveFinalityConflictResponseMessage{}
-	response.Error = appmessage.RPCErrorf("not implemented")
-	return response, nil
+	resolveFinalityConflictRequest := request.(*appmessage.ResolveFinalityConflictRequestMessage)
+
+	finalityBlockHash, err := externalapi.NewDomainHashFromString(resolveFinalityConflictRequest.FinalityBlockHash)
+	if err != nil {
+		errorMessage := &appmessage.ResolveFinalityConflictResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse finalityBlockHash: %s", err)
+		return errorMessage, nil
+	}
+
+	log.Infof("Resolving the finality conflict in favor of finality block %s", finalityBlockHash)
+	err = context.Domain.Consensus().ResolveFinalityConflict(finalityBlockHash)
+	if err != nil {
+		if !errors.As(err, &ruleerrors.RuleError{}) {
+			return nil, err
+		}
+		errorMessage := &appmessage.ResolveFinalityConflictResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not resolve the finality conflict: %s", err)
+		return errorMessage, nil
+	}
+
+	// The virtual might have moved to the other side of the conflict, so the
+	// current block template is no longer relevant
+	err = context.ProtocolManager.Context().OnNewBlockTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	return appmessage.NewResolveFinalityConflictResponseMessage(), nil
 }
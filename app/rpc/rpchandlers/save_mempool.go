@@ -0,0 +1,29 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleSaveMempool handles the respectively named RPC command
+func HandleSaveMempool(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	if context.Config.SafeRPC {
+		log.Warn("SaveMempool RPC command called while node in safe RPC mode -- ignoring.")
+		errorMessage := &appmessage.SaveMempoolResponseMessage{}
+		errorMessage.Error =
+			appmessage.RPCErrorf("SaveMempool RPC command called while node in safe RPC mode")
+		return errorMessage, nil
+	}
+
+	mempoolFile := context.Config.MempoolFile()
+	savedCount, err := context.Domain.MiningManager().SaveMempool(mempoolFile)
+	if err != nil {
+		errorMessage := &appmessage.SaveMempoolResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not save the mempool into %s: %s", mempoolFile, err)
+		return errorMessage, nil
+	}
+	log.Infof("Saved %d mempool transactions into %s", savedCount, mempoolFile)
+
+	return appmessage.NewSaveMempoolResponseMessage(uint64(savedCount)), nil
+}
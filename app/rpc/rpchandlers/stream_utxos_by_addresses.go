@@ -0,0 +1,91 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/kaspanet/kaspad/util"
+)
+
+// defaultStreamUTXOsByAddressesChunkSize is the amount of UTXOs sent in a
+// single UTXOsByAddressesChunkNotification when the request doesn't specify one
+const defaultStreamUTXOsByAddressesChunkSize = 1000
+
+// HandleStreamUTXOsByAddresses handles the respectively named RPC command.
+// The UTXOs are sent as a series of UTXOsByAddressesChunkNotifications,
+// followed by a single StreamUTXOsByAddressesResponse once all of them were sent
+func HandleStreamUTXOsByAddresses(context *rpccontext.Context, router *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.UTXOIndex {
+		errorMessage := &appmessage.StreamUTXOsByAddressesResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when kaspad is run without --utxoindex")
+		return errorMessage, nil
+	}
+
+	streamUTXOsByAddressesRequest := request.(*appmessage.StreamUTXOsByAddressesRequestMessage)
+
+	addresses := uniqueAddresses(streamUTXOsByAddressesRequest.Addresses)
+	scriptPublicKeys := make([]*externalapi.ScriptPublicKey, len(addresses))
+	for i, addressString := range addresses {
+		address, err := util.DecodeAddress(addressString, context.Config.ActiveNetParams.Prefix)
+		if err != nil {
+			errorMessage := &appmessage.StreamUTXOsByAddressesResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not decode address '%s': %s", addressString, err)
+			return errorMessage, nil
+		}
+		scriptPublicKey, err := txscript.PayToAddrScript(address)
+		if err != nil {
+			errorMessage := &appmessage.StreamUTXOsByAddressesResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not create a scriptPublicKey for address '%s': %s", addressString, err)
+			return errorMessage, nil
+		}
+		scriptPublicKeys[i] = scriptPublicKey
+	}
+
+	filter, err := context.ConvertUTXOsByAddressesFilterToUTXOFilter(streamUTXOsByAddressesRequest.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	chunkSize := int(streamUTXOsByAddressesRequest.ChunkSize)
+	if chunkSize == 0 {
+		chunkSize = defaultStreamUTXOsByAddressesChunkSize
+	}
+
+	// Every address is read one chunk at a time so that the UTXO index
+	// is never locked for the duration of the whole stream
+	entryCount := uint64(0)
+	chunk := make([]*appmessage.UTXOsByAddressesEntry, 0, chunkSize)
+	for i, scriptPublicKey := range scriptPublicKeys {
+		var startOutpoint *externalapi.DomainOutpoint
+		for {
+			pairs, hasMore, err := context.UTXOIndex.UTXOsPage(scriptPublicKey, startOutpoint, chunkSize-len(chunk), filter)
+			if err != nil {
+				return nil, err
+			}
+			chunk = append(chunk, rpccontext.ConvertOutpointAndUTXOEntryPairsToUTXOsByAddressesEntries(addresses[i], pairs)...)
+			if len(chunk) == chunkSize {
+				err := router.OutgoingRoute().Enqueue(appmessage.NewUTXOsByAddressesChunkNotificationMessage(chunk))
+				if err != nil {
+					return nil, err
+				}
+				entryCount += uint64(len(chunk))
+				chunk = make([]*appmessage.UTXOsByAddressesEntry, 0, chunkSize)
+			}
+			if !hasMore {
+				break
+			}
+			startOutpoint = pairs[len(pairs)-1].Outpoint
+		}
+	}
+	if len(chunk) > 0 {
+		err := router.OutgoingRoute().Enqueue(appmessage.NewUTXOsByAddressesChunkNotificationMessage(chunk))
+		if err != nil {
+			return nil, err
+		}
+		entryCount += uint64(len(chunk))
+	}
+
+	return appmessage.NewStreamUTXOsByAddressesResponseMessage(entryCount), nil
+}
@@ -0,0 +1,43 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/miningmanager/mempool"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleSubmitTransactionReplacement handles the respectively named RPC command
+func HandleSubmitTransactionReplacement(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	submitTransactionReplacementRequest := request.(*appmessage.SubmitTransactionReplacementRequestMessage)
+
+	domainTransaction, err := appmessage.RPCTransactionToDomainTransaction(submitTransactionReplacementRequest.Transaction)
+	if err != nil {
+		errorMessage := &appmessage.SubmitTransactionReplacementResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse transaction: %s", err)
+		return errorMessage, nil
+	}
+
+	transactionID := consensushashing.TransactionID(domainTransaction)
+	replacedTransactions, err := context.ProtocolManager.AddTransactionReplacement(domainTransaction)
+	if err != nil {
+		if !errors.As(err, &mempool.RuleError{}) {
+			return nil, err
+		}
+
+		log.Debugf("Rejected transaction replacement %s: %s", transactionID, err)
+		errorMessage := &appmessage.SubmitTransactionReplacementResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Rejected transaction replacement %s: %s", transactionID, err)
+		return errorMessage, nil
+	}
+
+	rpcReplacedTransactions := make([]*appmessage.RPCTransaction, len(replacedTransactions))
+	for i, replacedTransaction := range replacedTransactions {
+		rpcReplacedTransactions[i] = appmessage.DomainTransactionToRPCTransaction(replacedTransaction)
+	}
+
+	response := appmessage.NewSubmitTransactionReplacementResponseMessage(transactionID.String(), rpcReplacedTransactions)
+	return response, nil
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"os"
+
+	"github.com/kaspanet/kaspad/app/snapshot"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// runSnapshotCommand exports or imports a consensus state snapshot,
+// according to cfg, instead of running the node
+func runSnapshotCommand(cfg *config.Config, db database.Database) error {
+	domain, err := newDomain(cfg, db)
+	if err != nil {
+		log.Errorf("Unable to create the domain: %+v", err)
+		return err
+	}
+
+	if cfg.ExportSnapshot != "" {
+		err = func() error {
+			log.Infof("Exporting a snapshot into '%s'", cfg.ExportSnapshot)
+			file, err := os.Create(cfg.ExportSnapshot)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			defer file.Close()
+
+			err = snapshot.Export(domain.Consensus(), cfg.ActiveNetParams, file)
+			if err != nil {
+				return err
+			}
+			return errors.WithStack(file.Sync())
+		}()
+	} else {
+		err = func() error {
+			log.Infof("Importing a snapshot from '%s'", cfg.ImportSnapshot)
+			file, err := os.Open(cfg.ImportSnapshot)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			defer file.Close()
+
+			return snapshot.Import(domain, cfg.ActiveNetParams, file)
+		}()
+	}
+	if err != nil {
+		log.Errorf("Snapshot command failed: %+v", err)
+		return err
+	}
+
+	log.Infof("Snapshot command completed successfully")
+	return nil
+}
@@ -0,0 +1,163 @@
+package snapshot
+
+import (
+	"io"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/protocol/flows/v5/blockrelay"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+// Export writes a snapshot of the given consensus into w.
+//
+// NOTE: No new blocks may be added to the consensus while it's being exported.
+func Export(consensus externalapi.Consensus, params *dagconfig.Params, w io.Writer) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "snapshot.Export")
+	defer onEnd()
+
+	pruningPoint, err := consensus.PruningPoint()
+	if err != nil {
+		return err
+	}
+	if pruningPoint.Equal(params.GenesisHash) {
+		return errors.Errorf("cannot export a snapshot while the pruning point is the genesis")
+	}
+
+	snapshotWriter := newWriter(w)
+	err = snapshotWriter.writeHeader(params.Name)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Exporting the pruning point proof of pruning point %s", pruningPoint)
+	pruningPointProof, err := consensus.BuildPruningPointProof()
+	if err != nil {
+		return err
+	}
+	err = snapshotWriter.writeMessage(appmessage.DomainPruningPointProofToMsgPruningPointProof(pruningPointProof))
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Exporting the past pruning points and the pruning point anticone")
+	err = exportPruningPointsAndPruningPointAnticone(consensus, params, snapshotWriter)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Exporting the pruning point future headers")
+	err = exportPruningPointFutureHeaders(consensus, pruningPoint, snapshotWriter)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Exporting the pruning point UTXO set")
+	err = exportPruningPointUTXOSet(consensus, pruningPoint, snapshotWriter)
+	if err != nil {
+		return err
+	}
+
+	return snapshotWriter.flush()
+}
+
+func exportPruningPointsAndPruningPointAnticone(consensus externalapi.Consensus, params *dagconfig.Params,
+	snapshotWriter *writer) error {
+
+	pruningPointHeaders, err := consensus.PruningPointHeaders()
+	if err != nil {
+		return err
+	}
+	msgPruningPointHeaders := make([]*appmessage.MsgBlockHeader, len(pruningPointHeaders))
+	for i, header := range pruningPointHeaders {
+		msgPruningPointHeaders[i] = appmessage.DomainBlockHeaderToBlockHeader(header)
+	}
+	err = snapshotWriter.writeMessage(appmessage.NewMsgPruningPoints(msgPruningPointHeaders))
+	if err != nil {
+		return err
+	}
+
+	trustedData, blocksWithTrustedData, err := blockrelay.PruningPointAndItsAnticoneWithTrustedData(consensus, params)
+	if err != nil {
+		return err
+	}
+	err = snapshotWriter.writeMessage(trustedData)
+	if err != nil {
+		return err
+	}
+	for _, blockWithTrustedData := range blocksWithTrustedData {
+		err = snapshotWriter.writeMessage(blockWithTrustedData)
+		if err != nil {
+			return err
+		}
+	}
+	return snapshotWriter.writeMessage(appmessage.NewMsgDoneBlocksWithTrustedData())
+}
+
+func exportPruningPointFutureHeaders(consensus externalapi.Consensus, pruningPoint *externalapi.DomainHash,
+	snapshotWriter *writer) error {
+
+	headersSelectedTip, err := consensus.GetHeadersSelectedTip()
+	if err != nil {
+		return err
+	}
+
+	headerCount := 0
+	lowHash := pruningPoint
+	for !lowHash.Equal(headersSelectedTip) {
+		blockHashes, _, err := consensus.GetHashesBetween(lowHash, headersSelectedTip, maxHeadersPerMessage)
+		if err != nil {
+			return err
+		}
+
+		blockHeaders := make([]*appmessage.MsgBlockHeader, len(blockHashes))
+		for i, blockHash := range blockHashes {
+			blockHeader, err := consensus.GetBlockHeader(blockHash)
+			if err != nil {
+				return err
+			}
+			blockHeaders[i] = appmessage.DomainBlockHeaderToBlockHeader(blockHeader)
+		}
+		err = snapshotWriter.writeMessage(appmessage.NewBlockHeadersMessage(blockHeaders))
+		if err != nil {
+			return err
+		}
+
+		headerCount += len(blockHashes)
+		lowHash = blockHashes[len(blockHashes)-1]
+	}
+
+	log.Infof("Exported %d headers", headerCount)
+	return snapshotWriter.writeMessage(appmessage.NewMsgDoneHeaders())
+}
+
+func exportPruningPointUTXOSet(consensus externalapi.Consensus, pruningPoint *externalapi.DomainHash,
+	snapshotWriter *writer) error {
+
+	utxoCount := 0
+	var fromOutpoint *externalapi.DomainOutpoint
+	for {
+		pruningPointUTXOs, err := consensus.GetPruningPointUTXOs(pruningPoint, fromOutpoint, utxoSetChunkSize)
+		if err != nil {
+			return err
+		}
+
+		outpointAndUTXOEntryPairs :=
+			appmessage.DomainOutpointAndUTXOEntryPairsToOutpointAndUTXOEntryPairs(pruningPointUTXOs)
+		err = snapshotWriter.writeMessage(appmessage.NewMsgPruningPointUTXOSetChunk(outpointAndUTXOEntryPairs))
+		if err != nil {
+			return err
+		}
+
+		utxoCount += len(pruningPointUTXOs)
+		if len(pruningPointUTXOs) < utxoSetChunkSize {
+			break
+		}
+		fromOutpoint = pruningPointUTXOs[len(pruningPointUTXOs)-1].Outpoint
+	}
+
+	log.Infof("Exported %d UTXOs", utxoCount)
+	return snapshotWriter.writeMessage(appmessage.NewMsgDonePruningPointUTXOSetChunks())
+}
@@ -0,0 +1,384 @@
+package snapshot
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+// Import reads a snapshot from r and applies it to the given domain. The
+// snapshot goes through the same validation as the data received during
+// IBD with a headers proof, and replaces the current consensus only if
+// it's applied successfully.
+func Import(domain domain.Domain, params *dagconfig.Params, r io.Reader) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "snapshot.Import")
+	defer onEnd()
+
+	snapshotReader := newReader(r)
+	err := snapshotReader.readHeader(params.Name)
+	if err != nil {
+		return err
+	}
+
+	err = domain.InitStagingConsensusWithoutGenesis()
+	if err != nil {
+		return err
+	}
+
+	snapshotImporter := &importer{
+		domain:         domain,
+		params:         params,
+		snapshotReader: snapshotReader,
+	}
+	err = snapshotImporter.importIntoStagingConsensus()
+	if err != nil {
+		log.Infof("Importing the snapshot was unsuccessful. Deleting the staging consensus. (%s)", err)
+		deleteStagingConsensusErr := domain.DeleteStagingConsensus()
+		if deleteStagingConsensusErr != nil {
+			return deleteStagingConsensusErr
+		}
+		return err
+	}
+
+	log.Infof("Snapshot imported successfully. Committing the staging consensus " +
+		"and deleting the previous obsolete one")
+	return domain.CommitStagingConsensus()
+}
+
+type importer struct {
+	domain         domain.Domain
+	params         *dagconfig.Params
+	snapshotReader *reader
+}
+
+func (imp *importer) importIntoStagingConsensus() error {
+	proofPruningPoint, err := imp.importPruningPointProof()
+	if err != nil {
+		return err
+	}
+
+	err = imp.importPruningPoints(proofPruningPoint)
+	if err != nil {
+		return err
+	}
+
+	err = imp.importPruningPointAndItsAnticone(proofPruningPoint)
+	if err != nil {
+		return err
+	}
+
+	// TODO: Remove this condition once there's more proper way to check finality violation
+	// in the headers proof.
+	if proofPruningPoint.Equal(imp.params.GenesisHash) {
+		return errors.Errorf("the genesis pruning point violates finality")
+	}
+
+	err = imp.importPruningPointFutureHeaders()
+	if err != nil {
+		return err
+	}
+
+	err = imp.validatePruningPointFutureHeaderTimestamps()
+	if err != nil {
+		return err
+	}
+
+	return imp.importPruningPointUTXOSet(proofPruningPoint)
+}
+
+func (imp *importer) importPruningPointProof() (*externalapi.DomainHash, error) {
+	log.Infof("Importing the pruning point proof")
+	message, err := imp.snapshotReader.readMessage()
+	if err != nil {
+		return nil, err
+	}
+	pruningPointProofMessage, ok := message.(*appmessage.MsgPruningPointProof)
+	if !ok {
+		return nil, unexpectedMessageError(message, appmessage.CmdPruningPointProof)
+	}
+
+	pruningPointProof := appmessage.MsgPruningPointProofToDomainPruningPointProof(pruningPointProofMessage)
+	err = imp.domain.Consensus().ValidatePruningPointProof(pruningPointProof)
+	if err != nil {
+		return nil, errors.Wrapf(err, "pruning point proof validation failed")
+	}
+
+	err = imp.domain.StagingConsensus().ApplyPruningPointProof(pruningPointProof)
+	if err != nil {
+		return nil, err
+	}
+
+	return consensushashing.HeaderHash(pruningPointProof.Headers[0][len(pruningPointProof.Headers[0])-1]), nil
+}
+
+func (imp *importer) importPruningPoints(proofPruningPoint *externalapi.DomainHash) error {
+	currentPruningPoint, err := imp.domain.Consensus().PruningPoint()
+	if err != nil {
+		return err
+	}
+	if currentPruningPoint.Equal(proofPruningPoint) {
+		return errors.Errorf("the snapshot pruning point is the same as the current pruning point")
+	}
+
+	message, err := imp.snapshotReader.readMessage()
+	if err != nil {
+		return err
+	}
+	pruningPointsMessage, ok := message.(*appmessage.MsgPruningPoints)
+	if !ok {
+		return unexpectedMessageError(message, appmessage.CmdPruningPoints)
+	}
+	if len(pruningPointsMessage.Headers) == 0 {
+		return errors.Errorf("the snapshot contains no pruning points")
+	}
+
+	headers := make([]externalapi.BlockHeader, len(pruningPointsMessage.Headers))
+	for i, header := range pruningPointsMessage.Headers {
+		headers[i] = appmessage.BlockHeaderToDomainBlockHeader(header)
+	}
+
+	arePruningPointsViolatingFinality, err := imp.domain.Consensus().ArePruningPointsViolatingFinality(headers)
+	if err != nil {
+		return err
+	}
+	if arePruningPointsViolatingFinality {
+		return errors.Errorf("pruning points are violating finality")
+	}
+
+	lastPruningPoint := consensushashing.HeaderHash(headers[len(headers)-1])
+	if !lastPruningPoint.Equal(proofPruningPoint) {
+		return errors.Errorf("the proof pruning point is not equal to the last pruning " +
+			"point in the list")
+	}
+
+	return imp.domain.StagingConsensus().ImportPruningPoints(headers)
+}
+
+func (imp *importer) importPruningPointAndItsAnticone(proofPruningPoint *externalapi.DomainHash) error {
+	log.Infof("Importing the pruning point and its anticone")
+	message, err := imp.snapshotReader.readMessage()
+	if err != nil {
+		return err
+	}
+	trustedDataMessage, ok := message.(*appmessage.MsgTrustedData)
+	if !ok {
+		return unexpectedMessageError(message, appmessage.CmdTrustedData)
+	}
+
+	blockCount := 0
+	for ; ; blockCount++ {
+		message, err := imp.snapshotReader.readMessage()
+		if err != nil {
+			return err
+		}
+
+		var blockWithTrustedDataMessage *appmessage.MsgBlockWithTrustedDataV4
+		switch message := message.(type) {
+		case *appmessage.MsgBlockWithTrustedDataV4:
+			blockWithTrustedDataMessage = message
+		case *appmessage.MsgDoneBlocksWithTrustedData:
+			if blockCount == 0 {
+				return errors.Errorf("the snapshot doesn't contain the pruning point")
+			}
+			log.Infof("Imported the pruning point and its anticone. Total blocks: %d", blockCount)
+			return nil
+		default:
+			return unexpectedMessageError(message,
+				appmessage.CmdBlockWithTrustedDataV4, appmessage.CmdDoneBlocksWithTrustedData)
+		}
+
+		if blockCount == 0 && !blockWithTrustedDataMessage.Block.Header.BlockHash().Equal(proofPruningPoint) {
+			return errors.Errorf("first block with trusted data is not the pruning point")
+		}
+
+		err = imp.processBlockWithTrustedData(blockWithTrustedDataMessage, trustedDataMessage)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (imp *importer) processBlockWithTrustedData(
+	block *appmessage.MsgBlockWithTrustedDataV4, data *appmessage.MsgTrustedData) error {
+
+	blockWithTrustedData := &externalapi.BlockWithTrustedData{
+		Block:        appmessage.MsgBlockToDomainBlock(block.Block),
+		DAAWindow:    make([]*externalapi.TrustedDataDataDAAHeader, 0, len(block.DAAWindowIndices)),
+		GHOSTDAGData: make([]*externalapi.BlockGHOSTDAGDataHashPair, 0, len(block.GHOSTDAGDataIndices)),
+	}
+
+	for _, index := range block.DAAWindowIndices {
+		if index >= uint64(len(data.DAAWindow)) {
+			return errors.Errorf("DAA window index %d is out of range", index)
+		}
+		blockWithTrustedData.DAAWindow = append(blockWithTrustedData.DAAWindow,
+			appmessage.TrustedDataDataDAABlockV4ToTrustedDataDataDAAHeader(data.DAAWindow[index]))
+	}
+
+	for _, index := range block.GHOSTDAGDataIndices {
+		if index >= uint64(len(data.GHOSTDAGData)) {
+			return errors.Errorf("GHOSTDAG data index %d is out of range", index)
+		}
+		blockWithTrustedData.GHOSTDAGData = append(blockWithTrustedData.GHOSTDAGData,
+			appmessage.GHOSTDAGHashPairToDomainGHOSTDAGHashPair(data.GHOSTDAGData[index]))
+	}
+
+	err := imp.domain.StagingConsensus().ValidateAndInsertBlockWithTrustedData(blockWithTrustedData, false)
+	if err != nil {
+		return errors.Wrapf(err, "failed validating block with trusted data")
+	}
+	return nil
+}
+
+func (imp *importer) importPruningPointFutureHeaders() error {
+	log.Infof("Importing the pruning point future headers")
+	headerCount := 0
+	for {
+		message, err := imp.snapshotReader.readMessage()
+		if err != nil {
+			return err
+		}
+
+		switch message := message.(type) {
+		case *appmessage.BlockHeadersMessage:
+			for _, header := range message.BlockHeaders {
+				err = imp.processHeader(header)
+				if err != nil {
+					return err
+				}
+			}
+			headerCount += len(message.BlockHeaders)
+			log.Debugf("Imported %d headers so far", headerCount)
+		case *appmessage.MsgDoneHeaders:
+			log.Infof("Imported %d headers", headerCount)
+			return nil
+		default:
+			return unexpectedMessageError(message, appmessage.CmdBlockHeaders, appmessage.CmdDoneHeaders)
+		}
+	}
+}
+
+func (imp *importer) processHeader(msgBlockHeader *appmessage.MsgBlockHeader) error {
+	header := appmessage.BlockHeaderToDomainBlockHeader(msgBlockHeader)
+	block := &externalapi.DomainBlock{
+		Header:       header,
+		Transactions: nil,
+	}
+
+	blockHash := consensushashing.BlockHash(block)
+	blockInfo, err := imp.domain.StagingConsensus().GetBlockInfo(blockHash)
+	if err != nil {
+		return err
+	}
+	if blockInfo.Exists {
+		log.Debugf("Block header %s is already in the DAG. Skipping...", blockHash)
+		return nil
+	}
+	err = imp.domain.StagingConsensus().ValidateAndInsertBlock(block, false)
+	if err != nil {
+		if errors.Is(err, ruleerrors.ErrDuplicateBlock) {
+			log.Debugf("Skipping block header %s as it is a duplicate", blockHash)
+			return nil
+		}
+		return errors.Wrapf(err, "got invalid block header %s", blockHash)
+	}
+	return nil
+}
+
+func (imp *importer) validatePruningPointFutureHeaderTimestamps() error {
+	headerSelectedTipHash, err := imp.domain.StagingConsensus().GetHeadersSelectedTip()
+	if err != nil {
+		return err
+	}
+	headerSelectedTipHeader, err := imp.domain.StagingConsensus().GetBlockHeader(headerSelectedTipHash)
+	if err != nil {
+		return err
+	}
+	headerSelectedTipTimestamp := headerSelectedTipHeader.TimeInMilliseconds()
+
+	currentSelectedTipHash, err := imp.domain.Consensus().GetHeadersSelectedTip()
+	if err != nil {
+		return err
+	}
+	currentSelectedTipHeader, err := imp.domain.Consensus().GetBlockHeader(currentSelectedTipHash)
+	if err != nil {
+		return err
+	}
+	currentSelectedTipTimestamp := currentSelectedTipHeader.TimeInMilliseconds()
+
+	if headerSelectedTipTimestamp < currentSelectedTipTimestamp {
+		return errors.Errorf("the timestamp of the candidate selected " +
+			"tip is smaller than the current selected tip")
+	}
+
+	minTimestampDifferenceInMilliseconds := (10 * time.Minute).Milliseconds()
+	if headerSelectedTipTimestamp-currentSelectedTipTimestamp < minTimestampDifferenceInMilliseconds {
+		return errors.Errorf("difference between the timestamps of " +
+			"the current pruning point and the candidate pruning point is too small")
+	}
+	return nil
+}
+
+func (imp *importer) importPruningPointUTXOSet(pruningPoint *externalapi.DomainHash) error {
+	log.Infof("Checking if the snapshot pruning point %s is compatible to the node DAG", pruningPoint)
+	isValid, err := imp.domain.StagingConsensus().IsValidPruningPoint(pruningPoint)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		return errors.Errorf("invalid pruning point %s", pruningPoint)
+	}
+
+	defer func() {
+		err := imp.domain.StagingConsensus().ClearImportedPruningPointData()
+		if err != nil {
+			panic(fmt.Sprintf("failed to clear imported pruning point data: %s", err))
+		}
+	}()
+
+	log.Infof("Importing the pruning point UTXO set")
+	utxoCount := 0
+	for done := false; !done; {
+		message, err := imp.snapshotReader.readMessage()
+		if err != nil {
+			return err
+		}
+
+		switch message := message.(type) {
+		case *appmessage.MsgPruningPointUTXOSetChunk:
+			domainOutpointAndUTXOEntryPairs :=
+				appmessage.OutpointAndUTXOEntryPairsToDomainOutpointAndUTXOEntryPairs(message.OutpointAndUTXOEntryPairs)
+			err := imp.domain.StagingConsensus().AppendImportedPruningPointUTXOs(domainOutpointAndUTXOEntryPairs)
+			if err != nil {
+				return err
+			}
+			utxoCount += len(message.OutpointAndUTXOEntryPairs)
+		case *appmessage.MsgDonePruningPointUTXOSetChunks:
+			done = true
+		default:
+			return unexpectedMessageError(message,
+				appmessage.CmdPruningPointUTXOSetChunk, appmessage.CmdDonePruningPointUTXOSetChunks)
+		}
+	}
+	log.Infof("Imported the pruning point UTXO set. Total UTXOs: %d", utxoCount)
+
+	err = imp.domain.StagingConsensus().ValidateAndInsertImportedPruningPoint(pruningPoint)
+	if err != nil {
+		return errors.Wrapf(err, "error with pruning point UTXO set")
+	}
+	return nil
+}
+
+func unexpectedMessageError(message appmessage.Message, expectedCommands ...appmessage.MessageCommand) error {
+	return errors.Errorf("unexpected message in snapshot. expected: %s, got: %s",
+		expectedCommands, message.Command())
+}
@@ -0,0 +1,157 @@
+// Package snapshot implements exporting the consensus state of a node into a
+// single file and importing it into another node, as an offline alternative
+// to IBD with a headers proof.
+//
+// A snapshot file starts with a header made of a magic string, the snapshot
+// format version and the name of the network it was taken from. It is followed
+// by the same messages a syncer sends during IBD with a headers proof, in the
+// same order, each prefixed with its length:
+//
+//   - The pruning point proof
+//   - The past pruning points
+//   - The trusted data, followed by the pruning point and its anticone
+//   - The headers of the pruning point future
+//   - The pruning point UTXO set
+package snapshot
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// Version is the version of the snapshot format written by Export
+const Version uint32 = 1
+
+const (
+	magic = "KASSNAPS"
+
+	// maxMessageSize is the largest message a snapshot may contain. It exists
+	// so that a corrupted length prefix won't make us allocate an arbitrary
+	// amount of memory
+	maxMessageSize = 1 << 30 // 1 GiB
+
+	// utxoSetChunkSize is the amount of UTXOs written in each UTXO set chunk
+	utxoSetChunkSize = 1000
+
+	// maxHeadersPerMessage is the amount of headers written in each headers message
+	maxHeadersPerMessage = 1 << 10
+)
+
+type writer struct {
+	bufferedWriter *bufio.Writer
+}
+
+func newWriter(w io.Writer) *writer {
+	return &writer{bufferedWriter: bufio.NewWriter(w)}
+}
+
+func (w *writer) writeHeader(networkName string) error {
+	_, err := w.bufferedWriter.WriteString(magic)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = binary.Write(w.bufferedWriter, binary.LittleEndian, Version)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return w.writeBytes([]byte(networkName))
+}
+
+func (w *writer) writeMessage(message appmessage.Message) error {
+	protoMessage, err := protowire.FromAppMessage(message)
+	if err != nil {
+		return err
+	}
+	messageBytes, err := proto.Marshal(protoMessage)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return w.writeBytes(messageBytes)
+}
+
+func (w *writer) writeBytes(bytes []byte) error {
+	err := binary.Write(w.bufferedWriter, binary.LittleEndian, uint32(len(bytes)))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = w.bufferedWriter.Write(bytes)
+	return errors.WithStack(err)
+}
+
+func (w *writer) flush() error {
+	return errors.WithStack(w.bufferedWriter.Flush())
+}
+
+type reader struct {
+	bufferedReader *bufio.Reader
+}
+
+func newReader(r io.Reader) *reader {
+	return &reader{bufferedReader: bufio.NewReader(r)}
+}
+
+func (r *reader) readHeader(expectedNetworkName string) error {
+	magicBytes := make([]byte, len(magic))
+	_, err := io.ReadFull(r.bufferedReader, magicBytes)
+	if err != nil {
+		return errors.Wrapf(err, "failed reading the snapshot header")
+	}
+	if string(magicBytes) != magic {
+		return errors.Errorf("the given file is not a kaspad snapshot")
+	}
+
+	var version uint32
+	err = binary.Read(r.bufferedReader, binary.LittleEndian, &version)
+	if err != nil {
+		return errors.Wrapf(err, "failed reading the snapshot version")
+	}
+	if version != Version {
+		return errors.Errorf("unsupported snapshot version %d. Expected version: %d", version, Version)
+	}
+
+	networkName, err := r.readBytes()
+	if err != nil {
+		return err
+	}
+	if string(networkName) != expectedNetworkName {
+		return errors.Errorf("the snapshot was taken from network %s, but the node is "+
+			"running on network %s", networkName, expectedNetworkName)
+	}
+	return nil
+}
+
+func (r *reader) readMessage() (appmessage.Message, error) {
+	messageBytes, err := r.readBytes()
+	if err != nil {
+		return nil, err
+	}
+	protoMessage := &protowire.KaspadMessage{}
+	err = proto.Unmarshal(messageBytes, protoMessage)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed deserializing a snapshot message")
+	}
+	return protoMessage.ToAppMessage()
+}
+
+func (r *reader) readBytes() ([]byte, error) {
+	var length uint32
+	err := binary.Read(r.bufferedReader, binary.LittleEndian, &length)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading the snapshot")
+	}
+	if length > maxMessageSize {
+		return nil, errors.Errorf("snapshot message of size %d exceeds the maximum of %d", length, maxMessageSize)
+	}
+	bytes := make([]byte, length)
+	_, err = io.ReadFull(r.bufferedReader, bytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading the snapshot")
+	}
+	return bytes, nil
+}
@@ -0,0 +1,196 @@
+package snapshot
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/mining"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/domain/miningmanager/mempool"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/memorydb"
+)
+
+func newTestDomain(t *testing.T, params *dagconfig.Params) domain.Domain {
+	consensusConfig := &consensus.Config{Params: *params}
+	domainInstance, err := domain.New(consensusConfig, mempool.DefaultConfig(params), memorydb.NewMemoryDB())
+	if err != nil {
+		t.Fatalf("domain.New: %+v", err)
+	}
+	return domainInstance
+}
+
+func testParams() *dagconfig.Params {
+	params := dagconfig.SimnetParams
+
+	// Mined blocks are spaced far enough apart to pass the
+	// timestamp threshold validation of the import
+	params.TargetTimePerBlock = time.Minute
+
+	// This is done to make a pruning depth of 6 blocks
+	params.FinalityDuration = 2 * params.TargetTimePerBlock
+	params.K = 0
+	params.PruningProofM = 20
+	return &params
+}
+
+func mineBlocks(t *testing.T, domainInstance domain.Domain, count int) {
+	rd := rand.New(rand.NewSource(0))
+	coinbaseData := &externalapi.DomainCoinbaseData{
+		ScriptPublicKey: &externalapi.ScriptPublicKey{Script: nil, Version: 0},
+	}
+
+	var mockTimestamp int64
+	for i := 0; i < count; i++ {
+		block, err := domainInstance.Consensus().BuildBlock(coinbaseData, nil)
+		if err != nil {
+			t.Fatalf("BuildBlock: %+v", err)
+		}
+
+		if mockTimestamp == 0 {
+			mockTimestamp = block.Header.TimeInMilliseconds()
+		} else {
+			mockTimestamp += 10_000
+		}
+		mutableHeader := block.Header.ToMutable()
+		mutableHeader.SetTimeInMilliseconds(mockTimestamp)
+		block.Header = mutableHeader.ToImmutable()
+		mining.SolveBlock(block, rd)
+
+		err = domainInstance.Consensus().ValidateAndInsertBlock(block, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertBlock: %+v", err)
+		}
+	}
+}
+
+func TestExportAndImport(t *testing.T) {
+	params := testParams()
+	source := newTestDomain(t, params)
+	mineBlocks(t, source, 100)
+
+	var snapshot bytes.Buffer
+	err := Export(source.Consensus(), params, &snapshot)
+	if err != nil {
+		t.Fatalf("Export: %+v", err)
+	}
+
+	target := newTestDomain(t, params)
+	err = Import(target, params, bytes.NewReader(snapshot.Bytes()))
+	if err != nil {
+		t.Fatalf("Import: %+v", err)
+	}
+
+	sourcePruningPoint, err := source.Consensus().PruningPoint()
+	if err != nil {
+		t.Fatalf("PruningPoint: %+v", err)
+	}
+	targetPruningPoint, err := target.Consensus().PruningPoint()
+	if err != nil {
+		t.Fatalf("PruningPoint: %+v", err)
+	}
+	if !sourcePruningPoint.Equal(targetPruningPoint) {
+		t.Fatalf("Unexpected pruning point: expected %s but got %s", sourcePruningPoint, targetPruningPoint)
+	}
+
+	sourceHeadersSelectedTip, err := source.Consensus().GetHeadersSelectedTip()
+	if err != nil {
+		t.Fatalf("GetHeadersSelectedTip: %+v", err)
+	}
+	targetHeadersSelectedTip, err := target.Consensus().GetHeadersSelectedTip()
+	if err != nil {
+		t.Fatalf("GetHeadersSelectedTip: %+v", err)
+	}
+	if !sourceHeadersSelectedTip.Equal(targetHeadersSelectedTip) {
+		t.Fatalf("Unexpected headers selected tip: expected %s but got %s",
+			sourceHeadersSelectedTip, targetHeadersSelectedTip)
+	}
+
+	sourceUTXOs, err := source.Consensus().GetPruningPointUTXOs(sourcePruningPoint, nil, 1000)
+	if err != nil {
+		t.Fatalf("GetPruningPointUTXOs: %+v", err)
+	}
+	targetUTXOs, err := target.Consensus().GetPruningPointUTXOs(targetPruningPoint, nil, 1000)
+	if err != nil {
+		t.Fatalf("GetPruningPointUTXOs: %+v", err)
+	}
+	if len(sourceUTXOs) != len(targetUTXOs) {
+		t.Fatalf("Unexpected amount of pruning point UTXOs: expected %d but got %d",
+			len(sourceUTXOs), len(targetUTXOs))
+	}
+
+	// Importing the same snapshot again must fail, since its pruning
+	// point is already the current one
+	err = Import(target, params, bytes.NewReader(snapshot.Bytes()))
+	if err == nil || !strings.Contains(err.Error(), "same as the current pruning point") {
+		t.Fatalf("Unexpected error: %+v", err)
+	}
+}
+
+func TestImportErrors(t *testing.T) {
+	params := testParams()
+	source := newTestDomain(t, params)
+	mineBlocks(t, source, 100)
+
+	var snapshot bytes.Buffer
+	err := Export(source.Consensus(), params, &snapshot)
+	if err != nil {
+		t.Fatalf("Export: %+v", err)
+	}
+	snapshotBytes := snapshot.Bytes()
+
+	tests := []struct {
+		name          string
+		params        *dagconfig.Params
+		snapshot      []byte
+		expectedError string
+	}{
+		{
+			name:          "wrong magic",
+			params:        params,
+			snapshot:      append([]byte("NOTASNAP"), snapshotBytes[len(magic):]...),
+			expectedError: "not a kaspad snapshot",
+		},
+		{
+			name:          "wrong network",
+			params:        &dagconfig.DevnetParams,
+			snapshot:      snapshotBytes,
+			expectedError: "was taken from network",
+		},
+		{
+			name:          "truncated",
+			params:        params,
+			snapshot:      snapshotBytes[:len(snapshotBytes)/2],
+			expectedError: "failed reading the snapshot",
+		},
+	}
+
+	for _, test := range tests {
+		target := newTestDomain(t, test.params)
+		err := Import(target, test.params, bytes.NewReader(test.snapshot))
+		if err == nil || !strings.Contains(err.Error(), test.expectedError) {
+			t.Fatalf("%s: expected error containing %q but got: %+v", test.name, test.expectedError, err)
+		}
+
+		// A failed import must not leave a staging consensus behind
+		err = target.InitStagingConsensusWithoutGenesis()
+		if err != nil {
+			t.Fatalf("%s: InitStagingConsensusWithoutGenesis: %+v", test.name, err)
+		}
+	}
+}
+
+func TestExportGenesisPruningPoint(t *testing.T) {
+	params := testParams()
+	source := newTestDomain(t, params)
+
+	err := Export(source.Consensus(), params, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "pruning point is the genesis") {
+		t.Fatalf("Unexpected error: %+v", err)
+	}
+}
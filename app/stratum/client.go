@@ -0,0 +1,260 @@
+package stratum
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kaspanet/kaspad/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	// extranonceSize is the size in bytes of the extranonce assigned to
+	// every miner. The extranonce is the most significant bytes of the nonce,
+	// so that miners that work on the same job never search the same nonces
+	extranonceSize = 2
+
+	// nonceSize is the size in bytes of a block nonce
+	nonceSize = 8
+
+	maxRequestSize = 16 * 1024
+	writeTimeout   = 10 * time.Second
+)
+
+// client is a connected Stratum miner
+type client struct {
+	server     *Server
+	connection net.Conn
+	extranonce uint16
+
+	writeLock sync.Mutex
+
+	lock               sync.Mutex
+	isSubscribed       bool
+	workerName         string
+	payAddress         util.Address
+	difficulty         float64
+	previousDifficulty float64
+	sentDifficulty     float64
+	shareCount         int
+	windowStart        time.Time
+}
+
+func newClient(server *Server, connection net.Conn, extranonce uint16, difficulty float64) *client {
+	return &client{
+		server:             server,
+		connection:         connection,
+		extranonce:         extranonce,
+		difficulty:         difficulty,
+		previousDifficulty: difficulty,
+	}
+}
+
+func (c *client) String() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.workerName == "" {
+		return c.connection.RemoteAddr().String()
+	}
+	return c.workerName + "@" + c.connection.RemoteAddr().String()
+}
+
+// readLoop handles the requests of the miner until it disconnects
+func (c *client) readLoop() {
+	defer c.server.removeClient(c)
+
+	scanner := bufio.NewScanner(c.connection)
+	scanner.Buffer(make([]byte, 0, 1024), maxRequestSize)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		request := &request{}
+		err := json.Unmarshal(line, request)
+		if err != nil {
+			log.Debugf("Disconnecting Stratum miner %s because it sent a malformed request: %s", c, err)
+			return
+		}
+
+		result, stratumErr := c.handleRequest(request)
+		if stratumErr != nil {
+			log.Debugf("Stratum request %s of %s failed: %s", request.Method, c, stratumErr)
+		}
+		err = c.send(&response{ID: request.ID, Result: result, Error: stratumErr})
+		if err != nil {
+			log.Debugf("Could not respond to Stratum miner %s: %s", c, err)
+			return
+		}
+
+		if request.Method == methodAuthorize && stratumErr == nil {
+			err := c.server.sendInitialWork(c)
+			if err != nil {
+				log.Debugf("Could not send work to Stratum miner %s: %s", c, err)
+				return
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Debugf("Stopped reading from Stratum miner %s: %s", c, err)
+	}
+}
+
+func (c *client) handleRequest(request *request) (interface{}, *stratumError) {
+	switch request.Method {
+	case methodSubscribe:
+		c.lock.Lock()
+		c.isSubscribed = true
+		c.lock.Unlock()
+		if agent, err := request.stringParam(0); err == nil {
+			log.Debugf("Stratum miner %s subscribed using %s", c, agent)
+		}
+		return []interface{}{true, protocolIdentifier}, nil
+	case methodExtranonceSubscribe:
+		return true, nil
+	case methodAuthorize:
+		username, err := request.stringParam(0)
+		if err != nil {
+			return nil, err
+		}
+		return c.server.authorize(c, username)
+	case methodSubmit:
+		return c.server.submit(c, request)
+	default:
+		return nil, newStratumError(errorCodeOther, "unknown method %s", request.Method)
+	}
+}
+
+// authorizedPayAddress returns the address that the blocks of the miner pay
+// to, or nil if the miner isn't authorized yet
+func (c *client) authorizedPayAddress() util.Address {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.payAddress
+}
+
+// shareDifficulty returns the lowest difficulty that the shares of the miner
+// may currently have. It's the lower of the current difficulty and the one
+// before it, since shares of jobs that were sent before an adjustment might
+// still arrive
+func (c *client) shareDifficulty() float64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.previousDifficulty < c.difficulty {
+		return c.previousDifficulty
+	}
+	return c.difficulty
+}
+
+func (c *client) countShare() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.shareCount++
+}
+
+// adjustDifficulty moves the difficulty of the miner towards
+// targetSharesPerMinute once every varDiffWindow. The new difficulty is sent
+// along with the next job
+func (c *client) adjustDifficulty(now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elapsed := now.Sub(c.windowStart)
+	if elapsed < varDiffWindow {
+		return
+	}
+
+	next := nextDifficulty(c.difficulty, c.shareCount, elapsed)
+	if next != c.difficulty {
+		log.Debugf("Adjusting the share difficulty of Stratum worker %s from %g to %g after %d shares in %s",
+			c.workerName, c.difficulty, next, c.shareCount, elapsed)
+	}
+	c.previousDifficulty = c.difficulty
+	c.difficulty = next
+	c.shareCount = 0
+	c.windowStart = now
+}
+
+// parseNonce parses a submitted nonce. Miners may submit either the whole
+// nonce, or only the part that follows their extranonce
+func (c *client) parseNonce(nonceHex string) (uint64, error) {
+	nonceHex = strings.TrimPrefix(nonceHex, "0x")
+	if len(nonceHex) == 0 || len(nonceHex) > nonceSize*2 {
+		return 0, errors.Errorf("nonce %s is not a hex encoded %d byte number", nonceHex, nonceSize)
+	}
+	nonce, err := strconv.ParseUint(nonceHex, 16, 64)
+	if err != nil {
+		return 0, errors.Errorf("nonce %s is not a hex encoded %d byte number", nonceHex, nonceSize)
+	}
+
+	const extranonceShift = (nonceSize - extranonceSize) * 8
+	if len(nonceHex) <= (nonceSize-extranonceSize)*2 {
+		nonce |= uint64(c.extranonce) << extranonceShift
+	}
+	return nonce, nil
+}
+
+func (c *client) extranonceHex() string {
+	extranonce := []byte{byte(c.extranonce >> 8), byte(c.extranonce)}
+	return hex.EncodeToString(extranonce)
+}
+
+// sendJob sends the given job to the miner, preceded by its share difficulty
+// if it changed. The share difficulty is never above the difficulty of the
+// block itself, so that every block the miner finds is submitted as a share
+func (c *client) sendJob(job *job) error {
+	c.lock.Lock()
+	difficulty := c.difficulty
+	blockDifficulty := targetToDifficulty(&job.state.Target)
+	if blockDifficulty < difficulty {
+		difficulty = blockDifficulty
+	}
+	shouldSendDifficulty := difficulty != c.sentDifficulty
+	c.sentDifficulty = difficulty
+	c.lock.Unlock()
+
+	if shouldSendDifficulty {
+		err := c.notify(methodSetDifficulty, difficulty)
+		if err != nil {
+			return err
+		}
+	}
+	return c.notify(methodNotify, job.notifyParams()...)
+}
+
+func (c *client) notify(method string, params ...interface{}) error {
+	return c.send(&notification{Method: method, Params: params})
+}
+
+func (c *client) send(message interface{}) error {
+	// Encoder appends the newline that delimits messages
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(message)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	err = c.connection.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = c.connection.Write(buffer.Bytes())
+	return errors.WithStack(err)
+}
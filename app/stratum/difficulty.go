@@ -0,0 +1,71 @@
+package stratum
+
+import (
+	"math/big"
+	"time"
+)
+
+const (
+	// targetSharesPerMinute is the share rate the difficulty of every miner
+	// is adjusted towards
+	targetSharesPerMinute = 20
+
+	// varDiffWindow is how often the difficulty of a miner is adjusted
+	varDiffWindow = time.Minute
+
+	// maxDifficultyChangeFactor bounds how much the difficulty of a miner
+	// may change in a single adjustment
+	maxDifficultyChangeFactor = 4
+
+	// minDifficulty is the lowest share difficulty a miner is adjusted to
+	minDifficulty = 1e-9
+)
+
+// diff1Target is the share target of difficulty 1. Like Bitcoin's Stratum,
+// it is 0xffff * 2^208, so a share of difficulty 1 takes about 2^32 hashes
+var diff1Target = new(big.Int).Lsh(big.NewInt(0xffff), 208)
+
+// difficultyToTarget returns the share target of the given difficulty
+func difficultyToTarget(difficulty float64) *big.Int {
+	target := new(big.Float).SetInt(diff1Target)
+	target.Quo(target, big.NewFloat(difficulty))
+	targetInt, _ := target.Int(nil)
+	return targetInt
+}
+
+// targetToDifficulty returns the share difficulty of the given target
+func targetToDifficulty(target *big.Int) float64 {
+	difficulty := new(big.Float).SetInt(diff1Target)
+	difficulty.Quo(difficulty, new(big.Float).SetInt(target))
+	difficultyFloat, _ := difficulty.Float64()
+	return difficultyFloat
+}
+
+// nextDifficulty returns the difficulty a miner that submitted shareCount
+// shares at the given difficulty over elapsed should be moved to. The
+// difficulty is left unchanged while the share rate is within a factor of 2
+// of targetSharesPerMinute, to avoid changing it over mere noise
+func nextDifficulty(difficulty float64, shareCount int, elapsed time.Duration) float64 {
+	var ratio float64
+	if shareCount == 0 {
+		ratio = 1.0 / maxDifficultyChangeFactor
+	} else {
+		sharesPerMinute := float64(shareCount) / elapsed.Minutes()
+		ratio = sharesPerMinute / targetSharesPerMinute
+		if ratio >= 0.5 && ratio <= 2 {
+			return difficulty
+		}
+		if ratio > maxDifficultyChangeFactor {
+			ratio = maxDifficultyChangeFactor
+		}
+		if ratio < 1.0/maxDifficultyChangeFactor {
+			ratio = 1.0 / maxDifficultyChangeFactor
+		}
+	}
+
+	next := difficulty * ratio
+	if next < minDifficulty {
+		return minDifficulty
+	}
+	return next
+}
@@ -0,0 +1,50 @@
+package stratum
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestDifficultyToTarget(t *testing.T) {
+	if difficultyToTarget(1).Cmp(diff1Target) != 0 {
+		t.Fatalf("The target of difficulty 1 is %x, expected %x", difficultyToTarget(1), diff1Target)
+	}
+
+	expectedTarget := new(big.Int).Rsh(diff1Target, 10)
+	if difficultyToTarget(1024).Cmp(expectedTarget) != 0 {
+		t.Fatalf("The target of difficulty 1024 is %x, expected %x", difficultyToTarget(1024), expectedTarget)
+	}
+
+	for _, difficulty := range []float64{1e-6, 0.5, 1, 3, 4096, 1e12} {
+		roundTrip := targetToDifficulty(difficultyToTarget(difficulty))
+		if math.Abs(roundTrip-difficulty)/difficulty > 1e-9 {
+			t.Errorf("Difficulty %g became %g after converting to a target and back", difficulty, roundTrip)
+		}
+	}
+}
+
+func TestNextDifficulty(t *testing.T) {
+	tests := []struct {
+		name       string
+		difficulty float64
+		shareCount int
+		elapsed    time.Duration
+		expected   float64
+	}{
+		{"on target", 8, targetSharesPerMinute, time.Minute, 8},
+		{"within noise", 8, targetSharesPerMinute * 3 / 2, time.Minute, 8},
+		{"too many shares", 8, targetSharesPerMinute * 3, time.Minute, 24},
+		{"far too many shares", 8, targetSharesPerMinute * 100, time.Minute, 8 * maxDifficultyChangeFactor},
+		{"too few shares", 8, 12, 2 * time.Minute, 8 * 6.0 / targetSharesPerMinute},
+		{"no shares", 8, 0, time.Minute, 8.0 / maxDifficultyChangeFactor},
+		{"minimum difficulty", minDifficulty, 0, time.Minute, minDifficulty},
+	}
+	for _, test := range tests {
+		next := nextDifficulty(test.difficulty, test.shareCount, test.elapsed)
+		if math.Abs(next-test.expected) > 1e-9*test.expected {
+			t.Errorf("%s: got difficulty %g, expected %g", test.name, next, test.expected)
+		}
+	}
+}
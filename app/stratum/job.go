@@ -0,0 +1,79 @@
+package stratum
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/pow"
+)
+
+// job is a block template that miners work on, identified by its ID
+type job struct {
+	id         string
+	payAddress string
+	block      *externalapi.DomainBlock
+	state      *pow.State
+
+	submittedNoncesLock sync.Mutex
+	submittedNonces     map[uint64]struct{}
+}
+
+// newJob creates a job for the given block template. Its ID is assigned once
+// it's stored
+func newJob(payAddress string, block *externalapi.DomainBlock) *job {
+	return &job{
+		payAddress:      payAddress,
+		block:           block,
+		state:           pow.NewState(block.Header.ToMutable()),
+		submittedNonces: make(map[uint64]struct{}),
+	}
+}
+
+// hasSameWork returns whether both jobs have the same header, up to the nonce,
+// in which case there's no point in sending the other one to miners
+func (j *job) hasSameWork(other *job) bool {
+	return j.state.Timestamp == other.state.Timestamp && j.state.PrePowHash().Equal(other.state.PrePowHash())
+}
+
+// notifyParams returns the parameters of the mining.notify of this job: the
+// job ID, the pre-PoW hash as 4 little endian 64-bit words and the timestamp
+func (j *job) notifyParams() []interface{} {
+	prePowHash := j.state.PrePowHash().ByteSlice()
+	words := make([]uint64, len(prePowHash)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(prePowHash[i*8:])
+	}
+	return []interface{}{j.id, words, j.state.Timestamp}
+}
+
+// proofOfWorkState returns a copy of the PoW state of this job with the
+// given nonce, that may be used concurrently with other copies
+func (j *job) proofOfWorkState(nonce uint64) *pow.State {
+	state := *j.state
+	state.Nonce = nonce
+	return &state
+}
+
+// addSubmittedNonce marks the given nonce as submitted, and returns false if
+// it was already submitted before
+func (j *job) addSubmittedNonce(nonce uint64) bool {
+	j.submittedNoncesLock.Lock()
+	defer j.submittedNoncesLock.Unlock()
+
+	if _, ok := j.submittedNonces[nonce]; ok {
+		return false
+	}
+	j.submittedNonces[nonce] = struct{}{}
+	return true
+}
+
+// blockWithNonce returns the block of this job with the given nonce
+func (j *job) blockWithNonce(nonce uint64) *externalapi.DomainBlock {
+	header := j.block.Header.ToMutable()
+	header.SetNonce(nonce)
+	return &externalapi.DomainBlock{
+		Header:       header.ToImmutable(),
+		Transactions: j.block.Transactions,
+	}
+}
@@ -0,0 +1,87 @@
+package stratum
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The Stratum methods that kaspad serves and sends
+const (
+	methodSubscribe           = "mining.subscribe"
+	methodExtranonceSubscribe = "mining.extranonce.subscribe"
+	methodAuthorize           = "mining.authorize"
+	methodSubmit              = "mining.submit"
+	methodSetExtranonce       = "mining.set_extranonce"
+	methodSetDifficulty       = "mining.set_difficulty"
+	methodNotify              = "mining.notify"
+)
+
+// The error codes of Stratum, as used by most pools and mining software
+const (
+	errorCodeOther          = 20
+	errorCodeJobNotFound    = 21
+	errorCodeDuplicateShare = 22
+	errorCodeLowDifficulty  = 23
+	errorCodeUnauthorized   = 24
+	errorCodeNotSubscribed  = 25
+)
+
+// protocolIdentifier is the Stratum flavor kaspad speaks, as returned to
+// mining.subscribe
+const protocolIdentifier = "EthereumStratum/1.0.0"
+
+// request is a request from a miner. Stratum is line delimited JSON-RPC 1.0,
+// so the ID may be of any type and is echoed back as is
+type request struct {
+	ID     interface{}       `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// response is the response to a request. Stratum always sends both result
+// and error, one of which is null
+type response struct {
+	ID     interface{}   `json:"id"`
+	Result interface{}   `json:"result"`
+	Error  *stratumError `json:"error"`
+}
+
+// notification is a message to a miner that it doesn't respond to
+type notification struct {
+	ID     interface{}   `json:"id"`
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+// stratumError is an error returned to a miner. It's encoded as
+// [code, message, null]
+type stratumError struct {
+	code    int
+	message string
+}
+
+func newStratumError(code int, format string, args ...interface{}) *stratumError {
+	return &stratumError{code: code, message: fmt.Sprintf(format, args...)}
+}
+
+func (e *stratumError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.message, e.code)
+}
+
+// MarshalJSON implements json.Marshaler
+func (e *stratumError) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{e.code, e.message, nil})
+}
+
+// stringParam returns the string parameter at the given index
+func (r *request) stringParam(index int) (string, *stratumError) {
+	if index >= len(r.Params) {
+		return "", newStratumError(errorCodeOther, "missing parameter #%d of %s", index+1, r.Method)
+	}
+	var param string
+	err := json.Unmarshal(r.Params[index], &param)
+	if err != nil {
+		return "", newStratumError(errorCodeOther, "parameter #%d of %s is not a string", index+1, r.Method)
+	}
+	return param, nil
+}
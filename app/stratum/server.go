@@ -0,0 +1,434 @@
+// Package stratum implements a Stratum server that lets pool mining software
+// and ASIC/GPU miners mine directly against kaspad.
+//
+// Miners subscribe, and then authorize as a worker. Unless kaspad is
+// configured with a pay address, the worker name must start with the address
+// the miner wants to be paid to, as in <address>.<worker name>. Every miner
+// then receives a unique extranonce, its share difficulty and a job for every
+// new block template. Shares that also meet the target of the block are
+// submitted to the node as blocks.
+package stratum
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/protocol"
+	"github.com/kaspanet/kaspad/app/protocol/protocolerrors"
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/util"
+	"github.com/kaspanet/kaspad/version"
+	"github.com/pkg/errors"
+)
+
+const (
+	// jobRefreshInterval is how often block templates are polled for
+	// new transactions and timestamps, in addition to whenever the node
+	// notifies about a new block template
+	jobRefreshInterval = time.Second
+
+	// maxJobsPerPayAddress is the number of recent jobs of every pay address
+	// that miners may still submit shares for
+	maxJobsPerPayAddress = 16
+)
+
+// node is the part of kaspad that the Stratum server mines against
+type node interface {
+	getBlockTemplate(coinbaseData *externalapi.DomainCoinbaseData) (block *externalapi.DomainBlock, isSynced bool, err error)
+	submitBlock(block *externalapi.DomainBlock) error
+}
+
+type kaspadNode struct {
+	domain          domain.Domain
+	protocolManager *protocol.Manager
+}
+
+func (n *kaspadNode) getBlockTemplate(coinbaseData *externalapi.DomainCoinbaseData) (*externalapi.DomainBlock, bool, error) {
+	block, isNearlySynced, err := n.domain.MiningManager().GetBlockTemplate(coinbaseData)
+	if err != nil {
+		return nil, false, err
+	}
+	// The node is considered synced if it has peers and consensus state is nearly synced
+	return block, n.protocolManager.Context().HasPeers() && isNearlySynced, nil
+}
+
+func (n *kaspadNode) submitBlock(block *externalapi.DomainBlock) error {
+	return n.protocolManager.AddBlock(block)
+}
+
+// Server is a Stratum server
+type Server struct {
+	cfg  *config.Config
+	node node
+
+	listeners []net.Listener
+
+	lock           sync.Mutex
+	clients        map[*client]struct{}
+	jobs           map[string]*job
+	recentJobs     map[string][]*job
+	nextJobID      uint64
+	nextExtranonce uint16
+
+	isSynced          bool
+	newBlockTemplates chan struct{}
+	quit              chan struct{}
+}
+
+// NewServer creates a new Stratum server that listens on the addresses in
+// cfg.StratumListeners, and mines against the given domain
+func NewServer(cfg *config.Config, domain domain.Domain, protocolManager *protocol.Manager) *Server {
+	return newServer(cfg, &kaspadNode{domain: domain, protocolManager: protocolManager})
+}
+
+func newServer(cfg *config.Config, node node) *Server {
+	return &Server{
+		cfg:               cfg,
+		node:              node,
+		clients:           make(map[*client]struct{}),
+		jobs:              make(map[string]*job),
+		recentJobs:        make(map[string][]*job),
+		isSynced:          true,
+		newBlockTemplates: make(chan struct{}, 1),
+		quit:              make(chan struct{}),
+	}
+}
+
+// Start starts listening for miners
+func (s *Server) Start() error {
+	for _, listenAddress := range s.cfg.StratumListeners {
+		listener, err := net.Listen("tcp", listenAddress)
+		if err != nil {
+			for _, listener := range s.listeners {
+				listener.Close()
+			}
+			return errors.WithStack(err)
+		}
+		s.listeners = append(s.listeners, listener)
+		log.Infof("Stratum server listening on %s", listener.Addr())
+	}
+
+	for _, listener := range s.listeners {
+		listener := listener
+		spawn("stratum.Server.acceptLoop", func() {
+			s.acceptLoop(listener)
+		})
+	}
+	spawn("stratum.Server.jobsLoop", s.jobsLoop)
+	return nil
+}
+
+// Stop stops listening and disconnects all miners
+func (s *Server) Stop() error {
+	close(s.quit)
+
+	var firstErr error
+	for _, listener := range s.listeners {
+		err := listener.Close()
+		if err != nil && firstErr == nil {
+			firstErr = errors.WithStack(err)
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for client := range s.clients {
+		client.connection.Close()
+	}
+	return firstErr
+}
+
+// NotifyNewBlockTemplate sends fresh jobs to all the miners. It's called
+// whenever the node has a new block template
+func (s *Server) NotifyNewBlockTemplate() {
+	select {
+	case s.newBlockTemplates <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Server) acceptLoop(listener net.Listener) {
+	for {
+		connection, err := listener.Accept()
+		if err != nil {
+			select {
+			case <-s.quit:
+			default:
+				log.Errorf("Stratum server stopped accepting miners on %s: %s", listener.Addr(), err)
+			}
+			return
+		}
+		s.addClient(connection)
+	}
+}
+
+func (s *Server) addClient(connection net.Conn) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	client := newClient(s, connection, s.nextExtranonce, s.cfg.StratumDifficulty)
+	s.nextExtranonce++
+	s.clients[client] = struct{}{}
+
+	log.Infof("Stratum miner connected from %s", connection.RemoteAddr())
+	spawn("stratum.client.readLoop", client.readLoop)
+}
+
+func (s *Server) removeClient(client *client) {
+	s.lock.Lock()
+	delete(s.clients, client)
+	s.lock.Unlock()
+
+	client.connection.Close()
+	log.Infof("Stratum miner %s disconnected", client)
+}
+
+func (s *Server) authorize(client *client, username string) (interface{}, *stratumError) {
+	payAddress := s.cfg.StratumPayAddr
+	if payAddress == nil {
+		addressString := username
+		if dotIndex := strings.IndexByte(username, '.'); dotIndex >= 0 {
+			addressString = username[:dotIndex]
+		}
+		var err error
+		payAddress, err = util.DecodeAddress(addressString, s.cfg.ActiveNetParams.Prefix)
+		if err != nil {
+			return nil, newStratumError(errorCodeUnauthorized,
+				"worker names must be in the form <address>.<worker name>: %s", err)
+		}
+	}
+
+	client.lock.Lock()
+	if !client.isSubscribed {
+		client.lock.Unlock()
+		return nil, newStratumError(errorCodeNotSubscribed, "%s must be called first", methodSubscribe)
+	}
+	client.workerName = username
+	client.payAddress = payAddress
+	client.windowStart = time.Now()
+	client.lock.Unlock()
+
+	log.Infof("Stratum worker %s authorized, paying to %s", client, payAddress)
+	return true, nil
+}
+
+// sendInitialWork sends a newly authorized miner its extranonce, and the
+// latest job of its pay address if there is one. Otherwise, a job is sent
+// once it's created
+func (s *Server) sendInitialWork(client *client) error {
+	err := client.notify(methodSetExtranonce, client.extranonceHex(), nonceSize-extranonceSize)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	recentJobs := s.recentJobs[client.authorizedPayAddress().String()]
+	s.lock.Unlock()
+	if len(recentJobs) == 0 {
+		s.NotifyNewBlockTemplate()
+		return nil
+	}
+	return client.sendJob(recentJobs[len(recentJobs)-1])
+}
+
+func (s *Server) submit(client *client, request *request) (interface{}, *stratumError) {
+	payAddress := client.authorizedPayAddress()
+	if payAddress == nil {
+		return nil, newStratumError(errorCodeUnauthorized, "unauthorized worker")
+	}
+	jobID, stratumErr := request.stringParam(1)
+	if stratumErr != nil {
+		return nil, stratumErr
+	}
+	nonceHex, stratumErr := request.stringParam(2)
+	if stratumErr != nil {
+		return nil, stratumErr
+	}
+
+	s.lock.Lock()
+	job, ok := s.jobs[jobID]
+	s.lock.Unlock()
+	if !ok || job.payAddress != payAddress.String() {
+		return nil, newStratumError(errorCodeJobNotFound, "job %s not found", jobID)
+	}
+
+	nonce, err := client.parseNonce(nonceHex)
+	if err != nil {
+		return nil, newStratumError(errorCodeOther, "%s", err)
+	}
+	if !job.addSubmittedNonce(nonce) {
+		return nil, newStratumError(errorCodeDuplicateShare, "duplicate share")
+	}
+
+	state := job.proofOfWorkState(nonce)
+	powValue := state.CalculateProofOfWorkValue()
+	shareTarget := difficultyToTarget(client.shareDifficulty())
+	if shareTarget.Cmp(&state.Target) < 0 {
+		shareTarget = &state.Target
+	}
+	if powValue.Cmp(shareTarget) > 0 {
+		return nil, newStratumError(errorCodeLowDifficulty, "low difficulty share")
+	}
+	client.countShare()
+
+	if powValue.Cmp(&state.Target) <= 0 {
+		s.submitBlock(client, job.blockWithNonce(nonce))
+	}
+	return true, nil
+}
+
+func (s *Server) submitBlock(client *client, block *externalapi.DomainBlock) {
+	blockHash := consensushashing.BlockHash(block)
+	err := s.node.submitBlock(block)
+	if err != nil {
+		if errors.As(err, &ruleerrors.RuleError{}) || errors.As(err, &protocolerrors.ProtocolError{}) {
+			log.Warnf("Block %s of Stratum worker %s was rejected: %s", blockHash, client, err)
+			return
+		}
+		log.Errorf("Could not submit block %s of Stratum worker %s: %+v", blockHash, client, err)
+		return
+	}
+	log.Infof("Accepted block %s via Stratum from worker %s", blockHash, client)
+}
+
+// jobsLoop sends new jobs to the miners whenever the block template changes,
+// and adjusts their share difficulties
+func (s *Server) jobsLoop() {
+	ticker := time.NewTicker(jobRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.quit:
+			return
+		case <-s.newBlockTemplates:
+		case <-ticker.C:
+			s.adjustDifficulties()
+		}
+
+		err := s.refreshJobs()
+		if err != nil {
+			log.Errorf("Could not create Stratum jobs: %+v", err)
+		}
+	}
+}
+
+func (s *Server) adjustDifficulties() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	for client := range s.clients {
+		if client.authorizedPayAddress() != nil {
+			client.adjustDifficulty(now)
+		}
+	}
+}
+
+// refreshJobs creates a job for every pay address of the authorized miners,
+// and sends it to them if its work changed
+func (s *Server) refreshJobs() error {
+	clientsByPayAddress := make(map[string][]*client)
+	payAddresses := make(map[string]util.Address)
+	s.lock.Lock()
+	for client := range s.clients {
+		payAddress := client.authorizedPayAddress()
+		if payAddress == nil {
+			continue
+		}
+		clientsByPayAddress[payAddress.String()] = append(clientsByPayAddress[payAddress.String()], client)
+		payAddresses[payAddress.String()] = payAddress
+	}
+	for payAddress := range s.recentJobs {
+		if _, ok := payAddresses[payAddress]; !ok {
+			s.removeJobsOf(payAddress)
+		}
+	}
+	s.lock.Unlock()
+
+	for payAddressString, payAddress := range payAddresses {
+		job, err := s.createJob(payAddress)
+		if err != nil {
+			return err
+		}
+		if job == nil {
+			continue
+		}
+		for _, client := range clientsByPayAddress[payAddressString] {
+			err := client.sendJob(job)
+			if err != nil {
+				log.Debugf("Could not send a job to Stratum miner %s: %s", client, err)
+			}
+		}
+	}
+	return nil
+}
+
+// createJob creates a job from the block template of the given pay address.
+// It returns nil if the node isn't synced, or if the work of the job is the
+// same as that of the latest job of the address
+func (s *Server) createJob(payAddress util.Address) (*job, error) {
+	scriptPublicKey, err := txscript.PayToAddrScript(payAddress)
+	if err != nil {
+		return nil, err
+	}
+	coinbaseData := &externalapi.DomainCoinbaseData{
+		ScriptPublicKey: scriptPublicKey,
+		ExtraData:       []byte(version.Version() + "/stratum"),
+	}
+	block, isSynced, err := s.node.getBlockTemplate(coinbaseData)
+	if err != nil {
+		return nil, err
+	}
+
+	isSynced = isSynced || s.cfg.AllowSubmitBlockWhenNotSynced
+	if isSynced != s.isSynced {
+		s.isSynced = isSynced
+		if isSynced {
+			log.Infof("The node is synced, resuming sending jobs to Stratum miners")
+		} else {
+			log.Warnf("The node is not synced, pausing sending jobs to Stratum miners")
+		}
+	}
+	if !isSynced {
+		return nil, nil
+	}
+
+	job := newJob(payAddress.String(), block)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	recentJobs := s.recentJobs[job.payAddress]
+	if len(recentJobs) > 0 && recentJobs[len(recentJobs)-1].hasSameWork(job) {
+		return nil, nil
+	}
+	job.id = strconv.FormatUint(s.nextJobID, 16)
+	s.nextJobID++
+
+	s.jobs[job.id] = job
+	recentJobs = append(recentJobs, job)
+	if len(recentJobs) > maxJobsPerPayAddress {
+		delete(s.jobs, recentJobs[0].id)
+		recentJobs = recentJobs[1:]
+	}
+	s.recentJobs[job.payAddress] = recentJobs
+	return job, nil
+}
+
+// removeJobsOf removes the jobs of a pay address that no miner mines to
+// anymore. It must be called with s.lock held
+func (s *Server) removeJobsOf(payAddress string) {
+	for _, job := range s.recentJobs[payAddress] {
+		delete(s.jobs, job.id)
+	}
+	delete(s.recentJobs, payAddress)
+}
@@ -0,0 +1,348 @@
+package stratum
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/pow"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/util"
+)
+
+type fakeNode struct {
+	lock            sync.Mutex
+	block           *externalapi.DomainBlock
+	submittedBlocks []*externalapi.DomainBlock
+}
+
+func newFakeNode() *fakeNode {
+	return &fakeNode{block: dagconfig.SimnetParams.GenesisBlock}
+}
+
+func (n *fakeNode) getBlockTemplate(*externalapi.DomainCoinbaseData) (*externalapi.DomainBlock, bool, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.block, true, nil
+}
+
+func (n *fakeNode) submitBlock(block *externalapi.DomainBlock) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.submittedBlocks = append(n.submittedBlocks, block)
+	return nil
+}
+
+func (n *fakeNode) setTimestamp(timestamp int64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	header := n.block.Header.ToMutable()
+	header.SetTimeInMilliseconds(timestamp)
+	n.block = &externalapi.DomainBlock{Header: header.ToImmutable(), Transactions: n.block.Transactions}
+}
+
+func (n *fakeNode) submittedBlockCount() int {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return len(n.submittedBlocks)
+}
+
+// testMiner speaks Stratum to the server under test
+type testMiner struct {
+	t             *testing.T
+	connection    net.Conn
+	reader        *bufio.Reader
+	nextID        int
+	notifications []*testMessage
+}
+
+type testMessage struct {
+	ID     *int              `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+	Result json.RawMessage   `json:"result"`
+	Error  []interface{}     `json:"error"`
+}
+
+func newTestMiner(t *testing.T, server *Server) *testMiner {
+	connection, err := net.Dial("tcp", server.listeners[0].Addr().String())
+	if err != nil {
+		t.Fatalf("Dial: %s", err)
+	}
+	t.Cleanup(func() { connection.Close() })
+	return &testMiner{t: t, connection: connection, reader: bufio.NewReader(connection)}
+}
+
+func (m *testMiner) read() *testMessage {
+	err := m.connection.SetReadDeadline(time.Now().Add(10 * time.Second))
+	if err != nil {
+		m.t.Fatalf("SetReadDeadline: %s", err)
+	}
+	line, err := m.reader.ReadBytes('\n')
+	if err != nil {
+		m.t.Fatalf("Error reading from the Stratum server: %s", err)
+	}
+	message := &testMessage{}
+	err = json.Unmarshal(line, message)
+	if err != nil {
+		m.t.Fatalf("Error decoding %s: %s", line, err)
+	}
+	return message
+}
+
+// call sends a request and returns its response, queueing any notifications
+// that arrive before it
+func (m *testMiner) call(method string, params ...interface{}) *testMessage {
+	m.nextID++
+	requestBytes, err := json.Marshal(map[string]interface{}{"id": m.nextID, "method": method, "params": params})
+	if err != nil {
+		m.t.Fatalf("Marshal: %s", err)
+	}
+	_, err = m.connection.Write(append(requestBytes, '\n'))
+	if err != nil {
+		m.t.Fatalf("Write: %s", err)
+	}
+
+	for {
+		message := m.read()
+		if message.ID == nil {
+			m.notifications = append(m.notifications, message)
+			continue
+		}
+		if *message.ID != m.nextID {
+			m.t.Fatalf("Got a response to request %d while waiting for %d", *message.ID, m.nextID)
+		}
+		return message
+	}
+}
+
+func (m *testMiner) expectResult(method string, params ...interface{}) {
+	response := m.call(method, params...)
+	if response.Error != nil {
+		m.t.Fatalf("%s failed: %v", method, response.Error)
+	}
+	var result bool
+	err := json.Unmarshal(response.Result, &result)
+	if err != nil || !result {
+		m.t.Fatalf("Unexpected result of %s: %s", method, response.Result)
+	}
+}
+
+func (m *testMiner) subscribe() {
+	response := m.call(methodSubscribe, "test-miner/1.0")
+	var result []interface{}
+	err := json.Unmarshal(response.Result, &result)
+	if err != nil || len(result) != 2 || result[1] != protocolIdentifier {
+		m.t.Fatalf("Unexpected result of %s: %s", methodSubscribe, response.Result)
+	}
+}
+
+func (m *testMiner) expectError(expectedCode int, method string, params ...interface{}) {
+	response := m.call(method, params...)
+	if len(response.Error) != 3 {
+		m.t.Fatalf("Expected %s to fail with code %d, but got %s", method, expectedCode, response.Result)
+	}
+	code, ok := response.Error[0].(float64)
+	if !ok || int(code) != expectedCode {
+		m.t.Fatalf("Expected %s to fail with code %d, but got %v", method, expectedCode, response.Error)
+	}
+}
+
+// nextNotification returns the next notification, and fails if it's not of the given method
+func (m *testMiner) nextNotification(method string) []json.RawMessage {
+	var message *testMessage
+	if len(m.notifications) > 0 {
+		message, m.notifications = m.notifications[0], m.notifications[1:]
+	} else {
+		message = m.read()
+	}
+	if message.Method != method {
+		m.t.Fatalf("Expected %s, but got %s", method, message.Method)
+	}
+	return message.Params
+}
+
+func (m *testMiner) nextJobID() string {
+	var jobID string
+	params := m.nextNotification(methodNotify)
+	err := json.Unmarshal(params[0], &jobID)
+	if err != nil {
+		m.t.Fatalf("Unmarshal: %s", err)
+	}
+	return jobID
+}
+
+func setupServer(t *testing.T, payAddress util.Address) (*Server, *fakeNode) {
+	cfg := config.DefaultConfig()
+	cfg.ActiveNetParams = &dagconfig.SimnetParams
+	cfg.StratumListeners = []string{"127.0.0.1:0"}
+	cfg.StratumPayAddr = payAddress
+
+	node := newFakeNode()
+	server := newServer(cfg, node)
+	err := server.Start()
+	if err != nil {
+		t.Fatalf("Start: %s", err)
+	}
+	t.Cleanup(func() { server.Stop() })
+	return server, node
+}
+
+func testAddress(t *testing.T) util.Address {
+	address, err := util.NewAddressPublicKey(make([]byte, 32), util.Bech32PrefixKaspaSim)
+	if err != nil {
+		t.Fatalf("NewAddressPublicKey: %s", err)
+	}
+	return address
+}
+
+// findNonce returns the lowest nonce after the given extranonce, whose proof
+// of work does or doesn't meet the target of the block of the given job
+func findNonce(server *Server, jobID string, extranonce uint16, shouldMeetTarget bool) uint64 {
+	server.lock.Lock()
+	job := server.jobs[jobID]
+	server.lock.Unlock()
+
+	for nonce := uint64(extranonce) << 48; ; nonce++ {
+		if job.proofOfWorkState(nonce).CheckProofOfWork() == shouldMeetTarget {
+			return nonce
+		}
+	}
+}
+
+func TestMining(t *testing.T) {
+	server, node := setupServer(t, nil)
+	miner := newTestMiner(t, server)
+	worker := testAddress(t).String() + ".rig1"
+
+	miner.subscribe()
+	miner.expectResult(methodAuthorize, worker, "x")
+
+	var extranonce string
+	var extranonce2Size int
+	params := miner.nextNotification(methodSetExtranonce)
+	_ = json.Unmarshal(params[0], &extranonce)
+	_ = json.Unmarshal(params[1], &extranonce2Size)
+	if extranonce != "0000" || extranonce2Size != nonceSize-extranonceSize {
+		t.Fatalf("Unexpected extranonce %s of size %d", extranonce, extranonce2Size)
+	}
+
+	// The simnet block target is far easier than difficulty 1, so the
+	// share difficulty is lowered to that of the block
+	var difficulty float64
+	params = miner.nextNotification(methodSetDifficulty)
+	_ = json.Unmarshal(params[0], &difficulty)
+	blockDifficulty := targetToDifficulty(&pow.NewState(node.block.Header.ToMutable()).Target)
+	if difficulty != blockDifficulty {
+		t.Fatalf("Expected the share difficulty to be lowered to the block difficulty %g, but got %g",
+			blockDifficulty, difficulty)
+	}
+
+	jobID := miner.nextJobID()
+	nonce := findNonce(server, jobID, 0, true)
+	miner.expectResult(methodSubmit, worker, jobID, fmt.Sprintf("%012x", nonce))
+	if node.submittedBlockCount() != 1 {
+		t.Fatalf("Expected a single submitted block, but got %d", node.submittedBlockCount())
+	}
+	submittedHeader := node.submittedBlocks[0].Header
+	if submittedHeader.Nonce() != nonce || !pow.CheckProofOfWorkByBits(submittedHeader.ToMutable()) {
+		t.Fatalf("The submitted block has nonce %d, expected a valid block with nonce %d", submittedHeader.Nonce(), nonce)
+	}
+
+	miner.expectError(errorCodeDuplicateShare, methodSubmit, worker, jobID, fmt.Sprintf("%016x", nonce))
+	lowNonce := findNonce(server, jobID, 0, false)
+	miner.expectError(errorCodeLowDifficulty, methodSubmit, worker, jobID, fmt.Sprintf("%016x", lowNonce))
+	miner.expectError(errorCodeJobNotFound, methodSubmit, worker, "nonexistent", fmt.Sprintf("%016x", nonce))
+	if node.submittedBlockCount() != 1 {
+		t.Fatalf("Expected a single submitted block, but got %d", node.submittedBlockCount())
+	}
+
+	// A new block template is sent as a new job
+	node.setTimestamp(node.block.Header.TimeInMilliseconds() + 1000)
+	server.NotifyNewBlockTemplate()
+	newJobID := miner.nextJobID()
+	if newJobID == jobID {
+		t.Fatalf("The new template was sent with the ID %s of the previous job", jobID)
+	}
+	newNonce := findNonce(server, newJobID, 0, true)
+	miner.expectResult(methodSubmit, worker, newJobID, fmt.Sprintf("%016x", newNonce))
+	if node.submittedBlockCount() != 2 {
+		t.Fatalf("Expected 2 submitted blocks, but got %d", node.submittedBlockCount())
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	server, _ := setupServer(t, nil)
+
+	miner := newTestMiner(t, server)
+	worker := testAddress(t).String() + ".rig1"
+	miner.expectError(errorCodeNotSubscribed, methodAuthorize, worker)
+	miner.subscribe()
+	miner.expectError(errorCodeUnauthorized, methodSubmit, worker, "0", "00")
+	miner.expectError(errorCodeUnauthorized, methodAuthorize, "rig1")
+	miner.expectError(errorCodeUnauthorized, methodAuthorize, "kaspa:qqkqkzjvr7zwxxmjxjkmxxdwju9kjs6e9u82uh59z07vgaks6gg62v8707g73.rig1")
+	miner.expectResult(methodAuthorize, worker)
+
+	// Every miner is assigned its own extranonce
+	secondMiner := newTestMiner(t, server)
+	secondMiner.subscribe()
+	secondMiner.expectResult(methodAuthorize, worker)
+	var extranonce string
+	_ = json.Unmarshal(secondMiner.nextNotification(methodSetExtranonce)[0], &extranonce)
+	if extranonce != "0001" {
+		t.Fatalf("Expected the second miner to get extranonce 0001, but got %s", extranonce)
+	}
+}
+
+func TestAuthorizeWithPayAddress(t *testing.T) {
+	server, node := setupServer(t, testAddress(t))
+	miner := newTestMiner(t, server)
+
+	miner.subscribe()
+	miner.expectResult(methodAuthorize, "rig1")
+	miner.nextNotification(methodSetExtranonce)
+	miner.nextNotification(methodSetDifficulty)
+	jobID := miner.nextJobID()
+	nonce := findNonce(server, jobID, 0, true)
+	miner.expectResult(methodSubmit, "rig1", jobID, fmt.Sprintf("%016x", nonce))
+	if node.submittedBlockCount() != 1 {
+		t.Fatalf("Expected a single submitted block, but got %d", node.submittedBlockCount())
+	}
+}
+
+func TestParseNonce(t *testing.T) {
+	client := &client{extranonce: 0xabcd}
+	tests := []struct {
+		nonceHex      string
+		expectedNonce uint64
+		expectedError bool
+	}{
+		{"0x0123456789abcdef", 0x0123456789abcdef, false},
+		{"0123456789abcdef", 0x0123456789abcdef, false},
+		{"456789abcdef", 0xabcd456789abcdef, false},
+		{"1", 0xabcd000000000001, false},
+		{"", 0, true},
+		{"0123456789abcdef0", 0, true},
+		{"xyz", 0, true},
+	}
+	for _, test := range tests {
+		nonce, err := client.parseNonce(test.nonceHex)
+		if (err != nil) != test.expectedError {
+			t.Errorf("parseNonce(%s): unexpected error %v", test.nonceHex, err)
+			continue
+		}
+		if nonce != test.expectedNonce {
+			t.Errorf("parseNonce(%s) returned %x, expected %x", test.nonceHex, nonce, test.expectedNonce)
+		}
+	}
+}
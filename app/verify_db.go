@@ -0,0 +1,125 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/prefixmanager"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// verifyDBReport is the machine-readable report written by the verify-db command
+type verifyDBReport struct {
+	IsSound                   bool             `json:"isSound"`
+	VerifiedBlockCount        uint64           `json:"verifiedBlockCount"`
+	UnverifiableGHOSTDAGCount uint64           `json:"unverifiableGhostdagCount"`
+	UnrepairedIssueCount      int              `json:"unrepairedIssueCount"`
+	Issues                    []*verifyDBIssue `json:"issues"`
+}
+
+type verifyDBIssue struct {
+	Check       string `json:"check"`
+	BlockHash   string `json:"blockHash,omitempty"`
+	Description string `json:"description"`
+	IsRepaired  bool   `json:"isRepaired"`
+}
+
+// runVerifyDBCommand verifies the consensus database of the active prefix and
+// writes a report, instead of running the node. An error is returned if any of
+// the issues that were found weren't repaired.
+func runVerifyDBCommand(cfg *config.Config, db database.Database) error {
+	consensusInstance, err := newVerifyDBConsensus(cfg, db)
+	if err != nil {
+		log.Errorf("Unable to open the consensus database: %+v", err)
+		return err
+	}
+
+	log.Infof("Verifying the consensus database")
+	report, err := consensusInstance.VerifyDatabase(cfg.VerifyDBRepair)
+	if err != nil {
+		log.Errorf("Database verification failed: %+v", err)
+		return err
+	}
+
+	jsonReport := &verifyDBReport{
+		IsSound:                   report.UnrepairedIssueCount() == 0,
+		VerifiedBlockCount:        report.VerifiedBlockCount,
+		UnverifiableGHOSTDAGCount: report.UnverifiableGHOSTDAGCount,
+		UnrepairedIssueCount:      report.UnrepairedIssueCount(),
+		Issues:                    make([]*verifyDBIssue, len(report.Issues)),
+	}
+	for i, issue := range report.Issues {
+		jsonReport.Issues[i] = &verifyDBIssue{
+			Check:       string(issue.Check),
+			Description: issue.Description,
+			IsRepaired:  issue.IsRepaired,
+		}
+		if issue.BlockHash != nil {
+			jsonReport.Issues[i].BlockHash = issue.BlockHash.String()
+		}
+	}
+
+	err = writeVerifyDBReport(cfg.VerifyDBReport, jsonReport)
+	if err != nil {
+		log.Errorf("Unable to write the database verification report: %+v", err)
+		return err
+	}
+
+	if !jsonReport.IsSound {
+		if cfg.VerifyDBRepair {
+			log.Errorf("verify-db-repair only repairs GHOSTDAG data, so the remaining issues " +
+				"can only be fixed by resyncing the node (e.g. by running it with --reset-db)")
+		}
+		err := errors.Errorf("the consensus database has %d unrepaired issues", jsonReport.UnrepairedIssueCount)
+		log.Error(err)
+		return err
+	}
+
+	log.Infof("Database verification completed successfully: verified %d blocks and found %d issues",
+		report.VerifiedBlockCount, len(report.Issues))
+	return nil
+}
+
+// newVerifyDBConsensus opens the consensus of the active prefix as is. Unlike the
+// consensus that is created for running the node, it doesn't initialize, recover
+// or clean up any of the data in the database before it's verified.
+func newVerifyDBConsensus(cfg *config.Config, db database.Database) (externalapi.Consensus, error) {
+	activePrefix, exists, err := prefixmanager.ActivePrefix(db)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.Errorf("the database has no active consensus to verify")
+	}
+
+	consensusConfig := newConsensusConfig(cfg)
+	consensusConfig.SkipInit = true
+	consensusInstance, shouldMigrate, err := consensus.NewFactory().NewConsensus(consensusConfig, db, activePrefix, nil)
+	if err != nil {
+		return nil, err
+	}
+	if shouldMigrate {
+		return nil, errors.Errorf("the database has to be migrated by running the node before it can be verified")
+	}
+	return consensusInstance, nil
+}
+
+// writeVerifyDBReport writes the report into the given file, or into the
+// standard output if no file is given
+func writeVerifyDBReport(reportFile string, report *verifyDBReport) error {
+	serializedReport, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	serializedReport = append(serializedReport, '\n')
+
+	if reportFile == "" {
+		_, err = os.Stdout.Write(serializedReport)
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(reportFile, serializedReport, 0600))
+}
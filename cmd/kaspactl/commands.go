@@ -34,10 +34,17 @@ var commandTypes = []reflect.Type{
 	reflect.TypeOf(protowire.KaspadMessage_GetMempoolEntriesByAddressesRequest{}),
 
 	reflect.TypeOf(protowire.KaspadMessage_SubmitTransactionRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_SubmitTransactionReplacementRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_GetFeeEstimateRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_SaveMempoolRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_LoadMempoolRequest{}),
 
 	reflect.TypeOf(protowire.KaspadMessage_GetUtxosByAddressesRequest{}),
 	reflect.TypeOf(protowire.KaspadMessage_GetBalanceByAddressRequest{}),
 	reflect.TypeOf(protowire.KaspadMessage_GetCoinSupplyRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_GetTransactionRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_GetTransactionAcceptanceInfoRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_GetTransactionsByAddressRequest{}),
 
 	reflect.TypeOf(protowire.KaspadMessage_BanRequest{}),
 	reflect.TypeOf(protowire.KaspadMessage_UnbanRequest{}),
@@ -13,9 +13,17 @@ var (
 
 type configFlags struct {
 	RPCServer                          string `short:"s" long:"rpcserver" description:"RPC server to connect to"`
+	RPCUser                            string `long:"rpcuser" description:"RPC username, if the RPC server requires authentication"`
+	RPCPass                            string `long:"rpcpass" description:"RPC password, if the RPC server requires authentication"`
+	RPCToken                           string `long:"rpctoken" description:"RPC bearer token, if the RPC server requires authentication"`
+	RPCTLS                             bool   `long:"rpctls" description:"Connect to the RPC server over TLS"`
+	RPCCert                            string `long:"rpccert" description:"File containing the certificate of the RPC server, or of the authority that signed it. The system's authorities are used if omitted (requires --rpctls)"`
+	RPCClientCert                      string `long:"rpcclientcert" description:"File containing the client certificate to present to RPC servers that require one (requires --rpctls)"`
+	RPCClientKey                       string `long:"rpcclientkey" description:"File containing the key of the client certificate"`
 	Timeout                            uint64 `short:"t" long:"timeout" description:"Timeout for the request (in seconds)"`
 	RequestJSON                        string `short:"j" long:"json" description:"The request in JSON format"`
 	ListCommands                       bool   `short:"l" long:"list-commands" description:"List all commands and exit"`
+	WatchFinalityConflicts             bool   `long:"watch-finality-conflicts" description:"Print the finality conflicts of the RPC server as they happen, until interrupted"`
 	AllowConnectionToDifferentVersions bool   `short:"a" long:"allow-connection-to-different-versions" description:"Allow connections to versions different than kaspactl's version'"`
 	CommandAndParameters               []string
 	config.NetworkFlags
@@ -45,6 +53,12 @@ func parseConfig() (*configFlags, error) {
 	}
 
 	cfg.CommandAndParameters = remainingArgs
+	if cfg.WatchFinalityConflicts {
+		if len(cfg.CommandAndParameters) > 0 || cfg.RequestJSON != "" {
+			return nil, errors.New("Neither --json nor a command may be specified with --watch-finality-conflicts")
+		}
+		return cfg, nil
+	}
 	if len(cfg.CommandAndParameters) == 0 && cfg.RequestJSON == "" ||
 		len(cfg.CommandAndParameters) > 0 && cfg.RequestJSON != "" {
 
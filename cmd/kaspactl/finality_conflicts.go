@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient/grpcclient"
+)
+
+// watchFinalityConflicts prints the finality conflicts of the RPC server until interrupted,
+// alongside the ResolveFinalityConflict command that switches the node to the conflicting side
+func watchFinalityConflicts(rpcAddress string, connectOptions *grpcclient.ConnectOptions) {
+	client, err := rpcclient.NewRPCClientWithOptions(rpcAddress, connectOptions)
+	if err != nil {
+		printErrorAndExit(fmt.Sprintf("error connecting to the RPC server: %s", err))
+	}
+	defer client.Close()
+
+	err = client.RegisterForFinalityConflictsNotifications(
+		func(notification *appmessage.FinalityConflictNotificationMessage) {
+			selectedTipHash := "unknown"
+			getSelectedTipHashResponse, err := client.GetSelectedTipHash()
+			if err == nil {
+				selectedTipHash = getSelectedTipHashResponse.SelectedTipHash
+			}
+			fmt.Printf("Finality conflict: block %s violates finality. The selected tip is %s\n",
+				notification.ViolatingBlockHash, selectedTipHash)
+			fmt.Printf("To switch to the side of %s run: kaspactl ResolveFinalityConflict %s\n",
+				notification.ViolatingBlockHash, notification.FinalityBlockHash)
+		},
+		func(notification *appmessage.FinalityConflictResolvedNotificationMessage) {
+			fmt.Printf("Finality conflict resolved in favor of finality block %s\n", notification.FinalityBlockHash)
+		})
+	if err != nil {
+		printErrorAndExit(fmt.Sprintf("error registering for finality conflicts: %s", err))
+	}
+
+	fmt.Println("Watching for finality conflicts. Press Ctrl+C to stop")
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	<-interrupt
+}
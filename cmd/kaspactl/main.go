@@ -11,7 +11,9 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
 	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient/grpcclient"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpctls"
 )
 
 func main() {
@@ -28,7 +30,19 @@ func main() {
 	if err != nil {
 		printErrorAndExit(fmt.Sprintf("error parsing RPC server address: %s", err))
 	}
-	client, err := grpcclient.Connect(rpcAddress)
+	rpcAuthorization, err := rpcauth.ClientAuthorization(cfg.RPCUser, cfg.RPCPass, cfg.RPCToken)
+	if err != nil {
+		printErrorAndExit(fmt.Sprintf("error parsing RPC credentials: %s", err))
+	}
+	rpcTLSConfig, err := rpctls.ClientConfig(cfg.RPCTLS, cfg.RPCCert, cfg.RPCClientCert, cfg.RPCClientKey)
+	if err != nil {
+		printErrorAndExit(fmt.Sprintf("error loading RPC TLS configuration: %s", err))
+	}
+	connectOptions := &grpcclient.ConnectOptions{
+		Authorization: rpcAuthorization,
+		TLSConfig:     rpcTLSConfig,
+	}
+	client, err := grpcclient.ConnectWithOptions(rpcAddress, connectOptions)
 	if err != nil {
 		printErrorAndExit(fmt.Sprintf("error connecting to the RPC server: %s", err))
 	}
@@ -48,6 +62,11 @@ func main() {
 		}
 	}
 
+	if cfg.WatchFinalityConflicts {
+		watchFinalityConflicts(rpcAddress, connectOptions)
+		return
+	}
+
 	responseChan := make(chan string)
 
 	if cfg.RequestJSON != "" {
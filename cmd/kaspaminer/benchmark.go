@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/kaspanet/kaspad/domain/consensus/utils/pow"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+)
+
+// benchmark measures the hash rate of the miner without a node, by hashing
+// the genesis header of the network over and over again with every thread.
+// It keeps hashing until the process is interrupted.
+func benchmark(params *dagconfig.Params, threads int) {
+	rand.Seed(time.Now().UnixNano()) // Seed the global concurrent-safe random source.
+
+	log.Infof("Benchmarking %d threads", threads)
+	genesisState := pow.NewState(params.GenesisBlock.Header.ToMutable())
+	hashCounters := make([]hashCounter, threads)
+	for i, nonceRange := range splitNonceSpace(threads) {
+		i, nonceRange := i, nonceRange
+		spawn(fmt.Sprintf("benchmark-thread-%d", i), func() {
+			state := *genesisState
+			nonce := nonceRange.randomNonce()
+			for {
+				nonce = nonceRange.next(nonce)
+				state.Nonce = nonce
+				// Whether the nonce is valid doesn't matter, only
+				// the time it takes to check it does
+				state.CheckProofOfWork()
+				hashCounters[i].increment()
+			}
+		})
+	}
+
+	logHashRate(hashCounters)
+}
@@ -3,7 +3,10 @@ package main
 import (
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
 	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient/grpcclient"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpctls"
 	"github.com/pkg/errors"
 	"time"
 )
@@ -22,7 +25,18 @@ func (mc *minerClient) connect() error {
 	if err != nil {
 		return err
 	}
-	rpcClient, err := rpcclient.NewRPCClient(rpcAddress)
+	rpcAuthorization, err := rpcauth.ClientAuthorization(mc.cfg.RPCUser, mc.cfg.RPCPass, mc.cfg.RPCToken)
+	if err != nil {
+		return err
+	}
+	rpcTLSConfig, err := rpctls.ClientConfig(mc.cfg.RPCTLS, mc.cfg.RPCCert, mc.cfg.RPCClientCert, mc.cfg.RPCClientKey)
+	if err != nil {
+		return err
+	}
+	rpcClient, err := rpcclient.NewRPCClientWithOptions(rpcAddress, &grpcclient.ConnectOptions{
+		Authorization: rpcAuthorization,
+		TLSConfig:     rpcTLSConfig,
+	})
 	if err != nil {
 		return err
 	}
@@ -20,6 +20,7 @@ const (
 	defaultLogFilename          = "kaspaminer.log"
 	defaultErrLogFilename       = "kaspaminer_err.log"
 	defaultTargetBlockRateRatio = 2.0
+	defaultThreads              = 1
 )
 
 var (
@@ -33,17 +34,27 @@ var (
 type configFlags struct {
 	ShowVersion           bool     `short:"V" long:"version" description:"Display version information and exit"`
 	RPCServer             string   `short:"s" long:"rpcserver" description:"RPC server to connect to"`
+	RPCUser               string   `long:"rpcuser" description:"RPC username, if the RPC server requires authentication"`
+	RPCPass               string   `long:"rpcpass" description:"RPC password, if the RPC server requires authentication"`
+	RPCToken              string   `long:"rpctoken" description:"RPC bearer token, if the RPC server requires authentication"`
+	RPCTLS                bool     `long:"rpctls" description:"Connect to the RPC server over TLS"`
+	RPCCert               string   `long:"rpccert" description:"File containing the certificate of the RPC server, or of the authority that signed it. The system's authorities are used if omitted (requires --rpctls)"`
+	RPCClientCert         string   `long:"rpcclientcert" description:"File containing the client certificate to present to RPC servers that require one (requires --rpctls)"`
+	RPCClientKey          string   `long:"rpcclientkey" description:"File containing the key of the client certificate"`
 	MiningAddr            string   `long:"miningaddr" description:"Address to mine to"`
 	NumberOfBlocks        uint64   `short:"n" long:"numblocks" description:"Number of blocks to mine. If omitted, will mine until the process is interrupted."`
 	MineWhenNotSynced     bool     `long:"mine-when-not-synced" description:"Mine even if the node is not synced with the rest of the network."`
 	Profile               string   `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
 	TargetBlocksPerSecond *float64 `long:"target-blocks-per-second" description:"Sets a maximum block rate. 0 means no limit (The default one is 2 * target network block rate)"`
+	Threads               int      `short:"t" long:"threads" description:"Number of mining threads, each searching a disjoint part of the nonce space"`
+	Benchmark             bool     `long:"benchmark" description:"Measure the hash rate without connecting to a node, by hashing a fixed block header until interrupted"`
 	config.NetworkFlags
 }
 
 func parseConfig() (*configFlags, error) {
 	cfg := &configFlags{
 		RPCServer: defaultRPCServer,
+		Threads:   defaultThreads,
 	}
 	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
 	_, err := parser.Parse()
@@ -77,7 +88,11 @@ func parseConfig() (*configFlags, error) {
 		}
 	}
 
-	if cfg.MiningAddr == "" {
+	if cfg.Threads < 1 {
+		return nil, errors.New("--threads must be at least 1")
+	}
+
+	if cfg.MiningAddr == "" && !cfg.Benchmark {
 		return nil, errors.New("--miningaddr is required")
 	}
 
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const logHashRateInterval = 10 * time.Second
+
+// hashCounter counts the hashes tried by a single mining thread. It's
+// padded to the size of a cache line so that the threads don't slow each
+// other down by writing into the same cache line
+type hashCounter struct {
+	hashes uint64
+	_      [56]byte
+}
+
+func (hc *hashCounter) increment() {
+	atomic.AddUint64(&hc.hashes, 1)
+}
+
+// sample returns the amount of hashes tried since the previous sample
+func (hc *hashCounter) sample() uint64 {
+	return atomic.SwapUint64(&hc.hashes, 0)
+}
+
+func logHashRate(hashCounters []hashCounter) {
+	spawn("logHashRate", func() {
+		lastCheck := time.Now()
+		for range time.Tick(logHashRateInterval) {
+			currentTime := time.Now()
+			elapsedSeconds := currentTime.Sub(lastCheck).Seconds()
+			lastCheck = currentTime
+
+			totalHashRate := 0.0
+			threadHashRates := make([]string, len(hashCounters))
+			for i := range hashCounters {
+				kiloHashesTried := float64(hashCounters[i].sample()) / 1000.0
+				hashRate := kiloHashesTried / elapsedSeconds
+				totalHashRate += hashRate
+				threadHashRates[i] = fmt.Sprintf("%.2f", hashRate)
+			}
+			log.Infof("Current hash rate is %.2f Khash/s", totalHashRate)
+			if len(hashCounters) > 1 {
+				log.Infof("Current hash rate per thread (Khash/s): %s", strings.Join(threadHashRates, ", "))
+			}
+		}
+	})
+}
+
+// nonceRange is the part of the nonce space searched by a single mining
+// thread. The ranges of the threads are disjoint, so no two threads ever
+// try the same nonce on the same template
+type nonceRange struct {
+	start uint64
+	size  uint64
+}
+
+// splitNonceSpace splits the nonce space into a nonce range per thread
+func splitNonceSpace(threads int) []nonceRange {
+	rangeSize := math.MaxUint64 / uint64(threads)
+	nonceRanges := make([]nonceRange, threads)
+	for i := range nonceRanges {
+		nonceRanges[i] = nonceRange{start: uint64(i) * rangeSize, size: rangeSize}
+	}
+	// The last range also covers the remainder of the division
+	lastRange := &nonceRanges[threads-1]
+	lastRange.size = math.MaxUint64 - lastRange.start
+	return nonceRanges
+}
+
+// randomNonce returns a random nonce within the range, to start searching from
+func (nr nonceRange) randomNonce() uint64 {
+	return nr.start + rand.Uint64()%nr.size // Use the global concurrent-safe random source.
+}
+
+// next returns the nonce following the given one, wrapping
+// around to the start of the range once its end is reached
+func (nr nonceRange) next(nonce uint64) uint64 {
+	nonce++
+	if nonce-nr.start >= nr.size {
+		return nr.start
+	}
+	return nonce
+}
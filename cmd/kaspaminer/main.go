@@ -35,6 +35,12 @@ func main() {
 		profiling.Start(cfg.Profile, log)
 	}
 
+	if cfg.Benchmark {
+		benchmark(cfg.NetParams(), cfg.Threads)
+		<-interrupt
+		return
+	}
+
 	client, err := newMinerClient(cfg)
 	if err != nil {
 		panic(errors.Wrap(err, "error connecting to the RPC server"))
@@ -48,7 +54,8 @@ func main() {
 
 	doneChan := make(chan struct{})
 	spawn("mineLoop", func() {
-		err = mineLoop(client, cfg.NumberOfBlocks, *cfg.TargetBlocksPerSecond, cfg.MineWhenNotSynced, miningAddr)
+		err = mineLoop(client, cfg.NumberOfBlocks, *cfg.TargetBlocksPerSecond, cfg.MineWhenNotSynced, miningAddr,
+			cfg.Threads)
 		if err != nil {
 			panic(errors.Wrap(err, "error in mine loop"))
 		}
@@ -2,8 +2,10 @@ package main
 
 import (
 	nativeerrors "errors"
+	"fmt"
 	"github.com/kaspanet/kaspad/version"
 	"math/rand"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -17,14 +19,12 @@ import (
 	"github.com/pkg/errors"
 )
 
-var hashesTried uint64
-
-const logHashRateInterval = 10 * time.Second
-
 func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond float64, mineWhenNotSynced bool,
-	miningAddr util.Address) error {
+	miningAddr util.Address, threads int) error {
 	rand.Seed(time.Now().UnixNano()) // Seed the global concurrent-safe random source.
 
+	hashCounters := make([]hashCounter, threads)
+
 	errChan := make(chan error)
 	doneChan := make(chan struct{})
 
@@ -57,7 +57,7 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 		}
 		windowStart := time.Now()
 		for blockIndex := 1; ; blockIndex++ {
-			foundBlockChan <- mineNextBlock(mineWhenNotSynced)
+			foundBlockChan <- mineNextBlock(mineWhenNotSynced, hashCounters)
 			if hasBlockRateTarget {
 				<-blockTicker.C
 				if (blockIndex % windowSize) == 0 {
@@ -82,7 +82,7 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 		doneChan <- struct{}{}
 	})
 
-	logHashRate()
+	logHashRate(hashCounters)
 
 	select {
 	case err := <-errChan:
@@ -92,22 +92,6 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 	}
 }
 
-func logHashRate() {
-	spawn("logHashRate", func() {
-		lastCheck := time.Now()
-		for range time.Tick(logHashRateInterval) {
-			currentHashesTried := atomic.LoadUint64(&hashesTried)
-			currentTime := time.Now()
-			kiloHashesTried := float64(currentHashesTried) / 1000.0
-			hashRate := kiloHashesTried / currentTime.Sub(lastCheck).Seconds()
-			log.Infof("Current hash rate is %.2f Khash/s", hashRate)
-			lastCheck = currentTime
-			// subtract from hashesTried the hashes we already sampled
-			atomic.AddUint64(&hashesTried, -currentHashesTried)
-		}
-	})
-}
-
 func handleFoundBlock(client *minerClient, block *externalapi.DomainBlock) error {
 	blockHash := consensushashing.BlockHash(block)
 	log.Infof("Submitting block %s to %s", blockHash, client.Address())
@@ -134,56 +118,100 @@ func handleFoundBlock(client *minerClient, block *externalapi.DomainBlock) error
 	return nil
 }
 
-func mineNextBlock(mineWhenNotSynced bool) *externalapi.DomainBlock {
-	nonce := rand.Uint64() // Use the global concurrent-safe random source.
-	for {
-		nonce++
+// mineNextBlock mines a block on the most up to date block template, splitting
+// the nonce space between a mining thread per hash counter
+func mineNextBlock(mineWhenNotSynced bool, hashCounters []hashCounter) *externalapi.DomainBlock {
+	threads := len(hashCounters)
+	foundBlockChan := make(chan *externalapi.DomainBlock, threads)
+	isDone := uint32(0)
+	waitGroup := sync.WaitGroup{}
+	for i, nonceRange := range splitNonceSpace(threads) {
+		i, nonceRange := i, nonceRange
+		waitGroup.Add(1)
+		spawn(fmt.Sprintf("mineNextBlock-thread-%d", i), func() {
+			defer waitGroup.Done()
+			// Only the first thread logs while waiting for a template, since
+			// all the threads wait for the same one
+			shouldLogWaiting := i == 0
+			block := mineInNonceRange(mineWhenNotSynced, nonceRange, &hashCounters[i], shouldLogWaiting, &isDone)
+			if block != nil {
+				foundBlockChan <- block
+			}
+		})
+	}
+
+	block := <-foundBlockChan
+	atomic.StoreUint32(&isDone, 1)
+	waitGroup.Wait()
+	return block
+}
+
+func mineInNonceRange(mineWhenNotSynced bool, nonceRange nonceRange, hashCounter *hashCounter,
+	shouldLogWaiting bool, isDone *uint32) *externalapi.DomainBlock {
+
+	var template *templatemanager.Template
+	var state pow.State
+	nonce := nonceRange.randomNonce()
+	for atomic.LoadUint32(isDone) == 0 {
+		nonce = nonceRange.next(nonce)
 		// For each nonce we try to build a block from the most up to date
 		// block template.
-		// In the rare case where the nonce space is exhausted for a specific
+		// In the rare case where the nonce range is exhausted for a specific
 		// block, it'll keep looping the nonce until a new block template
 		// is discovered.
-		block, state := getBlockForMining(mineWhenNotSynced)
+		if templatemanager.Get() != template {
+			template = getBlockForMining(mineWhenNotSynced, shouldLogWaiting, isDone)
+			if template == nil {
+				return nil
+			}
+			state = *template.State
+		}
 		state.Nonce = nonce
-		atomic.AddUint64(&hashesTried, 1)
+		hashCounter.increment()
 		if state.CheckProofOfWork() {
+			// Shallow copy the block so that setting its header won't affect the template
+			block := *template.Block
 			mutHeader := block.Header.ToMutable()
 			mutHeader.SetNonce(nonce)
 			block.Header = mutHeader.ToImmutable()
-			log.Infof("Found block %s with parents %s", consensushashing.BlockHash(block), block.Header.DirectParents())
-			return block
+			log.Infof("Found block %s with parents %s", consensushashing.BlockHash(&block), block.Header.DirectParents())
+			return &block
 		}
 	}
+	return nil
 }
 
-func getBlockForMining(mineWhenNotSynced bool) (*externalapi.DomainBlock, *pow.State) {
+// getBlockForMining waits for a block template that can be mined on. It returns
+// nil if isDone is set while waiting.
+func getBlockForMining(mineWhenNotSynced bool, shouldLog bool, isDone *uint32) *templatemanager.Template {
 	tryCount := 0
 
 	const sleepTime = 500 * time.Millisecond
 	const sleepTimeWhenNotSynced = 5 * time.Second
 
-	for {
+	for atomic.LoadUint32(isDone) == 0 {
 		tryCount++
 
-		shouldLog := (tryCount-1)%10 == 0
-		template, state, isSynced := templatemanager.Get()
+		shouldLogTry := shouldLog && (tryCount-1)%10 == 0
+		template := templatemanager.Get()
 		if template == nil {
-			if shouldLog {
+			if shouldLogTry {
 				log.Info("Waiting for the initial template")
 			}
 			time.Sleep(sleepTime)
 			continue
 		}
-		if !isSynced && !mineWhenNotSynced {
-			if shouldLog {
+		if !template.IsSynced && !mineWhenNotSynced {
+			if shouldLogTry {
 				log.Warnf("Kaspad is not synced. Skipping current block template")
 			}
 			time.Sleep(sleepTimeWhenNotSynced)
 			continue
 		}
 
-		return template, state
+		return template
 	}
+	return nil
 }
 
 func templatesLoop(client *minerClient, miningAddr util.Address, errChan chan error) {
@@ -4,25 +4,25 @@ import (
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/pow"
-	"sync"
+	"sync/atomic"
 )
 
-var currentTemplate *externalapi.DomainBlock
-var currentState *pow.State
-var isSynced bool
-var lock = &sync.Mutex{}
+// Template is a block template to mine on. It's shared between all
+// the mining threads, so it must not be modified
+type Template struct {
+	Block    *externalapi.DomainBlock
+	State    *pow.State
+	IsSynced bool
+}
 
-// Get returns the template to work on
-func Get() (*externalapi.DomainBlock, *pow.State, bool) {
-	lock.Lock()
-	defer lock.Unlock()
-	// Shallow copy the block so when the user replaces the header it won't affect the template here.
-	if currentTemplate == nil {
-		return nil, nil, false
-	}
-	block := *currentTemplate
-	state := *currentState
-	return &block, &state, isSynced
+var currentTemplate atomic.Value
+
+// Get returns the template to work on, or nil if no template was set yet.
+// It doesn't take any locks, so it's cheap enough to call for every nonce.
+// A new template is set whenever the returned pointer changes.
+func Get() *Template {
+	template, _ := currentTemplate.Load().(*Template)
+	return template
 }
 
 // Set sets the current template to work on
@@ -31,10 +31,10 @@ func Set(template *appmessage.GetBlockTemplateResponseMessage) error {
 	if err != nil {
 		return err
 	}
-	lock.Lock()
-	defer lock.Unlock()
-	currentTemplate = block
-	currentState = pow.NewState(block.Header.ToMutable())
-	isSynced = template.IsSynced
+	currentTemplate.Store(&Template{
+		Block:    block,
+		State:    pow.NewState(block.Header.ToMutable()),
+		IsSynced: template.IsSynced,
+	})
 	return nil
 }
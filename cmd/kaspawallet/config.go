@@ -12,7 +12,9 @@ import (
 const (
 	createSubCmd                    = "create"
 	balanceSubCmd                   = "balance"
+	historySubCmd                   = "history"
 	sendSubCmd                      = "send"
+	bumpFeeSubCmd                   = "bump-fee"
 	sweepSubCmd                     = "sweep"
 	createUnsignedTransactionSubCmd = "create-unsigned-transaction"
 	signSubCmd                      = "sign"
@@ -22,11 +24,17 @@ const (
 	newAddressSubCmd                = "new-address"
 	dumpUnencryptedDataSubCmd       = "dump-unencrypted-data"
 	startDaemonSubCmd               = "start-daemon"
+	psktConvertSubCmd               = "pskt-convert"
+	psktCombineSubCmd               = "pskt-combine"
+	psktInspectSubCmd               = "pskt-inspect"
+	psktFinalizeSubCmd              = "pskt-finalize"
 )
 
 const (
 	defaultListen    = "localhost:8082"
 	defaultRPCServer = "localhost"
+
+	defaultHistoryLimit = 20
 )
 
 type configFlags struct {
@@ -51,6 +59,13 @@ type balanceConfig struct {
 	config.NetworkFlags
 }
 
+type historyConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Offset        uint32 `long:"offset" short:"o" description:"Number of most recent transactions to skip"`
+	Limit         uint32 `long:"limit" short:"l" description:"Maximum number of transactions to show, or 0 to show all of them (default: 20)"`
+	config.NetworkFlags
+}
+
 type sendConfig struct {
 	KeysFile                 string   `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kaspawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Kaspawallet\\key.json (Windows))"`
 	Password                 string   `long:"password" short:"p" description:"Wallet password"`
@@ -60,10 +75,20 @@ type sendConfig struct {
 	SendAmount               float64  `long:"send-amount" short:"v" description:"An amount to send in Kaspa (e.g. 1234.12345678)"`
 	IsSendAll                bool     `long:"send-all" description:"Send all the Kaspa in the wallet (mutually exclusive with --send-amount)"`
 	UseExistingChangeAddress bool     `long:"use-existing-change-address" short:"u" description:"Will use an existing change address (in case no change address was ever used, it will use a new one)"`
+	FeeRate                  string   `long:"fee-rate" description:"The fee rate to pay in sompi per gram of mass (e.g. 1.5), or one of priority, normal or low to pay the respective fee rate estimated by the node (default: a fixed fee for every input)"`
 	Verbose                  bool     `long:"show-serialized" short:"s" description:"Show a list of hex encoded sent transactions"`
 	config.NetworkFlags
 }
 
+type bumpFeeConfig struct {
+	KeysFile      string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kaspawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Kaspawallet\\key.json (Windows))"`
+	Password      string `long:"password" short:"p" description:"Wallet password"`
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	TransactionID string `long:"transaction-id" short:"t" description:"The ID of the mempool transaction to replace" required:"true"`
+	Verbose       bool   `long:"show-serialized" short:"s" description:"Show the hex encoded replacement transaction"`
+	config.NetworkFlags
+}
+
 type sweepConfig struct {
 	PrivateKey    string `long:"private-key" short:"k" description:"Private key in hex format"`
 	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
@@ -77,6 +102,7 @@ type createUnsignedTransactionConfig struct {
 	SendAmount               float64  `long:"send-amount" short:"v" description:"An amount to send in Kaspa (e.g. 1234.12345678)"`
 	IsSendAll                bool     `long:"send-all" description:"Send all the Kaspa in the wallet (mutually exclusive with --send-amount)"`
 	UseExistingChangeAddress bool     `long:"use-existing-change-address" short:"u" description:"Will use an existing change address (in case no change address was ever used, it will use a new one)"`
+	FeeRate                  string   `long:"fee-rate" description:"The fee rate to pay in sompi per gram of mass (e.g. 1.5), or one of priority, normal or low to pay the respective fee rate estimated by the node (default: a fixed fee for every input)"`
 	config.NetworkFlags
 }
 
@@ -113,12 +139,19 @@ type newAddressConfig struct {
 }
 
 type startDaemonConfig struct {
-	KeysFile  string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kaspawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Kaspawallet\\key.json (Windows))"`
-	Password  string `long:"password" short:"p" description:"Wallet password"`
-	RPCServer string `long:"rpcserver" short:"s" description:"RPC server to connect to"`
-	Listen    string `long:"listen" short:"l" description:"Address to listen on (default: 0.0.0.0:8082)"`
-	Timeout   uint32 `long:"wait-timeout" short:"w" description:"Waiting timeout for RPC calls, seconds (default: 30 s)"`
-	Profile   string `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	KeysFile      string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kaspawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Kaspawallet\\key.json (Windows))"`
+	Password      string `long:"password" short:"p" description:"Wallet password"`
+	RPCServer     string `long:"rpcserver" short:"s" description:"RPC server to connect to"`
+	RPCUser       string `long:"rpcuser" description:"RPC username, if the RPC server requires authentication"`
+	RPCPass       string `long:"rpcpass" description:"RPC password, if the RPC server requires authentication"`
+	RPCToken      string `long:"rpctoken" description:"RPC bearer token, if the RPC server requires authentication"`
+	RPCTLS        bool   `long:"rpctls" description:"Connect to the RPC server over TLS"`
+	RPCCert       string `long:"rpccert" description:"File containing the certificate of the RPC server, or of the authority that signed it. The system's authorities are used if omitted (requires --rpctls)"`
+	RPCClientCert string `long:"rpcclientcert" description:"File containing the client certificate to present to RPC servers that require one (requires --rpctls)"`
+	RPCClientKey  string `long:"rpcclientkey" description:"File containing the key of the client certificate"`
+	Listen        string `long:"listen" short:"l" description:"Address to listen on (default: 0.0.0.0:8082)"`
+	Timeout       uint32 `long:"wait-timeout" short:"w" description:"Waiting timeout for RPC calls, seconds (default: 30 s)"`
+	Profile       string `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
 	config.NetworkFlags
 }
 
@@ -129,6 +162,36 @@ type dumpUnencryptedDataConfig struct {
 	config.NetworkFlags
 }
 
+type psktConvertConfig struct {
+	Transaction     string `long:"transaction" short:"t" description:"The transaction to convert, either as a PSKT or in the kaspawallet format (encoded in hex)"`
+	TransactionFile string `long:"transaction-file" short:"F" description:"The file containing the transaction to convert"`
+	Format          string `long:"format" description:"The format to convert the transaction to {json, binary, wallet}" default:"json"`
+	ECDSA           bool   `long:"ecdsa" description:"The transaction is of an ECDSA wallet"`
+	config.NetworkFlags
+}
+
+type psktCombineConfig struct {
+	PSKTs     []string `long:"pskt" description:"A partially signed transaction to combine, either as a PSKT or in the kaspawallet format (encoded in hex). Use multiple times to combine several transactions"`
+	PSKTFiles []string `long:"pskt-file" description:"A file containing a partially signed transaction to combine. Use multiple times to combine several transactions"`
+	Format    string   `long:"format" description:"The PSKT encoding to output {json, binary}" default:"json"`
+	ECDSA     bool     `long:"ecdsa" description:"The transactions are of an ECDSA wallet"`
+	config.NetworkFlags
+}
+
+type psktInspectConfig struct {
+	PSKT     string `long:"pskt" description:"The partially signed transaction to inspect, either as a PSKT or in the kaspawallet format (encoded in hex)"`
+	PSKTFile string `long:"pskt-file" description:"The file containing the partially signed transaction to inspect"`
+	ECDSA    bool   `long:"ecdsa" description:"The transaction is of an ECDSA wallet"`
+	config.NetworkFlags
+}
+
+type psktFinalizeConfig struct {
+	PSKT     string `long:"pskt" description:"The fully signed PSKT to finalize"`
+	PSKTFile string `long:"pskt-file" description:"The file containing the fully signed PSKT to finalize"`
+	ECDSA    bool   `long:"ecdsa" description:"The transaction is of an ECDSA wallet"`
+	config.NetworkFlags
+}
+
 func parseCommandLine() (subCommand string, config interface{}) {
 	cfg := &configFlags{}
 	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
@@ -141,10 +204,20 @@ func parseCommandLine() (subCommand string, config interface{}) {
 	parser.AddCommand(balanceSubCmd, "Shows the balance of a public address",
 		"Shows the balance for a public address in Kaspa", balanceConf)
 
+	historyConf := &historyConfig{DaemonAddress: defaultListen, Limit: defaultHistoryLimit}
+	parser.AddCommand(historySubCmd, "Shows the transaction history of the wallet",
+		"Shows the transactions that spent from or paid to the wallet, starting from the most recent ones. "+
+			"Only spends of transactions that were broadcast by the wallet daemon are shown", historyConf)
+
 	sendConf := &sendConfig{DaemonAddress: defaultListen}
 	parser.AddCommand(sendSubCmd, "Sends a Kaspa transaction to a public address",
 		"Sends a Kaspa transaction to a public address", sendConf)
 
+	bumpFeeConf := &bumpFeeConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(bumpFeeSubCmd, "Replaces a pending transaction with one paying a higher fee",
+		"Replaces a transaction of this wallet that is still in the mempool with a transaction that spends the same "+
+			"inputs and pays the same recipients, but pays a higher fee", bumpFeeConf)
+
 	sweepConf := &sweepConfig{DaemonAddress: defaultListen}
 	parser.AddCommand(sweepSubCmd, "Sends all funds associated with the given schnorr private key to a new address of the current wallet",
 		"Sends all funds associated with the given schnorr private key to a newly created external (i.e. not a change) address of the "+
@@ -180,6 +253,23 @@ func parseCommandLine() (subCommand string, config interface{}) {
 		"Prints the unencrypted wallet data including its private keys. Anyone that sees it can access "+
 			"the funds. Use only on safe environment.", dumpUnencryptedDataConf)
 
+	psktConvertConf := &psktConvertConfig{}
+	parser.AddCommand(psktConvertSubCmd, "Convert a transaction between the PSKT and kaspawallet formats",
+		"Convert a partially signed transaction between the PSKT JSON and binary encodings and the kaspawallet format", psktConvertConf)
+
+	psktCombineConf := &psktCombineConfig{}
+	parser.AddCommand(psktCombineSubCmd, "Combine the signatures of several cosigners into a single PSKT",
+		"Combine several copies of the same partially signed transaction, each signed by a different subset "+
+			"of its cosigners, into a single PSKT", psktCombineConf)
+
+	psktInspectConf := &psktInspectConfig{}
+	parser.AddCommand(psktInspectSubCmd, "Print the contents of a PSKT",
+		"Print the contents of a PSKT, including which cosigners already signed each of its inputs", psktInspectConf)
+
+	psktFinalizeConf := &psktFinalizeConfig{}
+	parser.AddCommand(psktFinalizeSubCmd, "Finalize a fully signed PSKT",
+		"Verify that a PSKT is fully signed and print it in the kaspawallet format, ready to broadcast", psktFinalizeConf)
+
 	startDaemonConf := &startDaemonConfig{
 		RPCServer: defaultRPCServer,
 		Listen:    defaultListen,
@@ -212,6 +302,13 @@ func parseCommandLine() (subCommand string, config interface{}) {
 			printErrorAndExit(err)
 		}
 		config = balanceConf
+	case historySubCmd:
+		combineNetworkFlags(&historyConf.NetworkFlags, &cfg.NetworkFlags)
+		err := historyConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = historyConf
 	case sendSubCmd:
 		combineNetworkFlags(&sendConf.NetworkFlags, &cfg.NetworkFlags)
 		err := sendConf.ResolveNetwork(parser)
@@ -223,6 +320,13 @@ func parseCommandLine() (subCommand string, config interface{}) {
 			printErrorAndExit(err)
 		}
 		config = sendConf
+	case bumpFeeSubCmd:
+		combineNetworkFlags(&bumpFeeConf.NetworkFlags, &cfg.NetworkFlags)
+		err := bumpFeeConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = bumpFeeConf
 	case sweepSubCmd:
 		combineNetworkFlags(&sweepConf.NetworkFlags, &cfg.NetworkFlags)
 		err := sweepConf.ResolveNetwork(parser)
@@ -283,6 +387,34 @@ func parseCommandLine() (subCommand string, config interface{}) {
 			printErrorAndExit(err)
 		}
 		config = dumpUnencryptedDataConf
+	case psktConvertSubCmd:
+		combineNetworkFlags(&psktConvertConf.NetworkFlags, &cfg.NetworkFlags)
+		err := psktConvertConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = psktConvertConf
+	case psktCombineSubCmd:
+		combineNetworkFlags(&psktCombineConf.NetworkFlags, &cfg.NetworkFlags)
+		err := psktCombineConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = psktCombineConf
+	case psktInspectSubCmd:
+		combineNetworkFlags(&psktInspectConf.NetworkFlags, &cfg.NetworkFlags)
+		err := psktInspectConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = psktInspectConf
+	case psktFinalizeSubCmd:
+		combineNetworkFlags(&psktFinalizeConf.NetworkFlags, &cfg.NetworkFlags)
+		err := psktFinalizeConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = psktFinalizeConf
 	case startDaemonSubCmd:
 		combineNetworkFlags(&startDaemonConf.NetworkFlags, &cfg.NetworkFlags)
 		err := startDaemonConf.ResolveNetwork(parser)
@@ -20,6 +20,11 @@ func createUnsignedTransaction(conf *createUnsignedTransactionConfig) error {
 	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
 	defer cancel()
 
+	feeRate, feeRateBucket, err := parseFeeRate(conf.FeeRate)
+	if err != nil {
+		return err
+	}
+
 	sendAmountSompi := uint64(conf.SendAmount * constants.SompiPerKaspa)
 	response, err := daemonClient.CreateUnsignedTransactions(ctx, &pb.CreateUnsignedTransactionsRequest{
 		From:                     conf.FromAddresses,
@@ -27,6 +32,8 @@ func createUnsignedTransaction(conf *createUnsignedTransactionConfig) error {
 		Amount:                   sendAmountSompi,
 		IsSendAll:                conf.IsSendAll,
 		UseExistingChangeAddress: conf.UseExistingChangeAddress,
+		FeeRate:                  feeRate,
+		FeeRateBucket:            feeRateBucket,
 	})
 	if err != nil {
 		return err
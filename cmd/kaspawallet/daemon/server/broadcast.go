@@ -16,7 +16,7 @@ func (s *server) Broadcast(_ context.Context, request *pb.BroadcastRequest) (*pb
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	txIDs, err := s.broadcast(request.Transactions, request.IsDomain)
+	txIDs, err := s.broadcast(request.Transactions, request.IsDomain, request.IsReplacement)
 	if err != nil {
 		return nil, err
 	}
@@ -24,7 +24,7 @@ func (s *server) Broadcast(_ context.Context, request *pb.BroadcastRequest) (*pb
 	return &pb.BroadcastResponse{TxIDs: txIDs}, nil
 }
 
-func (s *server) broadcast(transactions [][]byte, isDomain bool) ([]string, error) {
+func (s *server) broadcast(transactions [][]byte, isDomain bool, isReplacement bool) ([]string, error) {
 
 	txIDs := make([]string, len(transactions))
 	var tx *externalapi.DomainTransaction
@@ -44,7 +44,16 @@ func (s *server) broadcast(transactions [][]byte, isDomain bool) ([]string, erro
 			}
 		}
 
-		txIDs[i], err = sendTransaction(s.rpcClient, tx)
+		if isReplacement {
+			txIDs[i], err = sendTransactionReplacement(s.rpcClient, tx)
+		} else {
+			txIDs[i], err = sendTransaction(s.rpcClient, tx)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.addOutgoingTransactionToTransactionHistory(tx)
 		if err != nil {
 			return nil, err
 		}
@@ -69,3 +78,12 @@ func sendTransaction(client *rpcclient.RPCClient, tx *externalapi.DomainTransact
 	}
 	return submitTransactionResponse.TransactionID, nil
 }
+
+func sendTransactionReplacement(client *rpcclient.RPCClient, tx *externalapi.DomainTransaction) (string, error) {
+	submitTransactionReplacementResponse, err :=
+		client.SubmitTransactionReplacement(appmessage.DomainTransactionToRPCTransaction(tx))
+	if err != nil {
+		return "", errors.Wrapf(err, "error submitting transaction replacement")
+	}
+	return submitTransactionReplacementResponse.TransactionID, nil
+}
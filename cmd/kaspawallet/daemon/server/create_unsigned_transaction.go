@@ -22,8 +22,21 @@ func (s *server) CreateUnsignedTransactions(_ context.Context, request *pb.Creat
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	if request.ReplacedTransactionId != "" {
+		unsignedTransactions, err := s.createUnsignedTransactionReplacement(request.ReplacedTransactionId)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.CreateUnsignedTransactionsResponse{UnsignedTransactions: unsignedTransactions}, nil
+	}
+
+	feeRate, err := s.resolveFeeRate(request.FeeRate, request.FeeRateBucket)
+	if err != nil {
+		return nil, err
+	}
+
 	unsignedTransactions, err := s.createUnsignedTransactions(request.Address, request.Amount, request.IsSendAll,
-		request.From, request.UseExistingChangeAddress)
+		request.From, request.UseExistingChangeAddress, feeRate)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +44,12 @@ func (s *server) CreateUnsignedTransactions(_ context.Context, request *pb.Creat
 	return &pb.CreateUnsignedTransactionsResponse{UnsignedTransactions: unsignedTransactions}, nil
 }
 
-func (s *server) createUnsignedTransactions(address string, amount uint64, isSendAll bool, fromAddressesString []string, useExistingChangeAddress bool) ([][]byte, error) {
+// createUnsignedTransactions creates the unsigned transactions required to send the given amount to the given
+// address. If feeRate is zero, feePerInput is paid for every input, otherwise the fee matches the given fee rate
+// in sompi per gram of mass.
+func (s *server) createUnsignedTransactions(address string, amount uint64, isSendAll bool, fromAddressesString []string,
+	useExistingChangeAddress bool, feeRate float64) ([][]byte, error) {
+
 	if !s.isSynced() {
 		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
 	}
@@ -57,35 +75,62 @@ func (s *server) createUnsignedTransactions(address string, amount uint64, isSen
 		fromAddresses = append(fromAddresses, fromAddress)
 	}
 
-	selectedUTXOs, spendValue, changeSompi, err := s.selectUTXOs(amount, isSendAll, feePerInput, fromAddresses)
-	if err != nil {
-		return nil, err
+	// When paying by fee rate the fee depends on the mass of the transaction, which in turn depends on
+	// the selected UTXOs. So we start with no fee and raise the fee per input until it covers the
+	// fee required by the resulting transaction.
+	inputFee := uint64(feePerInput)
+	if feeRate > 0 {
+		inputFee = 0
 	}
+	var unsignedTransaction []byte
+	var changeAddress util.Address
+	var changeWalletAddress *walletAddress
+	for {
+		selectedUTXOs, spendValue, changeSompi, err := s.selectUTXOs(amount, isSendAll, inputFee, fromAddresses)
+		if err != nil {
+			return nil, err
+		}
 
-	if len(selectedUTXOs) == 0 {
-		return nil, errors.Errorf("couldn't find funds to spend")
-	}
+		if len(selectedUTXOs) == 0 {
+			return nil, errors.Errorf("couldn't find funds to spend")
+		}
 
-	changeAddress, changeWalletAddress, err := s.changeAddress(useExistingChangeAddress, fromAddresses)
-	if err != nil {
-		return nil, err
-	}
+		if changeAddress == nil {
+			changeAddress, changeWalletAddress, err = s.changeAddress(useExistingChangeAddress, fromAddresses)
+			if err != nil {
+				return nil, err
+			}
+		}
 
-	payments := []*libkaspawallet.Payment{{
-		Address: toAddress,
-		Amount:  spendValue,
-	}}
-	if changeSompi > 0 {
-		payments = append(payments, &libkaspawallet.Payment{
-			Address: changeAddress,
-			Amount:  changeSompi,
-		})
-	}
-	unsignedTransaction, err := libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
-		s.keysFile.MinimumSignatures,
-		payments, selectedUTXOs)
-	if err != nil {
-		return nil, err
+		payments := []*libkaspawallet.Payment{{
+			Address: toAddress,
+			Amount:  spendValue,
+		}}
+		if changeSompi > 0 {
+			payments = append(payments, &libkaspawallet.Payment{
+				Address: changeAddress,
+				Amount:  changeSompi,
+			})
+		}
+		unsignedTransaction, err = libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
+			s.keysFile.MinimumSignatures,
+			payments, selectedUTXOs)
+		if err != nil {
+			return nil, err
+		}
+
+		if feeRate == 0 {
+			break
+		}
+		requiredFee, err := s.requiredFee(unsignedTransaction, feeRate)
+		if err != nil {
+			return nil, err
+		}
+		inputCount := uint64(len(selectedUTXOs))
+		if inputFee*inputCount >= requiredFee {
+			break
+		}
+		inputFee = (requiredFee + inputCount - 1) / inputCount
 	}
 
 	unsignedTransactions, err := s.maybeAutoCompoundTransaction(unsignedTransaction, toAddress, changeAddress, changeWalletAddress)
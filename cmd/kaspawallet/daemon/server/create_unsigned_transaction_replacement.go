@@ -0,0 +1,179 @@
+package server
+
+import (
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/util"
+	"github.com/pkg/errors"
+)
+
+// createUnsignedTransactionReplacement creates an unsigned transaction that replaces the given
+// mempool transaction. The replacement spends all the inputs of the replaced transaction, and
+// pays the same amounts to the same recipients. On top of the fee of the replaced transaction
+// it pays feePerInput for each of its inputs, adding inputs if the change doesn't cover it.
+func (s *server) createUnsignedTransactionReplacement(replacedTransactionID string) ([][]byte, error) {
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+
+	getMempoolEntryResponse, err := s.rpcClient.GetMempoolEntry(replacedTransactionID, false, false)
+	if err != nil {
+		return nil, err
+	}
+	replacedTransaction, err := appmessage.RPCTransactionToDomainTransaction(getMempoolEntryResponse.Entry.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.refreshUTXOs()
+	if err != nil {
+		return nil, err
+	}
+
+	selectedUTXOs, err := s.replacedTransactionUTXOs(replacedTransaction)
+	if err != nil {
+		return nil, err
+	}
+	inputsValue := uint64(0)
+	for _, utxo := range selectedUTXOs {
+		inputsValue += utxo.UTXOEntry.Amount()
+	}
+
+	var payments []*libkaspawallet.Payment
+	var changeAddress util.Address
+	outputsValue := uint64(0)
+	paymentsValue := uint64(0)
+	for _, output := range replacedTransaction.Outputs {
+		outputsValue += output.Value
+
+		_, address, err := txscript.ExtractScriptPubKeyAddress(output.ScriptPublicKey, s.params)
+		if err != nil {
+			return nil, err
+		}
+		walletAddress, isWalletAddress := s.addressSet[address.String()]
+		if changeAddress == nil && isWalletAddress && walletAddress.keyChain == libkaspawallet.InternalKeychain {
+			changeAddress = address
+			continue
+		}
+
+		payments = append(payments, &libkaspawallet.Payment{
+			Address: address,
+			Amount:  output.Value,
+		})
+		paymentsValue += output.Value
+	}
+	if outputsValue > inputsValue {
+		return nil, errors.Errorf("transaction %s spends more than its inputs", replacedTransactionID)
+	}
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	replacedTransactionFee := inputsValue - outputsValue
+	fee := replacedTransactionFee + feePerInput*uint64(len(selectedUTXOs))
+	for _, utxo := range s.utxosSortedByAmount {
+		if inputsValue >= paymentsValue+fee {
+			break
+		}
+		if !isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, s.params.BlockCoinbaseMaturity) {
+			continue
+		}
+		if broadcastTime, ok := s.usedOutpoints[*utxo.Outpoint]; ok && time.Since(broadcastTime) <= time.Minute {
+			continue
+		}
+
+		selectedUTXOs = append(selectedUTXOs, &libkaspawallet.UTXO{
+			Outpoint:       utxo.Outpoint,
+			UTXOEntry:      utxo.UTXOEntry,
+			DerivationPath: s.walletAddressPath(utxo.address),
+		})
+		inputsValue += utxo.UTXOEntry.Amount()
+		fee += feePerInput
+	}
+	if inputsValue < paymentsValue+fee {
+		return nil, errors.Errorf("Insufficient funds for replacing transaction %s: %f required, while only %f available",
+			replacedTransactionID, float64(paymentsValue+fee)/constants.SompiPerKaspa,
+			float64(inputsValue)/constants.SompiPerKaspa)
+	}
+
+	changeSompi := inputsValue - paymentsValue - fee
+	if changeSompi > 0 {
+		if changeAddress == nil {
+			changeAddress, _, err = s.changeAddress(false, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+		payments = append(payments, &libkaspawallet.Payment{
+			Address: changeAddress,
+			Amount:  changeSompi,
+		})
+	}
+
+	unsignedTransaction, err := libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
+		s.keysFile.MinimumSignatures,
+		payments, selectedUTXOs)
+	if err != nil {
+		return nil, err
+	}
+
+	return [][]byte{unsignedTransaction}, nil
+}
+
+// replacedTransactionUTXOs returns the UTXOs spent by the given mempool transaction. Since
+// UTXOs spent in the mempool are excluded from s.utxosSortedByAmount, they are fetched
+// separately.
+func (s *server) replacedTransactionUTXOs(replacedTransaction *externalapi.DomainTransaction) (
+	[]*libkaspawallet.UTXO, error) {
+
+	getUTXOsByAddressesResponse, err := s.rpcClient.GetUTXOsByAddresses(s.addressSet.strings())
+	if err != nil {
+		return nil, err
+	}
+	entriesByOutpoint := make(map[appmessage.RPCOutpoint]*appmessage.UTXOsByAddressesEntry,
+		len(getUTXOsByAddressesResponse.Entries))
+	for _, entry := range getUTXOsByAddressesResponse.Entries {
+		entriesByOutpoint[*entry.Outpoint] = entry
+	}
+
+	utxos := make([]*libkaspawallet.UTXO, len(replacedTransaction.Inputs))
+	for i, input := range replacedTransaction.Inputs {
+		rpcOutpoint := appmessage.RPCOutpoint{
+			TransactionID: input.PreviousOutpoint.TransactionID.String(),
+			Index:         input.PreviousOutpoint.Index,
+		}
+		entry, ok := entriesByOutpoint[rpcOutpoint]
+		if !ok {
+			return nil, errors.Errorf("input %s of the replaced transaction does not spend a UTXO of this wallet",
+				input.PreviousOutpoint)
+		}
+
+		outpoint, err := appmessage.RPCOutpointToDomainOutpoint(entry.Outpoint)
+		if err != nil {
+			return nil, err
+		}
+		utxoEntry, err := appmessage.RPCUTXOEntryToUTXOEntry(entry.UTXOEntry)
+		if err != nil {
+			return nil, err
+		}
+		address, ok := s.addressSet[entry.Address]
+		if !ok {
+			return nil, errors.Errorf("Got result from address %s even though it wasn't requested", entry.Address)
+		}
+
+		utxos[i] = &libkaspawallet.UTXO{
+			Outpoint:       outpoint,
+			UTXOEntry:      utxoEntry,
+			DerivationPath: s.walletAddressPath(address),
+		}
+	}
+
+	return utxos, nil
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"math"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet/serialization"
+	"github.com/pkg/errors"
+)
+
+// resolveFeeRate returns the fee rate, in sompi per gram of mass, requested by the client.
+// If a fee rate bucket was requested instead, the fee rate of that bucket is fetched from the
+// node's fee estimate. Returns 0 if neither was requested, in which case feePerInput is paid
+// for every input.
+func (s *server) resolveFeeRate(feeRate float64, feeRateBucket string) (float64, error) {
+	if feeRate < 0 {
+		return 0, errors.Errorf("fee rate must not be negative")
+	}
+	if feeRate > 0 || feeRateBucket == "" {
+		return feeRate, nil
+	}
+
+	getFeeEstimateResponse, err := s.rpcClient.GetFeeEstimate()
+	if err != nil {
+		return 0, err
+	}
+	estimate := getFeeEstimateResponse.Estimate
+	switch feeRateBucket {
+	case "priority":
+		return estimate.PriorityBucket.FeeRate, nil
+	case "normal":
+		return estimate.NormalBucket.FeeRate, nil
+	case "low":
+		return estimate.LowBucket.FeeRate, nil
+	default:
+		return 0, errors.Errorf("unknown fee rate bucket %s. Expected one of priority, normal or low",
+			feeRateBucket)
+	}
+}
+
+// requiredFee returns the fee the given unsigned transaction has to pay, once
+// signed, in order to match the given fee rate
+func (s *server) requiredFee(unsignedTransaction []byte, feeRate float64) (uint64, error) {
+	partiallySignedTransaction, err := serialization.DeserializePartiallySignedTransaction(unsignedTransaction)
+	if err != nil {
+		return 0, err
+	}
+	mass, err := s.estimateMassAfterSignatures(partiallySignedTransaction)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(math.Ceil(float64(mass) * feeRate)), nil
+}
@@ -0,0 +1,185 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+)
+
+func (s *server) GetTransactionHistory(_ context.Context, request *pb.GetTransactionHistoryRequest) (
+	*pb.GetTransactionHistoryResponse, error) {
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, totalCount := s.transactionHistory.page(request.Offset, request.Limit)
+	transactions := make([]*pb.TransactionHistoryEntry, len(entries))
+	for i, entry := range entries {
+		confirmations := uint64(0)
+		if entry.IsAccepted && dagInfo.VirtualDAAScore >= entry.AcceptingDAAScore {
+			confirmations = dagInfo.VirtualDAAScore - entry.AcceptingDAAScore + 1
+		}
+		transactions[i] = &pb.TransactionHistoryEntry{
+			TransactionId:      entry.TransactionID,
+			Sent:               entry.sent(),
+			Received:           entry.received(),
+			Fee:                entry.Fee,
+			IsAccepted:         entry.IsAccepted,
+			AcceptingBlockHash: entry.AcceptingBlockHash,
+			AcceptingDaaScore:  entry.AcceptingDAAScore,
+			Confirmations:      confirmations,
+			Timestamp:          entry.Timestamp,
+			IsCoinbase:         entry.IsCoinbase,
+		}
+	}
+
+	return &pb.GetTransactionHistoryResponse{Transactions: transactions, TotalCount: totalCount}, nil
+}
+
+// startTransactionHistoryNotifications starts listening to the notifications
+// the transaction history is updated from
+func (s *server) startTransactionHistoryNotifications() error {
+	err := s.rpcClient.RegisterForVirtualSelectedParentChainChangedNotifications(true,
+		s.onVirtualSelectedParentChainChanged)
+	if err != nil {
+		return err
+	}
+
+	addresses, err := s.newTransactionHistoryAddressesWithLock()
+	if err != nil {
+		return err
+	}
+	return s.rpcClient.RegisterForUTXOsChangedNotifications(addresses, s.onUTXOsChanged)
+}
+
+// updateTransactionHistoryAddresses starts listening to UTXO changes of addresses that
+// might have been used since the last call
+func (s *server) updateTransactionHistoryAddresses() error {
+	addresses, err := s.newTransactionHistoryAddressesWithLock()
+	if err != nil {
+		return err
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+	return s.rpcClient.AddUTXOsChangedNotificationAddresses(addresses)
+}
+
+// newTransactionHistoryAddressesWithLock returns the addresses whose UTXO changes are not
+// listened to yet, up to numIndexesToQueryForRecentAddresses indexes after the last used one.
+// This way, payments to fresh addresses are noticed even before the address is known to be used.
+func (s *server) newTransactionHistoryAddressesWithLock() ([]string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	end := s.maxUsedIndex() + numIndexesToQueryForRecentAddresses
+	if end <= s.transactionHistoryAddressesEnd {
+		return nil, nil
+	}
+	addressSet, err := s.addressesToQuery(s.transactionHistoryAddressesEnd, end)
+	if err != nil {
+		return nil, err
+	}
+	s.transactionHistoryAddressesEnd = end
+	return addressSet.strings(), nil
+}
+
+func (s *server) onUTXOsChanged(notification *appmessage.UTXOsChangedNotificationMessage) {
+	err := s.addReceivedOutputsToTransactionHistory(notification.Added)
+	if err != nil {
+		log.Errorf("Error updating the transaction history: %s", err)
+	}
+}
+
+func (s *server) onVirtualSelectedParentChainChanged(
+	notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage) {
+
+	acceptingBlockHashes := s.transactionHistory.acceptingBlocksOfKnownTransactions(notification.AcceptedTransactionIDs)
+	acceptingDAAScores := make(map[string]uint64, len(acceptingBlockHashes))
+	for _, blockHash := range acceptingBlockHashes {
+		getBlockResponse, err := s.rpcClient.GetBlock(blockHash, false)
+		if err != nil {
+			log.Warnf("Error getting accepting block %s of wallet transactions: %s", blockHash, err)
+			continue
+		}
+		acceptingDAAScores[blockHash] = getBlockResponse.Block.Header.DAAScore
+	}
+
+	err := s.transactionHistory.applyChainChanges(notification.RemovedChainBlockHashes,
+		notification.AcceptedTransactionIDs, acceptingDAAScores)
+	if err != nil {
+		log.Errorf("Error updating the transaction history: %s", err)
+	}
+}
+
+// addReceivedOutputsToTransactionHistory records the given UTXOs of the wallet in the transaction history
+func (s *server) addReceivedOutputsToTransactionHistory(entries []*appmessage.UTXOsByAddressesEntry) error {
+	receivedOutputs := make([]*receivedOutput, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Outpoint == nil || entry.UTXOEntry == nil {
+			continue
+		}
+		receivedOutputs = append(receivedOutputs, &receivedOutput{
+			transactionID: entry.Outpoint.TransactionID,
+			output: &transactionHistoryOutput{
+				Index:   entry.Outpoint.Index,
+				Address: entry.Address,
+				Amount:  entry.UTXOEntry.Amount,
+			},
+			acceptingDAAScore: entry.UTXOEntry.BlockDAAScore,
+			isCoinbase:        entry.UTXOEntry.IsCoinbase,
+		})
+	}
+	return s.transactionHistory.addReceivedOutputs(receivedOutputs, time.Now().UnixMilli())
+}
+
+// addOutgoingTransactionToTransactionHistory records a transaction broadcast by the wallet
+// in the transaction history. Transactions that don't spend any of the wallet's UTXOs are
+// ignored, since they are recorded once their outputs to the wallet are accepted.
+func (s *server) addOutgoingTransactionToTransactionHistory(transaction *externalapi.DomainTransaction) error {
+	walletUTXOs := make(map[externalapi.DomainOutpoint]*walletUTXO, len(s.utxosSortedByAmount))
+	for _, utxo := range s.utxosSortedByAmount {
+		walletUTXOs[*utxo.Outpoint] = utxo
+	}
+
+	inputs := make([]*transactionHistoryInput, 0, len(transaction.Inputs))
+	inputsAmount := uint64(0)
+	areAllInputsKnown := true
+	for _, input := range transaction.Inputs {
+		utxo, ok := walletUTXOs[input.PreviousOutpoint]
+		if !ok {
+			areAllInputsKnown = false
+			continue
+		}
+		inputs = append(inputs, &transactionHistoryInput{
+			TransactionID: input.PreviousOutpoint.TransactionID.String(),
+			Index:         input.PreviousOutpoint.Index,
+			Amount:        utxo.UTXOEntry.Amount(),
+		})
+		inputsAmount += utxo.UTXOEntry.Amount()
+	}
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	// The fee is only known if the amounts of all the inputs are known
+	fee := uint64(0)
+	if areAllInputsKnown {
+		outputsAmount := uint64(0)
+		for _, output := range transaction.Outputs {
+			outputsAmount += output.Value
+		}
+		if inputsAmount > outputsAmount {
+			fee = inputsAmount - outputsAmount
+		}
+	}
+
+	transactionID := consensushashing.TransactionID(transaction).String()
+	return s.transactionHistory.addOutgoing(transactionID, inputs, fee, time.Now().UnixMilli())
+}
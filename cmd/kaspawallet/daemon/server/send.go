@@ -10,8 +10,13 @@ func (s *server) Send(_ context.Context, request *pb.SendRequest) (*pb.SendRespo
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	feeRate, err := s.resolveFeeRate(request.FeeRate, request.FeeRateBucket)
+	if err != nil {
+		return nil, err
+	}
+
 	unsignedTransactions, err := s.createUnsignedTransactions(request.ToAddress, request.Amount, request.IsSendAll,
-		request.From, request.UseExistingChangeAddress)
+		request.From, request.UseExistingChangeAddress, feeRate)
 
 	if err != nil {
 		return nil, err
@@ -22,7 +27,7 @@ func (s *server) Send(_ context.Context, request *pb.SendRequest) (*pb.SendRespo
 		return nil, err
 	}
 
-	txIDs, err := s.broadcast(signedTransactions, false)
+	txIDs, err := s.broadcast(signedTransactions, false, false)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/kaspanet/kaspad/cmd/kaspawallet/keys"
 	"github.com/kaspanet/kaspad/domain/dagconfig"
 	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient/grpcclient"
 	"github.com/kaspanet/kaspad/infrastructure/os/signal"
 	"github.com/kaspanet/kaspad/util/panics"
 	"github.com/pkg/errors"
@@ -39,6 +40,11 @@ type server struct {
 	txMassCalculator    *txmass.Calculator
 	usedOutpoints       map[externalapi.DomainOutpoint]time.Time
 
+	transactionHistory *transactionHistory
+	// transactionHistoryAddressesEnd is the address index up to which UTXO changes
+	// are listened to for the transaction history
+	transactionHistoryAddressesEnd uint32
+
 	isLogFinalProgressLineShown bool
 	maxUsedAddressesForLog      uint32
 	maxProcessedAddressesForLog uint32
@@ -49,7 +55,9 @@ type server struct {
 const MaxDaemonSendMsgSize = 100_000_000
 
 // Start starts the kaspawalletd server
-func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath string, profile string, timeout uint32) error {
+func Start(params *dagconfig.Params, listen, rpcServer string, rpcConnectOptions *grpcclient.ConnectOptions,
+	keysFilePath string, profile string, timeout uint32) error {
+
 	initLog(defaultLogFile, defaultErrLogFile)
 
 	defer panics.HandlePanic(log, "MAIN", nil)
@@ -66,7 +74,7 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 	log.Infof("Listening to TCP on %s", listen)
 
 	log.Infof("Connecting to a node at %s...", rpcServer)
-	rpcClient, err := connectToRPC(params, rpcServer, timeout)
+	rpcClient, err := connectToRPC(params, rpcServer, rpcConnectOptions, timeout)
 	if err != nil {
 		return (errors.Wrapf(err, "Error connecting to RPC server %s", rpcServer))
 	}
@@ -82,6 +90,11 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 		return err
 	}
 
+	history, err := loadTransactionHistory(transactionHistoryPath(keysFile.Path()))
+	if err != nil {
+		return errors.Wrapf(err, "Error reading the transaction history")
+	}
+
 	serverInstance := &server{
 		rpcClient:                   rpcClient,
 		params:                      params,
@@ -92,6 +105,7 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 		addressSet:                  make(walletAddressSet),
 		txMassCalculator:            txmass.NewCalculator(params.MassPerTxByte, params.MassPerScriptPubKeyByte, params.MassPerSigOp),
 		usedOutpoints:               map[externalapi.DomainOutpoint]time.Time{},
+		transactionHistory:          history,
 		isLogFinalProgressLineShown: false,
 		maxUsedAddressesForLog:      0,
 		maxProcessedAddressesForLog: 0,
@@ -37,6 +37,11 @@ func (s *server) sync() error {
 		return err
 	}
 
+	err = s.startTransactionHistoryNotifications()
+	if err != nil {
+		return err
+	}
+
 	for range ticker.C {
 		err = s.collectFarAddresses()
 		if err != nil {
@@ -52,6 +57,11 @@ func (s *server) sync() error {
 		if err != nil {
 			return err
 		}
+
+		err = s.updateTransactionHistoryAddresses()
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -277,7 +287,14 @@ func (s *server) refreshUTXOs() error {
 		return err
 	}
 
-	return s.updateUTXOSet(getUTXOsByAddressesResponse.Entries, mempoolEntriesByAddresses.Entries)
+	err = s.updateUTXOSet(getUTXOsByAddressesResponse.Entries, mempoolEntriesByAddresses.Entries)
+	if err != nil {
+		return err
+	}
+
+	// UTXOs that were received before the wallet daemon started listening to
+	// UTXO changes are added to the transaction history here
+	return s.addReceivedOutputsToTransactionHistory(getUTXOsByAddressesResponse.Entries)
 }
 
 func (s *server) isSynced() bool {
@@ -0,0 +1,341 @@
+package server
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+const transactionHistoryVersion = 1
+
+// transactionHistory is the locally persisted history of the transactions
+// that spent from or paid to the wallet
+type transactionHistory struct {
+	path    string
+	entries map[string]*transactionHistoryEntry
+	lock    sync.RWMutex
+}
+
+// transactionHistoryEntry is a single transaction in the transaction history
+type transactionHistoryEntry struct {
+	TransactionID string `json:"transactionId"`
+
+	// Inputs are the wallet's outpoints that were spent by the transaction
+	Inputs []*transactionHistoryInput `json:"inputs,omitempty"`
+
+	// Outputs are the outputs of the transaction that pay to the wallet
+	Outputs []*transactionHistoryOutput `json:"outputs,omitempty"`
+
+	Fee                uint64 `json:"fee"`
+	IsCoinbase         bool   `json:"isCoinbase"`
+	IsAccepted         bool   `json:"isAccepted"`
+	AcceptingBlockHash string `json:"acceptingBlockHash,omitempty"`
+	AcceptingDAAScore  uint64 `json:"acceptingDaaScore"`
+
+	// Timestamp is the time the transaction was first seen, in milliseconds since the epoch
+	Timestamp int64 `json:"timestamp"`
+}
+
+type transactionHistoryInput struct {
+	TransactionID string `json:"transactionId"`
+	Index         uint32 `json:"index"`
+	Amount        uint64 `json:"amount"`
+}
+
+type transactionHistoryOutput struct {
+	Index   uint32 `json:"index"`
+	Address string `json:"address"`
+	Amount  uint64 `json:"amount"`
+}
+
+// receivedOutput is an output paying to the wallet, that was accepted by the DAG
+type receivedOutput struct {
+	transactionID     string
+	output            *transactionHistoryOutput
+	acceptingDAAScore uint64
+	isCoinbase        bool
+}
+
+type transactionHistoryFileJSON struct {
+	Version      uint32                     `json:"version"`
+	Transactions []*transactionHistoryEntry `json:"transactions"`
+}
+
+func (e *transactionHistoryEntry) sent() uint64 {
+	sent := uint64(0)
+	for _, input := range e.Inputs {
+		sent += input.Amount
+	}
+	return sent
+}
+
+func (e *transactionHistoryEntry) received() uint64 {
+	received := uint64(0)
+	for _, output := range e.Outputs {
+		received += output.Amount
+	}
+	return received
+}
+
+func (e *transactionHistoryEntry) hasOutput(index uint32) bool {
+	for _, output := range e.Outputs {
+		if output.Index == index {
+			return true
+		}
+	}
+	return false
+}
+
+// transactionHistoryPath returns the path of the transaction history file
+// of the wallet with the given keys file
+func transactionHistoryPath(keysFilePath string) string {
+	return strings.TrimSuffix(keysFilePath, filepath.Ext(keysFilePath)) + "-history.json"
+}
+
+// loadTransactionHistory reads the transaction history saved in the given path.
+// An empty history is returned if the file does not exist yet.
+func loadTransactionHistory(path string) (*transactionHistory, error) {
+	history := &transactionHistory{
+		path:    path,
+		entries: make(map[string]*transactionHistoryEntry),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return history, nil
+		}
+		return nil, err
+	}
+
+	fileJSON := &transactionHistoryFileJSON{}
+	err = json.Unmarshal(data, fileJSON)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing transaction history file %s", path)
+	}
+	if fileJSON.Version != transactionHistoryVersion {
+		return nil, errors.Errorf("unsupported transaction history file version %d", fileJSON.Version)
+	}
+	for _, entry := range fileJSON.Transactions {
+		history.entries[entry.TransactionID] = entry
+	}
+	return history, nil
+}
+
+// save writes the history to its file. The caller must hold the write lock.
+func (th *transactionHistory) save() error {
+	fileJSON := &transactionHistoryFileJSON{
+		Version:      transactionHistoryVersion,
+		Transactions: make([]*transactionHistoryEntry, 0, len(th.entries)),
+	}
+	for _, entry := range th.entries {
+		fileJSON.Transactions = append(fileJSON.Transactions, entry)
+	}
+	sort.Slice(fileJSON.Transactions, func(i, j int) bool {
+		return fileJSON.Transactions[i].TransactionID < fileJSON.Transactions[j].TransactionID
+	})
+
+	data, err := json.Marshal(fileJSON)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(th.path), 0700)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first, so that the history is never left half written
+	temporaryPath := th.path + ".tmp"
+	err = os.WriteFile(temporaryPath, data, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(temporaryPath, th.path)
+}
+
+// addOutgoing records a transaction that was broadcast by the wallet. Transactions of the
+// wallet that were not accepted yet and spend any of the same inputs were replaced by the
+// given transaction, and are removed from the history.
+func (th *transactionHistory) addOutgoing(transactionID string, inputs []*transactionHistoryInput,
+	fee uint64, timestamp int64) error {
+
+	th.lock.Lock()
+	defer th.lock.Unlock()
+
+	spentOutpoints := make(map[transactionHistoryInput]struct{}, len(inputs))
+	for _, input := range inputs {
+		spentOutpoints[transactionHistoryInput{TransactionID: input.TransactionID, Index: input.Index}] = struct{}{}
+	}
+	for otherTransactionID, entry := range th.entries {
+		if entry.IsAccepted || otherTransactionID == transactionID {
+			continue
+		}
+		for _, input := range entry.Inputs {
+			if _, ok := spentOutpoints[transactionHistoryInput{TransactionID: input.TransactionID, Index: input.Index}]; ok {
+				delete(th.entries, otherTransactionID)
+				break
+			}
+		}
+	}
+
+	entry, ok := th.entries[transactionID]
+	if !ok {
+		entry = &transactionHistoryEntry{TransactionID: transactionID, Timestamp: timestamp}
+		th.entries[transactionID] = entry
+	}
+	entry.Inputs = inputs
+	entry.Fee = fee
+
+	return th.save()
+}
+
+// addReceivedOutputs records the given outputs paying to the wallet.
+// Outputs that were already recorded are ignored.
+func (th *transactionHistory) addReceivedOutputs(outputs []*receivedOutput, timestamp int64) error {
+	th.lock.Lock()
+	defer th.lock.Unlock()
+
+	isChanged := false
+	for _, received := range outputs {
+		entry, ok := th.entries[received.transactionID]
+		if !ok {
+			entry = &transactionHistoryEntry{TransactionID: received.transactionID, Timestamp: timestamp}
+			th.entries[received.transactionID] = entry
+		}
+		if entry.hasOutput(received.output.Index) {
+			continue
+		}
+		isChanged = true
+
+		entry.Outputs = append(entry.Outputs, received.output)
+		sort.Slice(entry.Outputs, func(i, j int) bool { return entry.Outputs[i].Index < entry.Outputs[j].Index })
+		entry.IsCoinbase = received.isCoinbase
+		if !entry.IsAccepted {
+			entry.IsAccepted = true
+			entry.AcceptingDAAScore = received.acceptingDAAScore
+		}
+	}
+
+	if !isChanged {
+		return nil
+	}
+	return th.save()
+}
+
+// acceptingBlocksOfKnownTransactions returns the hashes of the blocks that accept
+// transactions of the history, out of the given accepted transaction IDs
+func (th *transactionHistory) acceptingBlocksOfKnownTransactions(
+	acceptedTransactionIDs []*appmessage.AcceptedTransactionIDs) []string {
+
+	th.lock.RLock()
+	defer th.lock.RUnlock()
+
+	var acceptingBlockHashes []string
+	for _, accepted := range acceptedTransactionIDs {
+		for _, transactionID := range accepted.AcceptedTransactionIDs {
+			if _, ok := th.entries[transactionID]; ok {
+				acceptingBlockHashes = append(acceptingBlockHashes, accepted.AcceptingBlockHash)
+				break
+			}
+		}
+	}
+	return acceptingBlockHashes
+}
+
+// applyChainChanges updates the acceptance of the transactions in the history according
+// to a change of the virtual selected parent chain. Transactions accepted by removed chain
+// blocks become unaccepted, unless they are accepted again by one of the added blocks.
+// acceptingDAAScores holds the DAA score of every added block that accepts a transaction
+// of the history.
+func (th *transactionHistory) applyChainChanges(removedChainBlockHashes []string,
+	acceptedTransactionIDs []*appmessage.AcceptedTransactionIDs, acceptingDAAScores map[string]uint64) error {
+
+	th.lock.Lock()
+	defer th.lock.Unlock()
+
+	isChanged := false
+	removedChainBlockHashesSet := make(map[string]struct{}, len(removedChainBlockHashes))
+	for _, blockHash := range removedChainBlockHashes {
+		removedChainBlockHashesSet[blockHash] = struct{}{}
+	}
+	for _, entry := range th.entries {
+		if _, ok := removedChainBlockHashesSet[entry.AcceptingBlockHash]; ok && entry.AcceptingBlockHash != "" {
+			entry.IsAccepted = false
+			entry.AcceptingBlockHash = ""
+			entry.AcceptingDAAScore = 0
+			isChanged = true
+		}
+	}
+
+	for _, accepted := range acceptedTransactionIDs {
+		acceptingDAAScore, ok := acceptingDAAScores[accepted.AcceptingBlockHash]
+		if !ok {
+			continue
+		}
+		for _, transactionID := range accepted.AcceptedTransactionIDs {
+			entry, ok := th.entries[transactionID]
+			if !ok {
+				continue
+			}
+			entry.IsAccepted = true
+			entry.AcceptingBlockHash = accepted.AcceptingBlockHash
+			entry.AcceptingDAAScore = acceptingDAAScore
+			isChanged = true
+		}
+	}
+
+	if !isChanged {
+		return nil
+	}
+	return th.save()
+}
+
+// page returns up to limit entries of the history, skipping the first offset ones, along with
+// the total number of entries. Pending transactions come first, followed by the accepted ones
+// from the most recently accepted. A zero limit returns all the remaining entries.
+func (th *transactionHistory) page(offset, limit uint32) ([]*transactionHistoryEntry, uint32) {
+	th.lock.RLock()
+	defer th.lock.RUnlock()
+
+	entries := make([]*transactionHistoryEntry, 0, len(th.entries))
+	for _, entry := range th.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].IsAccepted != entries[j].IsAccepted {
+			return !entries[i].IsAccepted
+		}
+		if entries[i].AcceptingDAAScore != entries[j].AcceptingDAAScore {
+			return entries[i].AcceptingDAAScore > entries[j].AcceptingDAAScore
+		}
+		if entries[i].Timestamp != entries[j].Timestamp {
+			return entries[i].Timestamp > entries[j].Timestamp
+		}
+		return entries[i].TransactionID < entries[j].TransactionID
+	})
+
+	totalCount := uint32(len(entries))
+	if offset >= totalCount {
+		return []*transactionHistoryEntry{}, totalCount
+	}
+	end := totalCount
+	if limit != 0 && offset+limit < totalCount {
+		end = offset + limit
+	}
+
+	// Entries are copied since they keep changing after the lock is released
+	page := make([]*transactionHistoryEntry, 0, end-offset)
+	for _, entry := range entries[offset:end] {
+		entryCopy := *entry
+		entryCopy.Inputs = append([]*transactionHistoryInput(nil), entry.Inputs...)
+		entryCopy.Outputs = append([]*transactionHistoryOutput(nil), entry.Outputs...)
+		page = append(page, &entryCopy)
+	}
+	return page, totalCount
+}
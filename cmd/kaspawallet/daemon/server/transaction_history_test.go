@@ -0,0 +1,97 @@
+package server
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+)
+
+func TestTransactionHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys-history.json")
+	history, err := loadTransactionHistory(path)
+	if err != nil {
+		t.Fatalf("loadTransactionHistory: %s", err)
+	}
+
+	received := []*receivedOutput{
+		{transactionID: "a", output: &transactionHistoryOutput{Index: 0, Address: "address1", Amount: 100}, acceptingDAAScore: 10},
+		{transactionID: "a", output: &transactionHistoryOutput{Index: 1, Address: "address2", Amount: 50}, acceptingDAAScore: 10},
+		{transactionID: "b", output: &transactionHistoryOutput{Index: 0, Address: "address1", Amount: 20}, acceptingDAAScore: 20},
+	}
+	err = history.addReceivedOutputs(received, 1000)
+	if err != nil {
+		t.Fatalf("addReceivedOutputs: %s", err)
+	}
+	// Outputs that were already recorded must not be counted twice
+	err = history.addReceivedOutputs(received[:1], 2000)
+	if err != nil {
+		t.Fatalf("addReceivedOutputs: %s", err)
+	}
+
+	err = history.addOutgoing("c", []*transactionHistoryInput{{TransactionID: "a", Index: 0, Amount: 100}}, 5, 3000)
+	if err != nil {
+		t.Fatalf("addOutgoing: %s", err)
+	}
+	// d replaces c, since it spends the same input
+	err = history.addOutgoing("d", []*transactionHistoryInput{{TransactionID: "a", Index: 0, Amount: 100}}, 10, 4000)
+	if err != nil {
+		t.Fatalf("addOutgoing: %s", err)
+	}
+
+	entries, totalCount := history.page(0, 0)
+	assertTransactionHistory(t, entries, totalCount, []string{"d", "b", "a"}, 3)
+	if entries[2].received() != 150 || entries[0].sent() != 100 || entries[0].Fee != 10 {
+		t.Fatalf("Unexpected amounts: received %d, sent %d, fee %d",
+			entries[2].received(), entries[0].sent(), entries[0].Fee)
+	}
+
+	accepted := []*appmessage.AcceptedTransactionIDs{{AcceptingBlockHash: "block1", AcceptedTransactionIDs: []string{"x", "d"}}}
+	acceptingBlockHashes := history.acceptingBlocksOfKnownTransactions(accepted)
+	if len(acceptingBlockHashes) != 1 || acceptingBlockHashes[0] != "block1" {
+		t.Fatalf("Unexpected accepting blocks %v", acceptingBlockHashes)
+	}
+	err = history.applyChainChanges(nil, accepted, map[string]uint64{"block1": 30})
+	if err != nil {
+		t.Fatalf("applyChainChanges: %s", err)
+	}
+	entries, totalCount = history.page(0, 2)
+	assertTransactionHistory(t, entries, totalCount, []string{"d", "b"}, 3)
+	if !entries[0].IsAccepted || entries[0].AcceptingDAAScore != 30 {
+		t.Fatalf("Transaction d was not accepted")
+	}
+
+	// Once block1 is removed from the selected parent chain, d is pending again
+	err = history.applyChainChanges([]string{"block1"}, nil, nil)
+	if err != nil {
+		t.Fatalf("applyChainChanges: %s", err)
+	}
+	entries, totalCount = history.page(1, 5)
+	assertTransactionHistory(t, entries, totalCount, []string{"b", "a"}, 3)
+
+	loadedHistory, err := loadTransactionHistory(path)
+	if err != nil {
+		t.Fatalf("loadTransactionHistory: %s", err)
+	}
+	entries, totalCount = loadedHistory.page(0, 0)
+	assertTransactionHistory(t, entries, totalCount, []string{"d", "b", "a"}, 3)
+	if entries[0].IsAccepted {
+		t.Fatalf("The unaccepted state of d was not saved")
+	}
+}
+
+func assertTransactionHistory(t *testing.T, entries []*transactionHistoryEntry, totalCount uint32,
+	expectedTransactionIDs []string, expectedTotalCount uint32) {
+
+	if totalCount != expectedTotalCount {
+		t.Fatalf("Expected a total of %d transactions but got %d", expectedTotalCount, totalCount)
+	}
+	if len(entries) != len(expectedTransactionIDs) {
+		t.Fatalf("Expected %d transactions but got %d", len(expectedTransactionIDs), len(entries))
+	}
+	for i, entry := range entries {
+		if entry.TransactionID != expectedTransactionIDs[i] {
+			t.Fatalf("Expected transaction %s at index %d but got %s", expectedTransactionIDs[i], i, entry.TransactionID)
+		}
+	}
+}
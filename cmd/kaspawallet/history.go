@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/client"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/utils"
+)
+
+func history(conf *historyConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+	response, err := daemonClient.GetTransactionHistory(ctx,
+		&pb.GetTransactionHistoryRequest{Offset: conf.Offset, Limit: conf.Limit})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Transactions) == 0 {
+		fmt.Printf("No transactions to show (%d transactions in total)\n", response.TotalCount)
+		return nil
+	}
+
+	separator := strings.Repeat("-", 141)
+	fmt.Printf("%-64s %-19s %20s %19s %15s\n", "Transaction ID", "Date", "Amount KAS", "Fee KAS", "Confirmations")
+	fmt.Println(separator)
+	for _, transaction := range response.Transactions {
+		sign := "+"
+		amount := transaction.Received - transaction.Sent
+		if transaction.Sent > transaction.Received {
+			sign = "-"
+			amount = transaction.Sent - transaction.Received
+		}
+		confirmations := "pending"
+		if transaction.IsAccepted {
+			confirmations = fmt.Sprintf("%d", transaction.Confirmations)
+		}
+		coinbaseSuffix := ""
+		if transaction.IsCoinbase {
+			coinbaseSuffix = " (coinbase)"
+		}
+		date := time.UnixMilli(transaction.Timestamp).Format("2006-01-02 15:04:05")
+		fmt.Printf("%s %s %s%s %s %15s%s\n", transaction.TransactionId, date, sign, utils.FormatKas(amount),
+			utils.FormatKas(transaction.Fee), confirmations, coinbaseSuffix)
+	}
+	fmt.Println(separator)
+	fmt.Printf("Showing transactions %d-%d out of %d\n", conf.Offset+1, conf.Offset+uint32(len(response.Transactions)),
+		response.TotalCount)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package libkaspawallet
+
+import (
+	"bytes"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet/pskt"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet/serialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+// PartiallySignedTransactionToPSKT converts a serialized partially signed transaction
+// into a PSKT, filling in the redeem scripts of its multisig inputs
+func PartiallySignedTransactionToPSKT(serializedPSTx []byte, ecdsa bool) (*pskt.PSKT, error) {
+	partiallySignedTransaction, err := serialization.DeserializePartiallySignedTransaction(serializedPSTx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := pskt.FromPartiallySignedTransaction(partiallySignedTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, partiallySignedInput := range partiallySignedTransaction.PartiallySignedInputs {
+		isMultisig := len(partiallySignedInput.PubKeySignaturePairs) > 1
+		if !isMultisig {
+			continue
+		}
+		p.Inputs[i].RedeemScript, err = partiallySignedInputMultisigRedeemScript(partiallySignedInput, ecdsa)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// PSKTToPartiallySignedTransaction converts a PSKT into
+// a serialized partially signed transaction
+func PSKTToPartiallySignedTransaction(p *pskt.PSKT) ([]byte, error) {
+	partiallySignedTransaction, err := pskt.ToPartiallySignedTransaction(p)
+	if err != nil {
+		return nil, err
+	}
+	return serialization.SerializePartiallySignedTransaction(partiallySignedTransaction)
+}
+
+// FinalizePSKT makes sure the given PSKT is fully signed and that its redeem scripts
+// match its signers. It returns the fully signed transaction both as a serialized
+// partially signed transaction, which is ready to broadcast, and as a domain transaction.
+func FinalizePSKT(p *pskt.PSKT, ecdsa bool) ([]byte, *externalapi.DomainTransaction, error) {
+	if !p.IsFullySigned() {
+		return nil, nil, errors.Errorf("the PSKT is not fully signed")
+	}
+
+	partiallySignedTransaction, err := pskt.ToPartiallySignedTransaction(p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i, input := range p.Inputs {
+		if input.RedeemScript == nil {
+			continue
+		}
+		redeemScript, err := partiallySignedInputMultisigRedeemScript(
+			partiallySignedTransaction.PartiallySignedInputs[i], ecdsa)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !bytes.Equal(redeemScript, input.RedeemScript) {
+			return nil, nil, errors.Errorf("the redeem script of input %d doesn't match its signers", i)
+		}
+	}
+
+	serializedPSTx, err := serialization.SerializePartiallySignedTransaction(partiallySignedTransaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := ExtractTransactionDeserialized(partiallySignedTransaction, ecdsa)
+	if err != nil {
+		return nil, nil, err
+	}
+	return serializedPSTx, tx, nil
+}
@@ -0,0 +1,332 @@
+package pskt
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/util/binaryserializer"
+	"github.com/pkg/errors"
+)
+
+// The binary encoding of a PSKT is laid out as follows. All integers are little-endian,
+// and every variable length field (bytes or string) is prefixed with its length as a uint32:
+//
+//	magic                   5 bytes: "pskt" 0xff
+//	version                 uint32
+//	global:
+//	  tx version            uint16
+//	  lock time             uint64
+//	  subnetwork ID         20 bytes
+//	  gas                   uint64
+//	  payload               bytes
+//	input count             uint32
+//	inputs:
+//	  transaction ID        32 bytes
+//	  index                 uint32
+//	  sequence              uint64
+//	  sig-op count          uint8
+//	  amount                uint64
+//	  script version        uint16
+//	  script public key     bytes
+//	  block DAA score       uint64
+//	  is coinbase           uint8
+//	  derivation path       string
+//	  minimum signatures    uint32
+//	  redeem script         bytes
+//	  signer count          uint32
+//	  signers:
+//	    extended public key string
+//	    signature           bytes (empty if not signed)
+//	output count            uint32
+//	outputs:
+//	  amount                uint64
+//	  script version        uint16
+//	  script public key     bytes
+
+var magic = []byte{'p', 's', 'k', 't', 0xff}
+
+// maxVariableLengthFieldSize is the maximum size of a single variable length field.
+// It exists so that a corrupted length won't make us allocate an arbitrary amount of memory
+const maxVariableLengthFieldSize = 1 << 20 // 1 MiB
+
+// IsBinary returns whether the given bytes look like a binary-encoded PSKT
+func IsBinary(encoded []byte) bool {
+	return bytes.HasPrefix(encoded, magic)
+}
+
+// EncodeBinary encodes the given PSKT into its binary encoding
+func EncodeBinary(p *PSKT) ([]byte, error) {
+	err := p.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &bytes.Buffer{}
+	w.Write(magic)
+	encoder := &binaryEncoder{w: w}
+	encoder.putUint32(p.Version)
+
+	encoder.putUint16(p.Global.TxVersion)
+	encoder.putUint64(p.Global.LockTime)
+	encoder.putFixedBytes(p.Global.SubnetworkID[:])
+	encoder.putUint64(p.Global.Gas)
+	encoder.putBytes(p.Global.Payload)
+
+	encoder.putUint32(uint32(len(p.Inputs)))
+	for _, input := range p.Inputs {
+		encoder.putFixedBytes(input.PreviousOutpoint.TransactionID.ByteSlice())
+		encoder.putUint32(input.PreviousOutpoint.Index)
+		encoder.putUint64(input.Sequence)
+		encoder.putUint8(input.SigOpCount)
+		encoder.putUint64(input.UTXOEntry.Amount)
+		encoder.putScriptPublicKey(input.UTXOEntry.ScriptPublicKey)
+		encoder.putUint64(input.UTXOEntry.BlockDAAScore)
+		encoder.putBool(input.UTXOEntry.IsCoinbase)
+		encoder.putBytes([]byte(input.DerivationPath))
+		encoder.putUint32(input.MinimumSignatures)
+		encoder.putBytes(input.RedeemScript)
+		encoder.putUint32(uint32(len(input.Signers)))
+		for _, signer := range input.Signers {
+			encoder.putBytes([]byte(signer.ExtendedPublicKey))
+			encoder.putBytes(signer.Signature)
+		}
+	}
+
+	encoder.putUint32(uint32(len(p.Outputs)))
+	for _, output := range p.Outputs {
+		encoder.putUint64(output.Amount)
+		encoder.putScriptPublicKey(output.ScriptPublicKey)
+	}
+
+	if encoder.err != nil {
+		return nil, encoder.err
+	}
+	return w.Bytes(), nil
+}
+
+// DecodeBinary decodes a PSKT from its binary encoding
+func DecodeBinary(encoded []byte) (*PSKT, error) {
+	if !IsBinary(encoded) {
+		return nil, errors.Errorf("the given bytes are not a binary-encoded PSKT")
+	}
+	decoder := &binaryDecoder{r: bytes.NewReader(encoded[len(magic):])}
+
+	version := decoder.uint32()
+	if decoder.err == nil && version != Version {
+		return nil, errors.Errorf("unsupported PSKT version %d. Expected version: %d", version, Version)
+	}
+
+	p := &PSKT{
+		Version: version,
+		Global:  &Global{},
+	}
+	p.Global.TxVersion = decoder.uint16()
+	p.Global.LockTime = decoder.uint64()
+	decoder.fixedBytes(p.Global.SubnetworkID[:])
+	p.Global.Gas = decoder.uint64()
+	p.Global.Payload = decoder.bytes()
+
+	inputCount := decoder.count()
+	p.Inputs = make([]*Input, 0, inputCount)
+	for i := uint32(0); i < inputCount && decoder.err == nil; i++ {
+		input := &Input{UTXOEntry: &UTXOEntry{}}
+		var transactionIDBytes [externalapi.DomainHashSize]byte
+		decoder.fixedBytes(transactionIDBytes[:])
+		input.PreviousOutpoint.TransactionID = *externalapi.NewDomainTransactionIDFromByteArray(&transactionIDBytes)
+		input.PreviousOutpoint.Index = decoder.uint32()
+		input.Sequence = decoder.uint64()
+		input.SigOpCount = decoder.uint8()
+		input.UTXOEntry.Amount = decoder.uint64()
+		input.UTXOEntry.ScriptPublicKey = decoder.scriptPublicKey()
+		input.UTXOEntry.BlockDAAScore = decoder.uint64()
+		input.UTXOEntry.IsCoinbase = decoder.bool()
+		input.DerivationPath = string(decoder.bytes())
+		input.MinimumSignatures = decoder.uint32()
+		input.RedeemScript = decoder.bytes()
+
+		signerCount := decoder.count()
+		input.Signers = make([]*Signer, 0, signerCount)
+		for j := uint32(0); j < signerCount && decoder.err == nil; j++ {
+			input.Signers = append(input.Signers, &Signer{
+				ExtendedPublicKey: string(decoder.bytes()),
+				Signature:         decoder.bytes(),
+			})
+		}
+		p.Inputs = append(p.Inputs, input)
+	}
+
+	outputCount := decoder.count()
+	p.Outputs = make([]*Output, 0, outputCount)
+	for i := uint32(0); i < outputCount && decoder.err == nil; i++ {
+		p.Outputs = append(p.Outputs, &Output{
+			Amount:          decoder.uint64(),
+			ScriptPublicKey: decoder.scriptPublicKey(),
+		})
+	}
+
+	if decoder.err != nil {
+		return nil, errors.Wrapf(decoder.err, "failed decoding the binary PSKT")
+	}
+	if decoder.r.Len() != 0 {
+		return nil, errors.Errorf("the binary PSKT has %d unexpected trailing bytes", decoder.r.Len())
+	}
+
+	err := p.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// binaryEncoder writes the fields of a binary PSKT, stopping
+// at the first error, which is kept in err
+type binaryEncoder struct {
+	w   io.Writer
+	err error
+}
+
+func (e *binaryEncoder) putUint8(value uint8) {
+	if e.err == nil {
+		e.err = binaryserializer.PutUint8(e.w, value)
+	}
+}
+
+func (e *binaryEncoder) putUint16(value uint16) {
+	if e.err == nil {
+		e.err = binaryserializer.PutUint16(e.w, value)
+	}
+}
+
+func (e *binaryEncoder) putUint32(value uint32) {
+	if e.err == nil {
+		e.err = binaryserializer.PutUint32(e.w, value)
+	}
+}
+
+func (e *binaryEncoder) putUint64(value uint64) {
+	if e.err == nil {
+		e.err = binaryserializer.PutUint64(e.w, value)
+	}
+}
+
+func (e *binaryEncoder) putBool(value bool) {
+	if value {
+		e.putUint8(1)
+	} else {
+		e.putUint8(0)
+	}
+}
+
+func (e *binaryEncoder) putFixedBytes(value []byte) {
+	if e.err == nil {
+		_, e.err = e.w.Write(value)
+	}
+}
+
+func (e *binaryEncoder) putBytes(value []byte) {
+	e.putUint32(uint32(len(value)))
+	e.putFixedBytes(value)
+}
+
+func (e *binaryEncoder) putScriptPublicKey(scriptPublicKey *externalapi.ScriptPublicKey) {
+	e.putUint16(scriptPublicKey.Version)
+	e.putBytes(scriptPublicKey.Script)
+}
+
+// binaryDecoder reads the fields of a binary PSKT, stopping at
+// the first error, which is kept in err. Once an error occurs,
+// all reads return zero values
+type binaryDecoder struct {
+	r   *bytes.Reader
+	err error
+}
+
+func (d *binaryDecoder) uint8() uint8 {
+	if d.err != nil {
+		return 0
+	}
+	var value uint8
+	value, d.err = binaryserializer.Uint8(d.r)
+	return value
+}
+
+func (d *binaryDecoder) uint16() uint16 {
+	if d.err != nil {
+		return 0
+	}
+	var value uint16
+	value, d.err = binaryserializer.Uint16(d.r)
+	return value
+}
+
+func (d *binaryDecoder) uint32() uint32 {
+	if d.err != nil {
+		return 0
+	}
+	var value uint32
+	value, d.err = binaryserializer.Uint32(d.r)
+	return value
+}
+
+func (d *binaryDecoder) uint64() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	var value uint64
+	value, d.err = binaryserializer.Uint64(d.r)
+	return value
+}
+
+func (d *binaryDecoder) bool() bool {
+	value := d.uint8()
+	if d.err == nil && value > 1 {
+		d.err = errors.Errorf("invalid boolean value %d", value)
+	}
+	return value == 1
+}
+
+func (d *binaryDecoder) fixedBytes(destination []byte) {
+	if d.err != nil {
+		return
+	}
+	_, d.err = io.ReadFull(d.r, destination)
+}
+
+// count reads an element count, making sure that it doesn't
+// exceed the amount of remaining bytes, since every element
+// takes at least one byte
+func (d *binaryDecoder) count() uint32 {
+	count := d.uint32()
+	if d.err == nil && int64(count) > int64(d.r.Len()) {
+		d.err = errors.Errorf("element count %d exceeds the remaining size", count)
+		return 0
+	}
+	return count
+}
+
+func (d *binaryDecoder) bytes() []byte {
+	length := d.uint32()
+	if d.err != nil || length == 0 {
+		return nil
+	}
+	if length > maxVariableLengthFieldSize {
+		d.err = errors.Errorf("field of size %d exceeds the maximum of %d", length, maxVariableLengthFieldSize)
+		return nil
+	}
+	value := make([]byte, length)
+	d.fixedBytes(value)
+	return value
+}
+
+func (d *binaryDecoder) scriptPublicKey() *externalapi.ScriptPublicKey {
+	version := d.uint16()
+	script := d.bytes()
+	if script == nil {
+		script = []byte{}
+	}
+	return &externalapi.ScriptPublicKey{
+		Script:  script,
+		Version: version,
+	}
+}
@@ -0,0 +1,78 @@
+package pskt
+
+import (
+	"bytes"
+
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/pkg/errors"
+)
+
+// Combine merges the signatures of several PSKTs of the same
+// transaction, each signed by a different subset of its cosigners,
+// into a single PSKT. The given PSKTs are not modified.
+func Combine(pskts ...*PSKT) (*PSKT, error) {
+	if len(pskts) == 0 {
+		return nil, errors.Errorf("no PSKTs to combine")
+	}
+
+	combined := pskts[0].Clone()
+	err := combined.Validate()
+	if err != nil {
+		return nil, err
+	}
+	combinedTransactionID := consensushashing.TransactionID(combined.Transaction())
+
+	for i, other := range pskts[1:] {
+		err := other.Validate()
+		if err != nil {
+			return nil, errors.Wrapf(err, "PSKT #%d is invalid", i+2)
+		}
+
+		otherTransactionID := consensushashing.TransactionID(other.Transaction())
+		if !otherTransactionID.Equal(combinedTransactionID) {
+			return nil, errors.Errorf("PSKT #%d is of transaction %s while PSKT #1 is of transaction %s",
+				i+2, otherTransactionID, combinedTransactionID)
+		}
+
+		for inputIndex, input := range combined.Inputs {
+			err := combineInput(input, other.Inputs[inputIndex])
+			if err != nil {
+				return nil, errors.Wrapf(err, "PSKT #%d input %d", i+2, inputIndex)
+			}
+		}
+	}
+
+	return combined, nil
+}
+
+func combineInput(input, other *Input) error {
+	if input.UTXOEntry.Amount != other.UTXOEntry.Amount ||
+		!input.UTXOEntry.ScriptPublicKey.Equal(other.UTXOEntry.ScriptPublicKey) {
+		return errors.Errorf("the spent UTXO entries don't match")
+	}
+	if input.MinimumSignatures != other.MinimumSignatures || len(input.Signers) != len(other.Signers) {
+		return errors.Errorf("the signature requirements don't match")
+	}
+	if input.RedeemScript == nil {
+		input.RedeemScript = cloneBytes(other.RedeemScript)
+	} else if other.RedeemScript != nil && !bytes.Equal(input.RedeemScript, other.RedeemScript) {
+		return errors.Errorf("the redeem scripts don't match")
+	}
+
+	for i, signer := range input.Signers {
+		otherSigner := other.Signers[i]
+		if signer.ExtendedPublicKey != otherSigner.ExtendedPublicKey {
+			return errors.Errorf("the signers don't match")
+		}
+		if otherSigner.Signature == nil {
+			continue
+		}
+		if signer.Signature == nil {
+			signer.Signature = cloneBytes(otherSigner.Signature)
+		}
+		// Two different valid signatures by the same key are possible (e.g. when
+		// the signatures are nondeterministic), so a mismatch is not an error. The
+		// first signature is kept
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package pskt
+
+import (
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet/serialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+// FromPartiallySignedTransaction converts a partially signed transaction in
+// the kaspawallet format into a PSKT.
+//
+// Note that the kaspawallet format doesn't carry the DAA score and coinbase
+// flag of the spent UTXO entries nor redeem scripts, so these are left empty.
+func FromPartiallySignedTransaction(partiallySignedTransaction *serialization.PartiallySignedTransaction) (*PSKT, error) {
+	tx := partiallySignedTransaction.Tx
+	if len(tx.Inputs) != len(partiallySignedTransaction.PartiallySignedInputs) {
+		return nil, errors.Errorf("the transaction has %d inputs but %d partially signed inputs",
+			len(tx.Inputs), len(partiallySignedTransaction.PartiallySignedInputs))
+	}
+
+	p := &PSKT{
+		Version: Version,
+		Global: &Global{
+			TxVersion:    tx.Version,
+			LockTime:     tx.LockTime,
+			SubnetworkID: tx.SubnetworkID,
+			Gas:          tx.Gas,
+			Payload:      cloneBytes(tx.Payload),
+		},
+		Inputs:  make([]*Input, len(tx.Inputs)),
+		Outputs: make([]*Output, len(tx.Outputs)),
+	}
+	for i, input := range tx.Inputs {
+		partiallySignedInput := partiallySignedTransaction.PartiallySignedInputs[i]
+		p.Inputs[i] = &Input{
+			PreviousOutpoint: input.PreviousOutpoint,
+			Sequence:         input.Sequence,
+			SigOpCount:       input.SigOpCount,
+			UTXOEntry: &UTXOEntry{
+				Amount:          partiallySignedInput.PrevOutput.Value,
+				ScriptPublicKey: cloneScriptPublicKey(partiallySignedInput.PrevOutput.ScriptPublicKey),
+			},
+			DerivationPath:    partiallySignedInput.DerivationPath,
+			MinimumSignatures: partiallySignedInput.MinimumSignatures,
+			Signers:           make([]*Signer, len(partiallySignedInput.PubKeySignaturePairs)),
+		}
+		for j, pair := range partiallySignedInput.PubKeySignaturePairs {
+			p.Inputs[i].Signers[j] = &Signer{
+				ExtendedPublicKey: pair.ExtendedPublicKey,
+				Signature:         cloneBytes(pair.Signature),
+			}
+		}
+	}
+	for i, output := range tx.Outputs {
+		p.Outputs[i] = &Output{
+			Amount:          output.Value,
+			ScriptPublicKey: cloneScriptPublicKey(output.ScriptPublicKey),
+		}
+	}
+
+	err := p.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ToPartiallySignedTransaction converts a PSKT into a partially
+// signed transaction in the kaspawallet format
+func ToPartiallySignedTransaction(p *PSKT) (*serialization.PartiallySignedTransaction, error) {
+	err := p.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := p.Transaction()
+	partiallySignedInputs := make([]*serialization.PartiallySignedInput, len(p.Inputs))
+	for i, input := range p.Inputs {
+		partiallySignedInputs[i] = &serialization.PartiallySignedInput{
+			PrevOutput: &externalapi.DomainTransactionOutput{
+				Value:           input.UTXOEntry.Amount,
+				ScriptPublicKey: cloneScriptPublicKey(input.UTXOEntry.ScriptPublicKey),
+			},
+			MinimumSignatures:    input.MinimumSignatures,
+			PubKeySignaturePairs: make([]*serialization.PubKeySignaturePair, len(input.Signers)),
+			DerivationPath:       input.DerivationPath,
+		}
+		for j, signer := range input.Signers {
+			partiallySignedInputs[i].PubKeySignaturePairs[j] = &serialization.PubKeySignaturePair{
+				ExtendedPublicKey: signer.ExtendedPublicKey,
+				Signature:         cloneBytes(signer.Signature),
+			}
+		}
+	}
+
+	return &serialization.PartiallySignedTransaction{
+		Tx:                    tx,
+		PartiallySignedInputs: partiallySignedInputs,
+	}, nil
+}
+
+// Transaction returns the unsigned transaction described by the PSKT
+func (p *PSKT) Transaction() *externalapi.DomainTransaction {
+	inputs := make([]*externalapi.DomainTransactionInput, len(p.Inputs))
+	for i, input := range p.Inputs {
+		inputs[i] = &externalapi.DomainTransactionInput{
+			PreviousOutpoint: input.PreviousOutpoint,
+			Sequence:         input.Sequence,
+			SigOpCount:       input.SigOpCount,
+		}
+	}
+	outputs := make([]*externalapi.DomainTransactionOutput, len(p.Outputs))
+	for i, output := range p.Outputs {
+		outputs[i] = &externalapi.DomainTransactionOutput{
+			Value:           output.Amount,
+			ScriptPublicKey: cloneScriptPublicKey(output.ScriptPublicKey),
+		}
+	}
+
+	return &externalapi.DomainTransaction{
+		Version:      p.Global.TxVersion,
+		Inputs:       inputs,
+		Outputs:      outputs,
+		LockTime:     p.Global.LockTime,
+		SubnetworkID: p.Global.SubnetworkID,
+		Gas:          p.Global.Gas,
+		Payload:      cloneBytes(p.Global.Payload),
+	}
+}
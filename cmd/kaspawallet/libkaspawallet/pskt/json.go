@@ -0,0 +1,263 @@
+package pskt
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/subnetworks"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/transactionid"
+	"github.com/pkg/errors"
+)
+
+// jsonPSKT is the JSON representation of a PSKT. All byte
+// strings, including signatures and scripts, are hex encoded
+type jsonPSKT struct {
+	Version uint32        `json:"version"`
+	Global  *jsonGlobal   `json:"global"`
+	Inputs  []*jsonInput  `json:"inputs"`
+	Outputs []*jsonOutput `json:"outputs"`
+}
+
+type jsonGlobal struct {
+	TxVersion    uint16 `json:"txVersion"`
+	LockTime     uint64 `json:"lockTime"`
+	SubnetworkID string `json:"subnetworkId"`
+	Gas          uint64 `json:"gas"`
+	Payload      string `json:"payload"`
+}
+
+type jsonInput struct {
+	PreviousOutpoint  *jsonOutpoint  `json:"previousOutpoint"`
+	Sequence          uint64         `json:"sequence"`
+	SigOpCount        byte           `json:"sigOpCount"`
+	UTXOEntry         *jsonUTXOEntry `json:"utxoEntry"`
+	DerivationPath    string         `json:"derivationPath"`
+	MinimumSignatures uint32         `json:"minimumSignatures"`
+	RedeemScript      string         `json:"redeemScript,omitempty"`
+	Signers           []*jsonSigner  `json:"signers"`
+}
+
+type jsonOutpoint struct {
+	TransactionID string `json:"transactionId"`
+	Index         uint32 `json:"index"`
+}
+
+type jsonUTXOEntry struct {
+	Amount          uint64               `json:"amount"`
+	ScriptPublicKey *jsonScriptPublicKey `json:"scriptPublicKey"`
+	BlockDAAScore   uint64               `json:"blockDaaScore"`
+	IsCoinbase      bool                 `json:"isCoinbase"`
+}
+
+type jsonScriptPublicKey struct {
+	Version uint16 `json:"version"`
+	Script  string `json:"script"`
+}
+
+type jsonSigner struct {
+	ExtendedPublicKey string `json:"extendedPublicKey"`
+	Signature         string `json:"signature,omitempty"`
+}
+
+type jsonOutput struct {
+	Amount          uint64               `json:"amount"`
+	ScriptPublicKey *jsonScriptPublicKey `json:"scriptPublicKey"`
+}
+
+// EncodeJSON encodes the given PSKT into its JSON encoding
+func EncodeJSON(p *PSKT) ([]byte, error) {
+	err := p.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := &jsonPSKT{
+		Version: p.Version,
+		Global: &jsonGlobal{
+			TxVersion:    p.Global.TxVersion,
+			LockTime:     p.Global.LockTime,
+			SubnetworkID: p.Global.SubnetworkID.String(),
+			Gas:          p.Global.Gas,
+			Payload:      hex.EncodeToString(p.Global.Payload),
+		},
+		Inputs:  make([]*jsonInput, len(p.Inputs)),
+		Outputs: make([]*jsonOutput, len(p.Outputs)),
+	}
+	for i, input := range p.Inputs {
+		encoded.Inputs[i] = &jsonInput{
+			PreviousOutpoint: &jsonOutpoint{
+				TransactionID: input.PreviousOutpoint.TransactionID.String(),
+				Index:         input.PreviousOutpoint.Index,
+			},
+			Sequence:   input.Sequence,
+			SigOpCount: input.SigOpCount,
+			UTXOEntry: &jsonUTXOEntry{
+				Amount:          input.UTXOEntry.Amount,
+				ScriptPublicKey: scriptPublicKeyToJSON(input.UTXOEntry.ScriptPublicKey),
+				BlockDAAScore:   input.UTXOEntry.BlockDAAScore,
+				IsCoinbase:      input.UTXOEntry.IsCoinbase,
+			},
+			DerivationPath:    input.DerivationPath,
+			MinimumSignatures: input.MinimumSignatures,
+			RedeemScript:      hex.EncodeToString(input.RedeemScript),
+			Signers:           make([]*jsonSigner, len(input.Signers)),
+		}
+		for j, signer := range input.Signers {
+			encoded.Inputs[i].Signers[j] = &jsonSigner{
+				ExtendedPublicKey: signer.ExtendedPublicKey,
+				Signature:         hex.EncodeToString(signer.Signature),
+			}
+		}
+	}
+	for i, output := range p.Outputs {
+		encoded.Outputs[i] = &jsonOutput{
+			Amount:          output.Amount,
+			ScriptPublicKey: scriptPublicKeyToJSON(output.ScriptPublicKey),
+		}
+	}
+
+	return json.MarshalIndent(encoded, "", "  ")
+}
+
+// DecodeJSON decodes a PSKT from its JSON encoding
+func DecodeJSON(encoded []byte) (*PSKT, error) {
+	decoded := &jsonPSKT{}
+	err := json.Unmarshal(encoded, decoded)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed decoding the PSKT JSON")
+	}
+	if decoded.Version != Version {
+		return nil, errors.Errorf("unsupported PSKT version %d. Expected version: %d", decoded.Version, Version)
+	}
+	if decoded.Global == nil {
+		return nil, errors.Errorf("the PSKT is missing its global fields")
+	}
+
+	subnetworkID, err := subnetworks.FromString(decoded.Global.SubnetworkID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid subnetwork ID")
+	}
+	payload, err := hex.DecodeString(decoded.Global.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid payload")
+	}
+	p := &PSKT{
+		Version: decoded.Version,
+		Global: &Global{
+			TxVersion:    decoded.Global.TxVersion,
+			LockTime:     decoded.Global.LockTime,
+			SubnetworkID: *subnetworkID,
+			Gas:          decoded.Global.Gas,
+			Payload:      nilIfEmpty(payload),
+		},
+		Inputs:  make([]*Input, len(decoded.Inputs)),
+		Outputs: make([]*Output, len(decoded.Outputs)),
+	}
+
+	for i, input := range decoded.Inputs {
+		p.Inputs[i], err = inputFromJSON(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid input %d", i)
+		}
+	}
+	for i, output := range decoded.Outputs {
+		if output.ScriptPublicKey == nil {
+			return nil, errors.Errorf("output %d is missing its script public key", i)
+		}
+		scriptPublicKey, err := scriptPublicKeyFromJSON(output.ScriptPublicKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid output %d", i)
+		}
+		p.Outputs[i] = &Output{
+			Amount:          output.Amount,
+			ScriptPublicKey: scriptPublicKey,
+		}
+	}
+
+	err = p.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func inputFromJSON(input *jsonInput) (*Input, error) {
+	if input.PreviousOutpoint == nil {
+		return nil, errors.Errorf("missing previous outpoint")
+	}
+	if input.UTXOEntry == nil || input.UTXOEntry.ScriptPublicKey == nil {
+		return nil, errors.Errorf("missing UTXO entry")
+	}
+
+	transactionID, err := transactionid.FromString(input.PreviousOutpoint.TransactionID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid transaction ID")
+	}
+	scriptPublicKey, err := scriptPublicKeyFromJSON(input.UTXOEntry.ScriptPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	redeemScript, err := hex.DecodeString(input.RedeemScript)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid redeem script")
+	}
+
+	signers := make([]*Signer, len(input.Signers))
+	for i, signer := range input.Signers {
+		signature, err := hex.DecodeString(signer.Signature)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid signature of signer %d", i)
+		}
+		signers[i] = &Signer{
+			ExtendedPublicKey: signer.ExtendedPublicKey,
+			Signature:         nilIfEmpty(signature),
+		}
+	}
+
+	return &Input{
+		PreviousOutpoint: externalapi.DomainOutpoint{
+			TransactionID: *transactionID,
+			Index:         input.PreviousOutpoint.Index,
+		},
+		Sequence:   input.Sequence,
+		SigOpCount: input.SigOpCount,
+		UTXOEntry: &UTXOEntry{
+			Amount:          input.UTXOEntry.Amount,
+			ScriptPublicKey: scriptPublicKey,
+			BlockDAAScore:   input.UTXOEntry.BlockDAAScore,
+			IsCoinbase:      input.UTXOEntry.IsCoinbase,
+		},
+		DerivationPath:    input.DerivationPath,
+		MinimumSignatures: input.MinimumSignatures,
+		RedeemScript:      nilIfEmpty(redeemScript),
+		Signers:           signers,
+	}, nil
+}
+
+func scriptPublicKeyToJSON(scriptPublicKey *externalapi.ScriptPublicKey) *jsonScriptPublicKey {
+	return &jsonScriptPublicKey{
+		Version: scriptPublicKey.Version,
+		Script:  hex.EncodeToString(scriptPublicKey.Script),
+	}
+}
+
+func scriptPublicKeyFromJSON(scriptPublicKey *jsonScriptPublicKey) (*externalapi.ScriptPublicKey, error) {
+	script, err := hex.DecodeString(scriptPublicKey.Script)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid script public key")
+	}
+	return &externalapi.ScriptPublicKey{
+		Script:  script,
+		Version: scriptPublicKey.Version,
+	}, nil
+}
+
+// nilIfEmpty makes decoded empty byte strings nil, so that
+// decoding is consistent with the value that was encoded
+func nilIfEmpty(bytes []byte) []byte {
+	if len(bytes) == 0 {
+		return nil
+	}
+	return bytes
+}
@@ -0,0 +1,204 @@
+/*
+Package pskt implements PSKT, a documented and versioned container for partially
+signed Kaspa transactions. It carries everything a cosigner needs in order to
+verify and sign a transaction without access to a node or to the wallet that
+created it.
+
+A PSKT is made of:
+
+  - Global fields: the transaction version, lock time, subnetwork ID, gas and payload
+  - Inputs: the outpoint being spent, its sequence and sig-op count, the UTXO entry
+    it spends, the derivation path of its keys, the minimum amount of required
+    signatures, an optional redeem script (for multisig inputs), and a list of
+    signers, each with its derived extended public key and (potentially) signature
+  - Outputs: the amount and script public key of every output
+
+PSKTs have two encodings:
+
+  - JSON, where all byte strings are hex encoded. See jsonPSKT for the exact layout
+  - Binary, which starts with the magic bytes "pskt" followed by 0xff. See binary.go
+    for the exact layout
+
+Both encodings start with the PSKT version, so that future versions could be told
+apart from this one.
+*/
+package pskt
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+// Version is the current version of the PSKT format
+const Version uint32 = 0
+
+// PSKT is a partially signed Kaspa transaction
+type PSKT struct {
+	Version uint32
+	Global  *Global
+	Inputs  []*Input
+	Outputs []*Output
+}
+
+// Global contains the fields of a PSKT that are
+// relevant to the transaction as a whole
+type Global struct {
+	TxVersion    uint16
+	LockTime     uint64
+	SubnetworkID externalapi.DomainSubnetworkID
+	Gas          uint64
+	Payload      []byte
+}
+
+// Input is a PSKT transaction input along with
+// everything that is required in order to sign it
+type Input struct {
+	PreviousOutpoint  externalapi.DomainOutpoint
+	Sequence          uint64
+	SigOpCount        byte
+	UTXOEntry         *UTXOEntry
+	DerivationPath    string
+	MinimumSignatures uint32
+	RedeemScript      []byte
+	Signers           []*Signer
+}
+
+// UTXOEntry is the UTXO entry spent by a PSKT input
+type UTXOEntry struct {
+	Amount          uint64
+	ScriptPublicKey *externalapi.ScriptPublicKey
+	BlockDAAScore   uint64
+	IsCoinbase      bool
+}
+
+// Signer is a key that may sign a PSKT input, along
+// with its signature if it already signed it
+type Signer struct {
+	ExtendedPublicKey string
+	Signature         []byte
+}
+
+// Output is a PSKT transaction output
+type Output struct {
+	Amount          uint64
+	ScriptPublicKey *externalapi.ScriptPublicKey
+}
+
+// Validate checks that the PSKT is well formed
+func (p *PSKT) Validate() error {
+	if p.Version != Version {
+		return errors.Errorf("unsupported PSKT version %d. Expected version: %d", p.Version, Version)
+	}
+	if p.Global == nil {
+		return errors.Errorf("the PSKT is missing its global fields")
+	}
+	for i, input := range p.Inputs {
+		if input.UTXOEntry == nil || input.UTXOEntry.ScriptPublicKey == nil {
+			return errors.Errorf("input %d is missing its UTXO entry", i)
+		}
+		if len(input.Signers) == 0 {
+			return errors.Errorf("input %d has no signers", i)
+		}
+		if input.MinimumSignatures == 0 || input.MinimumSignatures > uint32(len(input.Signers)) {
+			return errors.Errorf("input %d requires %d signatures out of %d signers",
+				i, input.MinimumSignatures, len(input.Signers))
+		}
+	}
+	for i, output := range p.Outputs {
+		if output.ScriptPublicKey == nil {
+			return errors.Errorf("output %d is missing its script public key", i)
+		}
+	}
+	return nil
+}
+
+// IsFullySigned returns whether every input of the PSKT
+// has at least its minimum amount of signatures
+func (p *PSKT) IsFullySigned() bool {
+	for _, input := range p.Inputs {
+		if input.SignatureCount() < input.MinimumSignatures {
+			return false
+		}
+	}
+	return true
+}
+
+// SignatureCount returns the amount of signers that signed the input
+func (input *Input) SignatureCount() uint32 {
+	signatureCount := uint32(0)
+	for _, signer := range input.Signers {
+		if signer.Signature != nil {
+			signatureCount++
+		}
+	}
+	return signatureCount
+}
+
+// Clone creates a deep-clone of this PSKT
+func (p *PSKT) Clone() *PSKT {
+	clone := &PSKT{
+		Version: p.Version,
+		Inputs:  make([]*Input, len(p.Inputs)),
+		Outputs: make([]*Output, len(p.Outputs)),
+	}
+	if p.Global != nil {
+		clone.Global = &Global{
+			TxVersion:    p.Global.TxVersion,
+			LockTime:     p.Global.LockTime,
+			SubnetworkID: p.Global.SubnetworkID,
+			Gas:          p.Global.Gas,
+			Payload:      cloneBytes(p.Global.Payload),
+		}
+	}
+	for i, input := range p.Inputs {
+		clone.Inputs[i] = &Input{
+			PreviousOutpoint:  input.PreviousOutpoint,
+			Sequence:          input.Sequence,
+			SigOpCount:        input.SigOpCount,
+			DerivationPath:    input.DerivationPath,
+			MinimumSignatures: input.MinimumSignatures,
+			RedeemScript:      cloneBytes(input.RedeemScript),
+			Signers:           make([]*Signer, len(input.Signers)),
+		}
+		if input.UTXOEntry != nil {
+			clone.Inputs[i].UTXOEntry = &UTXOEntry{
+				Amount:          input.UTXOEntry.Amount,
+				ScriptPublicKey: cloneScriptPublicKey(input.UTXOEntry.ScriptPublicKey),
+				BlockDAAScore:   input.UTXOEntry.BlockDAAScore,
+				IsCoinbase:      input.UTXOEntry.IsCoinbase,
+			}
+		}
+		for j, signer := range input.Signers {
+			clone.Inputs[i].Signers[j] = &Signer{
+				ExtendedPublicKey: signer.ExtendedPublicKey,
+				Signature:         cloneBytes(signer.Signature),
+			}
+		}
+	}
+	for i, output := range p.Outputs {
+		clone.Outputs[i] = &Output{
+			Amount:          output.Amount,
+			ScriptPublicKey: cloneScriptPublicKey(output.ScriptPublicKey),
+		}
+	}
+	return clone
+}
+
+func cloneBytes(bytes []byte) []byte {
+	if bytes == nil {
+		return nil
+	}
+	clone := make([]byte, len(bytes))
+	copy(clone, bytes)
+	return clone
+}
+
+func cloneScriptPublicKey(scriptPublicKey *externalapi.ScriptPublicKey) *externalapi.ScriptPublicKey {
+	if scriptPublicKey == nil {
+		return nil
+	}
+	return &externalapi.ScriptPublicKey{
+		Script:  cloneBytes(scriptPublicKey.Script),
+		Version: scriptPublicKey.Version,
+	}
+}
@@ -0,0 +1,178 @@
+package pskt
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/subnetworks"
+)
+
+func testPSKT() *PSKT {
+	return &PSKT{
+		Version: Version,
+		Global: &Global{
+			TxVersion:    0,
+			LockTime:     5,
+			SubnetworkID: subnetworks.SubnetworkIDNative,
+			Gas:          0,
+			Payload:      []byte{1, 2, 3},
+		},
+		Inputs: []*Input{
+			{
+				PreviousOutpoint: externalapi.DomainOutpoint{
+					TransactionID: *externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+					Index:         2,
+				},
+				Sequence:   3,
+				SigOpCount: 2,
+				UTXOEntry: &UTXOEntry{
+					Amount:          1000,
+					ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{0xaa, 0x20, 0x87}, Version: 0},
+					BlockDAAScore:   7,
+					IsCoinbase:      true,
+				},
+				DerivationPath:    "m/0/1",
+				MinimumSignatures: 2,
+				RedeemScript:      []byte{0x52, 0x53, 0xae},
+				Signers: []*Signer{
+					{ExtendedPublicKey: "xpub1"},
+					{ExtendedPublicKey: "xpub2"},
+					{ExtendedPublicKey: "xpub3"},
+				},
+			},
+		},
+		Outputs: []*Output{
+			{
+				Amount:          900,
+				ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{0x20, 0xac}, Version: 0},
+			},
+		},
+	}
+}
+
+func TestEncodingRoundTrip(t *testing.T) {
+	p := testPSKT()
+	p.Inputs[0].Signers[1].Signature = []byte{4, 5, 6}
+
+	encodedJSON, err := EncodeJSON(p)
+	if err != nil {
+		t.Fatalf("EncodeJSON: %+v", err)
+	}
+	decodedJSON, err := DecodeJSON(encodedJSON)
+	if err != nil {
+		t.Fatalf("DecodeJSON: %+v", err)
+	}
+	if !reflect.DeepEqual(p, decodedJSON) {
+		t.Fatalf("The JSON round trip changed the PSKT")
+	}
+
+	encodedBinary, err := EncodeBinary(p)
+	if err != nil {
+		t.Fatalf("EncodeBinary: %+v", err)
+	}
+	if !IsBinary(encodedBinary) {
+		t.Fatalf("The binary encoding is not recognized as such")
+	}
+	decodedBinary, err := DecodeBinary(encodedBinary)
+	if err != nil {
+		t.Fatalf("DecodeBinary: %+v", err)
+	}
+	if !reflect.DeepEqual(p, decodedBinary) {
+		t.Fatalf("The binary round trip changed the PSKT")
+	}
+
+	for i := 0; i < len(encodedBinary); i++ {
+		_, err := DecodeBinary(encodedBinary[:i])
+		if err == nil {
+			t.Fatalf("Unexpectedly decoded a PSKT truncated to %d bytes", i)
+		}
+	}
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	p := testPSKT()
+	p.Inputs[0].Signers[0].Signature = []byte{4, 5, 6}
+
+	partiallySignedTransaction, err := ToPartiallySignedTransaction(p)
+	if err != nil {
+		t.Fatalf("ToPartiallySignedTransaction: %+v", err)
+	}
+	converted, err := FromPartiallySignedTransaction(partiallySignedTransaction)
+	if err != nil {
+		t.Fatalf("FromPartiallySignedTransaction: %+v", err)
+	}
+
+	// The wallet format has no place for redeem scripts, nor
+	// for the DAA score and coinbase flag of UTXO entries
+	expected := p.Clone()
+	expected.Inputs[0].RedeemScript = nil
+	expected.Inputs[0].UTXOEntry.BlockDAAScore = 0
+	expected.Inputs[0].UTXOEntry.IsCoinbase = false
+	if !reflect.DeepEqual(expected, converted) {
+		t.Fatalf("The conversion round trip changed the PSKT")
+	}
+}
+
+func TestCombine(t *testing.T) {
+	first := testPSKT()
+	first.Inputs[0].Signers[0].Signature = []byte{1}
+	second := testPSKT()
+	second.Inputs[0].Signers[2].Signature = []byte{3}
+
+	if first.IsFullySigned() || second.IsFullySigned() {
+		t.Fatalf("A PSKT with a single signature is not expected to be fully signed")
+	}
+
+	combined, err := Combine(first, second)
+	if err != nil {
+		t.Fatalf("Combine: %+v", err)
+	}
+	if !combined.IsFullySigned() {
+		t.Fatalf("The combined PSKT is expected to be fully signed")
+	}
+	if first.Inputs[0].SignatureCount() != 1 || second.Inputs[0].SignatureCount() != 1 {
+		t.Fatalf("Combine modified its arguments")
+	}
+
+	tests := []struct {
+		name          string
+		modify        func(p *PSKT)
+		expectedError string
+	}{
+		{
+			name:          "different transaction",
+			modify:        func(p *PSKT) { p.Outputs[0].Amount++ },
+			expectedError: "is of transaction",
+		},
+		{
+			name:          "different UTXO entry",
+			modify:        func(p *PSKT) { p.Inputs[0].UTXOEntry.Amount++ },
+			expectedError: "spent UTXO entries don't match",
+		},
+		{
+			name:          "different signers",
+			modify:        func(p *PSKT) { p.Inputs[0].Signers[1].ExtendedPublicKey = "xpub4" },
+			expectedError: "signers don't match",
+		},
+		{
+			name:          "different redeem script",
+			modify:        func(p *PSKT) { p.Inputs[0].RedeemScript = []byte{0x51} },
+			expectedError: "redeem scripts don't match",
+		},
+		{
+			name:          "unsupported version",
+			modify:        func(p *PSKT) { p.Version = Version + 1 },
+			expectedError: "unsupported PSKT version",
+		},
+	}
+	for _, test := range tests {
+		other := testPSKT()
+		test.modify(other)
+		_, err := Combine(first, other)
+		if err == nil || !strings.Contains(err.Error(), test.expectedError) {
+			t.Fatalf("%s: expected error containing %q but got: %+v", test.name, test.expectedError, err)
+		}
+	}
+}
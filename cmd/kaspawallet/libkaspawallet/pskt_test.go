@@ -0,0 +1,151 @@
+package libkaspawallet_test
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet/pskt"
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/testutils"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+)
+
+func TestPSKTMultisig(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		params := &consensusConfig.Params
+		forSchnorrAndECDSA(t, func(t *testing.T, ecdsa bool) {
+			consensusConfig.BlockCoinbaseMaturity = 0
+			tc, teardown, err := consensus.NewFactory().NewTestConsensus(consensusConfig, "TestPSKTMultisig")
+			if err != nil {
+				t.Fatalf("Error setting up tc: %+v", err)
+			}
+			defer teardown(false)
+
+			const numKeys = 3
+			mnemonics := make([]string, numKeys)
+			publicKeys := make([]string, numKeys)
+			for i := 0; i < numKeys; i++ {
+				var err error
+				mnemonics[i], err = libkaspawallet.CreateMnemonic()
+				if err != nil {
+					t.Fatalf("CreateMnemonic: %+v", err)
+				}
+
+				publicKeys[i], err = libkaspawallet.MasterPublicKeyFromMnemonic(&consensusConfig.Params, mnemonics[i], true)
+				if err != nil {
+					t.Fatalf("MasterPublicKeyFromMnemonic: %+v", err)
+				}
+			}
+
+			const minimumSignatures = 2
+			path := "m/1/2/3"
+			address, err := libkaspawallet.Address(params, publicKeys, minimumSignatures, path, ecdsa)
+			if err != nil {
+				t.Fatalf("Address: %+v", err)
+			}
+
+			scriptPublicKey, err := txscript.PayToAddrScript(address)
+			if err != nil {
+				t.Fatalf("PayToAddrScript: %+v", err)
+			}
+
+			coinbaseData := &externalapi.DomainCoinbaseData{
+				ScriptPublicKey: scriptPublicKey,
+				ExtraData:       nil,
+			}
+
+			fundingBlockHash, _, err := tc.AddBlock([]*externalapi.DomainHash{consensusConfig.GenesisHash}, coinbaseData, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+
+			block1Hash, _, err := tc.AddBlock([]*externalapi.DomainHash{fundingBlockHash}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+
+			block1, _, err := tc.GetBlock(block1Hash)
+			if err != nil {
+				t.Fatalf("GetBlock: %+v", err)
+			}
+
+			block1Tx := block1.Transactions[0]
+			block1TxOut := block1Tx.Outputs[0]
+			selectedUTXOs := []*libkaspawallet.UTXO{
+				{
+					Outpoint: &externalapi.DomainOutpoint{
+						TransactionID: *consensushashing.TransactionID(block1.Transactions[0]),
+						Index:         0,
+					},
+					UTXOEntry:      utxo.NewUTXOEntry(block1TxOut.Value, block1TxOut.ScriptPublicKey, true, 0),
+					DerivationPath: path,
+				},
+			}
+
+			unsignedTransaction, err := libkaspawallet.CreateUnsignedTransaction(publicKeys, minimumSignatures,
+				[]*libkaspawallet.Payment{{
+					Address: address,
+					Amount:  10,
+				}}, selectedUTXOs)
+			if err != nil {
+				t.Fatalf("CreateUnsignedTransactions: %+v", err)
+			}
+
+			// Every cosigner signs the unsigned transaction independently
+			pskts := make([]*pskt.PSKT, minimumSignatures)
+			for i := range pskts {
+				signedTransaction, err := libkaspawallet.Sign(params, mnemonics[i:i+1], unsignedTransaction, ecdsa)
+				if err != nil {
+					t.Fatalf("Sign: %+v", err)
+				}
+
+				pskts[i], err = libkaspawallet.PartiallySignedTransactionToPSKT(signedTransaction, ecdsa)
+				if err != nil {
+					t.Fatalf("PartiallySignedTransactionToPSKT: %+v", err)
+				}
+				if pskts[i].Inputs[0].RedeemScript == nil {
+					t.Fatalf("The redeem script of a multisig input is expected to be set")
+				}
+			}
+
+			_, _, err = libkaspawallet.FinalizePSKT(pskts[0], ecdsa)
+			if err == nil {
+				t.Fatalf("Unexpectedly finalized a PSKT that is not fully signed")
+			}
+
+			combined, err := pskt.Combine(pskts...)
+			if err != nil {
+				t.Fatalf("Combine: %+v", err)
+			}
+
+			finalized, tx, err := libkaspawallet.FinalizePSKT(combined, ecdsa)
+			if err != nil {
+				t.Fatalf("FinalizePSKT: %+v", err)
+			}
+
+			isFullySigned, err := libkaspawallet.IsTransactionFullySigned(finalized)
+			if err != nil {
+				t.Fatalf("IsTransactionFullySigned: %+v", err)
+			}
+			if !isFullySigned {
+				t.Fatalf("The finalized transaction is expected to be fully signed")
+			}
+
+			_, virtualChangeSet, err := tc.AddBlock([]*externalapi.DomainHash{block1Hash}, nil, []*externalapi.DomainTransaction{tx})
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+
+			addedUTXO := &externalapi.DomainOutpoint{
+				TransactionID: *consensushashing.TransactionID(tx),
+				Index:         0,
+			}
+			if !virtualChangeSet.VirtualUTXODiff.ToAdd().Contains(addedUTXO) {
+				t.Fatalf("Transaction wasn't accepted in the DAG")
+			}
+		})
+	})
+}
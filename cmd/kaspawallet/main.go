@@ -11,8 +11,12 @@ func main() {
 		err = create(config.(*createConfig))
 	case balanceSubCmd:
 		err = balance(config.(*balanceConfig))
+	case historySubCmd:
+		err = history(config.(*historyConfig))
 	case sendSubCmd:
 		err = send(config.(*sendConfig))
+	case bumpFeeSubCmd:
+		err = bumpFee(config.(*bumpFeeConfig))
 	case createUnsignedTransactionSubCmd:
 		err = createUnsignedTransaction(config.(*createUnsignedTransactionConfig))
 	case signSubCmd:
@@ -31,6 +35,14 @@ func main() {
 		err = startDaemon(config.(*startDaemonConfig))
 	case sweepSubCmd:
 		err = sweep(config.(*sweepConfig))
+	case psktConvertSubCmd:
+		err = psktConvert(config.(*psktConvertConfig))
+	case psktCombineSubCmd:
+		err = psktCombine(config.(*psktCombineConfig))
+	case psktInspectSubCmd:
+		err = psktInspect(config.(*psktInspectConfig))
+	case psktFinalizeSubCmd:
+		err = psktFinalize(config.(*psktFinalizeConfig))
 	default:
 		err = errors.Errorf("Unknown sub-command '%s'\n", subCmd)
 	}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet/pskt"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/pkg/errors"
+)
+
+const (
+	psktFormatJSON   = "json"
+	psktFormatBinary = "binary"
+	psktFormatWallet = "wallet"
+)
+
+func psktConvert(conf *psktConvertConfig) error {
+	encoded, err := readPSKTArgument(conf.Transaction, conf.TransactionFile, "--transaction", "--transaction-file")
+	if err != nil {
+		return err
+	}
+	p, err := decodePSKT(encoded, conf.ECDSA)
+	if err != nil {
+		return err
+	}
+
+	if conf.Format == psktFormatWallet {
+		partiallySignedTransaction, err := libkaspawallet.PSKTToPartiallySignedTransaction(p)
+		if err != nil {
+			return err
+		}
+		fmt.Println(encodeTransactionsToHex([][]byte{partiallySignedTransaction}))
+		return nil
+	}
+
+	return printPSKT(p, conf.Format)
+}
+
+func psktCombine(conf *psktCombineConfig) error {
+	encodedPSKTs := make([]string, 0, len(conf.PSKTs)+len(conf.PSKTFiles))
+	encodedPSKTs = append(encodedPSKTs, conf.PSKTs...)
+	for _, psktFile := range conf.PSKTFiles {
+		encoded, err := readPSKTArgument("", psktFile, "--pskt", "--pskt-file")
+		if err != nil {
+			return err
+		}
+		encodedPSKTs = append(encodedPSKTs, encoded)
+	}
+	if len(encodedPSKTs) < 2 {
+		return errors.Errorf("At least two PSKTs are required, passed using --pskt or --pskt-file")
+	}
+
+	pskts := make([]*pskt.PSKT, len(encodedPSKTs))
+	for i, encoded := range encodedPSKTs {
+		var err error
+		pskts[i], err = decodePSKT(encoded, conf.ECDSA)
+		if err != nil {
+			return errors.Wrapf(err, "Could not decode PSKT #%d", i+1)
+		}
+	}
+
+	combined, err := pskt.Combine(pskts...)
+	if err != nil {
+		return err
+	}
+
+	if combined.IsFullySigned() {
+		fmt.Fprintln(os.Stderr, "The PSKT is fully signed and ready to finalize")
+	} else {
+		fmt.Fprintln(os.Stderr, "Successfully combined PSKTs")
+	}
+	return printPSKT(combined, conf.Format)
+}
+
+func psktInspect(conf *psktInspectConfig) error {
+	encoded, err := readPSKTArgument(conf.PSKT, conf.PSKTFile, "--pskt", "--pskt-file")
+	if err != nil {
+		return err
+	}
+	p, err := decodePSKT(encoded, conf.ECDSA)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("PSKT version:\t\t%d\n", p.Version)
+	fmt.Printf("Transaction ID:\t\t%s\n", consensushashing.TransactionID(p.Transaction()))
+	fmt.Printf("Transaction version:\t%d\n", p.Global.TxVersion)
+	fmt.Printf("Lock time:\t\t%d\n", p.Global.LockTime)
+	fmt.Printf("Subnetwork ID:\t\t%s\n", p.Global.SubnetworkID)
+	fmt.Printf("Gas:\t\t\t%d\n", p.Global.Gas)
+	if len(p.Global.Payload) > 0 {
+		fmt.Printf("Payload:\t\t%s\n", hex.EncodeToString(p.Global.Payload))
+	}
+	fmt.Println()
+
+	allInputSompi := uint64(0)
+	for index, input := range p.Inputs {
+		fmt.Printf("Input %d: \tOutpoint: %s:%d \tAmount: %.2f Kaspa\n", index, input.PreviousOutpoint.TransactionID,
+			input.PreviousOutpoint.Index, float64(input.UTXOEntry.Amount)/float64(constants.SompiPerKaspa))
+		fmt.Printf("\tDerivation path: %s \tSignatures: %d of %d required\n",
+			input.DerivationPath, input.SignatureCount(), input.MinimumSignatures)
+		for _, signer := range input.Signers {
+			status := "not signed"
+			if signer.Signature != nil {
+				status = "signed"
+			}
+			fmt.Printf("\t\t%s: %s\n", signer.ExtendedPublicKey, status)
+		}
+		allInputSompi += input.UTXOEntry.Amount
+	}
+	fmt.Println()
+
+	allOutputSompi := uint64(0)
+	for index, output := range p.Outputs {
+		fmt.Printf("Output %d: \tRecipient: %s \tAmount: %.2f Kaspa\n",
+			index, scriptPublicKeyDescription(output.ScriptPublicKey, conf.ActiveNetParams),
+			float64(output.Amount)/float64(constants.SompiPerKaspa))
+		allOutputSompi += output.Amount
+	}
+	fmt.Println()
+
+	fmt.Printf("Fee:\t%d Sompi\n", allInputSompi-allOutputSompi)
+	if p.IsFullySigned() {
+		fmt.Println("The PSKT is fully signed and ready to finalize")
+	} else {
+		fmt.Println("The PSKT is not fully signed yet")
+	}
+	return nil
+}
+
+func psktFinalize(conf *psktFinalizeConfig) error {
+	encoded, err := readPSKTArgument(conf.PSKT, conf.PSKTFile, "--pskt", "--pskt-file")
+	if err != nil {
+		return err
+	}
+	p, err := decodePSKT(encoded, conf.ECDSA)
+	if err != nil {
+		return err
+	}
+
+	partiallySignedTransaction, tx, err := libkaspawallet.FinalizePSKT(p, conf.ECDSA)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Transaction %s is finalized and ready to broadcast\n", consensushashing.TransactionID(tx))
+	fmt.Println(encodeTransactionsToHex([][]byte{partiallySignedTransaction}))
+	return nil
+}
+
+func readPSKTArgument(value, file, valueFlag, fileFlag string) (string, error) {
+	if value == "" && file == "" {
+		return "", errors.Errorf("Either %s or %s is required", valueFlag, fileFlag)
+	}
+	if value != "" && file != "" {
+		return "", errors.Errorf("Both %s and %s cannot be passed at the same time", valueFlag, fileFlag)
+	}
+	if file == "" {
+		return strings.TrimSpace(value), nil
+	}
+
+	fileBytes, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", errors.Wrapf(err, "Could not read %s", file)
+	}
+	return strings.TrimSpace(string(fileBytes)), nil
+}
+
+// decodePSKT decodes a PSKT in its JSON encoding or in its hex-encoded binary
+// encoding, or a single transaction in the kaspawallet format
+func decodePSKT(encoded string, ecdsa bool) (*pskt.PSKT, error) {
+	if strings.HasPrefix(encoded, "{") {
+		return pskt.DecodeJSON([]byte(encoded))
+	}
+
+	if strings.Contains(encoded, hexTransactionsSeparator) {
+		return nil, errors.Errorf("Multiple transactions were given. Pass each of them separately")
+	}
+	decoded, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if pskt.IsBinary(decoded) {
+		return pskt.DecodeBinary(decoded)
+	}
+	return libkaspawallet.PartiallySignedTransactionToPSKT(decoded, ecdsa)
+}
+
+func printPSKT(p *pskt.PSKT, format string) error {
+	switch format {
+	case psktFormatJSON:
+		encoded, err := pskt.EncodeJSON(p)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(encoded))
+	case psktFormatBinary:
+		encoded, err := pskt.EncodeBinary(p)
+		if err != nil {
+			return err
+		}
+		fmt.Println(hex.EncodeToString(encoded))
+	default:
+		return errors.Errorf("Unknown format '%s'", format)
+	}
+	return nil
+}
+
+func scriptPublicKeyDescription(scriptPublicKey *externalapi.ScriptPublicKey, params *dagconfig.Params) string {
+	scriptPublicKeyType, scriptPublicKeyAddress, err := txscript.ExtractScriptPubKeyAddress(scriptPublicKey, params)
+	if err != nil || scriptPublicKeyType == txscript.NonStandardTy {
+		return fmt.Sprintf("<Non-standard transaction script public key: %s>", hex.EncodeToString(scriptPublicKey.Script))
+	}
+	return scriptPublicKeyAddress.EncodeAddress()
+}
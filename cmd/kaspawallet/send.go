@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/client"
@@ -38,6 +39,11 @@ func send(conf *sendConfig) error {
 		sendAmountSompi = uint64(conf.SendAmount * constants.SompiPerKaspa)
 	}
 
+	feeRate, feeRateBucket, err := parseFeeRate(conf.FeeRate)
+	if err != nil {
+		return err
+	}
+
 	createUnsignedTransactionsResponse, err :=
 		daemonClient.CreateUnsignedTransactions(ctx, &pb.CreateUnsignedTransactionsRequest{
 			From:                     conf.FromAddresses,
@@ -45,6 +51,8 @@ func send(conf *sendConfig) error {
 			Amount:                   sendAmountSompi,
 			IsSendAll:                conf.IsSendAll,
 			UseExistingChangeAddress: conf.UseExistingChangeAddress,
+			FeeRate:                  feeRate,
+			FeeRateBucket:            feeRateBucket,
 		})
 	if err != nil {
 		return err
@@ -99,3 +107,21 @@ func send(conf *sendConfig) error {
 
 	return nil
 }
+
+// parseFeeRate parses the value of the --fee-rate flag, which is either a fee rate
+// in sompi per gram of mass or the name of a fee estimate bucket
+func parseFeeRate(feeRateString string) (feeRate float64, feeRateBucket string, err error) {
+	switch feeRateString {
+	case "":
+		return 0, "", nil
+	case "priority", "normal", "low":
+		return 0, feeRateString, nil
+	}
+
+	feeRate, err = strconv.ParseFloat(feeRateString, 64)
+	if err != nil || feeRate <= 0 {
+		return 0, "", errors.Errorf("--fee-rate must be a positive number or one of priority, normal or low, "+
+			"but got %s", feeRateString)
+	}
+	return feeRate, "", nil
+}
@@ -1,7 +1,25 @@
 package main
 
-import "github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/server"
+import (
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/server"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient/grpcclient"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpctls"
+)
 
 func startDaemon(conf *startDaemonConfig) error {
-	return server.Start(conf.NetParams(), conf.Listen, conf.RPCServer, conf.KeysFile, conf.Profile, conf.Timeout)
+	rpcAuthorization, err := rpcauth.ClientAuthorization(conf.RPCUser, conf.RPCPass, conf.RPCToken)
+	if err != nil {
+		return err
+	}
+	rpcTLSConfig, err := rpctls.ClientConfig(conf.RPCTLS, conf.RPCCert, conf.RPCClientCert, conf.RPCClientKey)
+	if err != nil {
+		return err
+	}
+	rpcConnectOptions := &grpcclient.ConnectOptions{
+		Authorization: rpcAuthorization,
+		TLSConfig:     rpcTLSConfig,
+	}
+	return server.Start(conf.NetParams(), conf.Listen, conf.RPCServer, rpcConnectOptions, conf.KeysFile, conf.Profile,
+		conf.Timeout)
 }
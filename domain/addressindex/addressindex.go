@@ -0,0 +1,228 @@
+package addressindex
+
+import (
+	"sync"
+
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+)
+
+// AddressIndex maintains an index between script public keys and
+// the accepted transactions that either pay to or spend from them
+type AddressIndex struct {
+	domain domain.Domain
+	store  *addressIndexStore
+
+	mutex sync.Mutex
+}
+
+// New creates a new address index.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func New(domain domain.Domain, database database.Database) (*AddressIndex, error) {
+	addressIndex := &AddressIndex{
+		domain: domain,
+		store:  newAddressIndexStore(database),
+	}
+	isSynced, err := addressIndex.isSynced()
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSynced {
+		err := addressIndex.Reset()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addressIndex, nil
+}
+
+// Reset deletes the whole address index and resyncs it from consensus.
+func (ai *AddressIndex) Reset() error {
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	err := ai.store.deleteAll()
+	if err != nil {
+		return err
+	}
+
+	virtualInfo, err := ai.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return err
+	}
+
+	pruningPoint, err := ai.domain.Consensus().PruningPoint()
+	if err != nil {
+		return err
+	}
+
+	chainPath, err := ai.domain.Consensus().GetVirtualSelectedParentChainFromBlock(pruningPoint)
+	if err != nil {
+		return err
+	}
+
+	const chunk = 1000
+	for position := 0; position < len(chainPath.Added); position += chunk {
+		end := position + chunk
+		if end > len(chainPath.Added) {
+			end = len(chainPath.Added)
+		}
+
+		// We use chunks in order to avoid blocking consensus for too long
+		chainBlocksChunk := chainPath.Added[position:end]
+		chainBlocksAcceptanceData, err := ai.domain.Consensus().GetBlocksAcceptanceData(chainBlocksChunk)
+		if err != nil {
+			return err
+		}
+
+		for i, chainBlockHash := range chainBlocksChunk {
+			err := ai.addChainBlockAcceptanceData(chainBlockHash, chainBlocksAcceptanceData[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		err = ai.store.commitWithoutTransaction()
+		if err != nil {
+			return err
+		}
+	}
+
+	// This has to be done last to mark that the reset went smoothly and no reset has to be called next time.
+	return ai.store.updateAndCommitVirtualParentsWithoutTransaction(virtualInfo.ParentHashes)
+}
+
+func (ai *AddressIndex) isSynced() (bool, error) {
+	addressIndexVirtualParents, err := ai.store.getVirtualParents()
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	virtualInfo, err := ai.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return false, err
+	}
+
+	return externalapi.HashesEqual(virtualInfo.ParentHashes, addressIndexVirtualParents), nil
+}
+
+// Update updates the address index with the given DAG selected parent chain changes
+func (ai *AddressIndex) Update(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "AddressIndex.Update")
+	defer onEnd()
+
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	chainChanges := virtualChangeSet.VirtualSelectedParentChainChanges
+	log.Tracef("Updating address index with VirtualSelectedParentChainChanges: %+v", chainChanges)
+
+	if chainChanges != nil {
+		removedChainBlocksAcceptanceData, err := ai.domain.Consensus().GetBlocksAcceptanceData(chainChanges.Removed)
+		if err != nil {
+			return err
+		}
+		for i, removedChainBlockHash := range chainChanges.Removed {
+			err := ai.removeChainBlockAcceptanceData(removedChainBlockHash, removedChainBlocksAcceptanceData[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		addedChainBlocksAcceptanceData, err := ai.domain.Consensus().GetBlocksAcceptanceData(chainChanges.Added)
+		if err != nil {
+			return err
+		}
+		for i, addedChainBlockHash := range chainChanges.Added {
+			err := ai.addChainBlockAcceptanceData(addedChainBlockHash, addedChainBlocksAcceptanceData[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	ai.store.updateVirtualParents(virtualChangeSet.VirtualParents)
+
+	return ai.store.commit()
+}
+
+func (ai *AddressIndex) addChainBlockAcceptanceData(chainBlockHash *externalapi.DomainHash,
+	chainBlockAcceptanceData externalapi.AcceptanceData) error {
+
+	return ai.forEachAcceptedTransactionScriptPublicKey(chainBlockHash, chainBlockAcceptanceData,
+		ai.store.add)
+}
+
+func (ai *AddressIndex) removeChainBlockAcceptanceData(chainBlockHash *externalapi.DomainHash,
+	chainBlockAcceptanceData externalapi.AcceptanceData) error {
+
+	log.Tracef("Removing the acceptance data of chain block %s from the address index", chainBlockHash)
+	return ai.forEachAcceptedTransactionScriptPublicKey(chainBlockHash, chainBlockAcceptanceData,
+		ai.store.remove)
+}
+
+// forEachAcceptedTransactionScriptPublicKey calls the given function once for every
+// script public key that each transaction accepted by the given chain block either
+// pays to or spends from
+func (ai *AddressIndex) forEachAcceptedTransactionScriptPublicKey(chainBlockHash *externalapi.DomainHash,
+	chainBlockAcceptanceData externalapi.AcceptanceData,
+	function func(scriptPublicKey *externalapi.ScriptPublicKey, addressTransaction *AddressTransaction)) error {
+
+	chainBlockHeader, err := ai.domain.Consensus().GetBlockHeader(chainBlockHash)
+	if err != nil {
+		return err
+	}
+	acceptingDAAScore := chainBlockHeader.DAAScore()
+
+	for _, blockAcceptanceData := range chainBlockAcceptanceData {
+		for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+			if !transactionAcceptanceData.IsAccepted {
+				continue
+			}
+			addressTransaction := &AddressTransaction{
+				AcceptingDAAScore: acceptingDAAScore,
+				TransactionID:     *consensushashing.TransactionID(transactionAcceptanceData.Transaction),
+			}
+
+			// A transaction may pay to or spend from the same script public key more than once
+			scriptPublicKeys := make(map[scriptPublicKeyString]*externalapi.ScriptPublicKey)
+			for _, output := range transactionAcceptanceData.Transaction.Outputs {
+				scriptPublicKeys[scriptPublicKeyString(output.ScriptPublicKey.String())] = output.ScriptPublicKey
+			}
+			for _, utxoEntry := range transactionAcceptanceData.TransactionInputUTXOEntries {
+				scriptPublicKey := utxoEntry.ScriptPublicKey()
+				scriptPublicKeys[scriptPublicKeyString(scriptPublicKey.String())] = scriptPublicKey
+			}
+
+			for _, scriptPublicKey := range scriptPublicKeys {
+				function(scriptPublicKey, addressTransaction)
+			}
+		}
+	}
+	return nil
+}
+
+// AddressTransactions returns up to limit transactions that either pay to or spend from the given
+// script public key, ordered by their accepting DAA score and then by their ID. Only transactions
+// ordered after the given start are returned, so the last transaction of a page can be passed to
+// get the next page. The returned bool is true if there are more transactions after the returned ones.
+func (ai *AddressIndex) AddressTransactions(scriptPublicKey *externalapi.ScriptPublicKey,
+	start *AddressTransaction, limit int) ([]*AddressTransaction, bool, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "AddressIndex.AddressTransactions")
+	defer onEnd()
+
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	return ai.store.getAddressTransactions(scriptPublicKey, start, limit)
+}
@@ -0,0 +1,23 @@
+package addressindex
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+)
+
+// AddressTransaction is a transaction that either pays to or spends from
+// some script public key, as recorded in the address index
+type AddressTransaction struct {
+	// AcceptingDAAScore is the DAA score of the selected parent chain
+	// block that accepted the transaction
+	AcceptingDAAScore uint64
+
+	TransactionID externalapi.DomainTransactionID
+}
+
+// scriptPublicKeyString is a script public key represented as a string
+// We use this type rather than just a byte slice because Go maps don't
+// support slices as keys
+type scriptPublicKeyString string
+
+// addressTransactions is a set of the transactions of a single script public key
+type addressTransactions map[AddressTransaction]struct{}
@@ -0,0 +1,75 @@
+package addressindex
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+const daaScoreSize = 8
+
+// serializeAddressTransaction serializes the given address transaction into a database key
+// suffix. The DAA score is serialized in big-endian, so that the keys of a script public key
+// are ordered by their accepting DAA score
+func serializeAddressTransaction(addressTransaction *AddressTransaction) []byte {
+	serializedAddressTransaction := make([]byte, daaScoreSize+externalapi.DomainHashSize)
+	binary.BigEndian.PutUint64(serializedAddressTransaction[:daaScoreSize], addressTransaction.AcceptingDAAScore)
+	copy(serializedAddressTransaction[daaScoreSize:], addressTransaction.TransactionID.ByteSlice())
+	return serializedAddressTransaction
+}
+
+func deserializeAddressTransaction(serializedAddressTransaction []byte) (*AddressTransaction, error) {
+	if len(serializedAddressTransaction) != daaScoreSize+externalapi.DomainHashSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected length %d while deserializing "+
+			"address transaction", len(serializedAddressTransaction))
+	}
+
+	transactionID, err := externalapi.NewDomainTransactionIDFromByteSlice(serializedAddressTransaction[daaScoreSize:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddressTransaction{
+		AcceptingDAAScore: binary.BigEndian.Uint64(serializedAddressTransaction[:daaScoreSize]),
+		TransactionID:     *transactionID,
+	}, nil
+}
+
+const hashesLengthSize = 8
+
+func serializeHashes(hashes []*externalapi.DomainHash) []byte {
+	serializedHashes := make([]byte, hashesLengthSize+externalapi.DomainHashSize*len(hashes))
+	binary.LittleEndian.PutUint64(serializedHashes[:hashesLengthSize], uint64(len(hashes)))
+	for i, hash := range hashes {
+		start := hashesLengthSize + externalapi.DomainHashSize*i
+		end := start + externalapi.DomainHashSize
+		copy(serializedHashes[start:end], hash.ByteSlice())
+	}
+	return serializedHashes
+}
+
+func deserializeHashes(serializedHashes []byte) ([]*externalapi.DomainHash, error) {
+	if len(serializedHashes) < hashesLengthSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected EOF while deserializing hashes")
+	}
+	length := binary.LittleEndian.Uint64(serializedHashes[:hashesLengthSize])
+	hashes := make([]*externalapi.DomainHash, length)
+	for i := uint64(0); i < length; i++ {
+		start := hashesLengthSize + externalapi.DomainHashSize*i
+		end := start + externalapi.DomainHashSize
+
+		if end > uint64(len(serializedHashes)) {
+			return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected EOF while deserializing hashes")
+		}
+
+		var err error
+		hashes[i], err = externalapi.NewDomainHashFromByteSlice(serializedHashes[start:end])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return hashes, nil
+}
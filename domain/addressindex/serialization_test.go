@@ -0,0 +1,62 @@
+package addressindex
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+func randomTransactionID(r *rand.Rand) *externalapi.DomainTransactionID {
+	var transactionIDBytes [externalapi.DomainHashSize]byte
+	r.Read(transactionIDBytes[:])
+	return externalapi.NewDomainTransactionIDFromByteArray(&transactionIDBytes)
+}
+
+func Test_serializeAddressTransaction(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 32; i++ {
+		addressTransaction := &AddressTransaction{
+			AcceptingDAAScore: r.Uint64(),
+			TransactionID:     *randomTransactionID(r),
+		}
+		result, err := deserializeAddressTransaction(serializeAddressTransaction(addressTransaction))
+		if err != nil {
+			t.Fatalf("Failed deserializing address transaction: %v", err)
+		}
+		if *result != *addressTransaction {
+			t.Fatalf("Expected address transaction %+v, got %+v", addressTransaction, result)
+		}
+	}
+}
+
+func Test_serializeAddressTransactionOrder(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 32; i++ {
+		// Make sure the DAA score takes precedence over the transaction ID,
+		// including DAA scores that differ only in their high bytes
+		lower := &AddressTransaction{AcceptingDAAScore: uint64(r.Uint32()), TransactionID: *randomTransactionID(r)}
+		higher := &AddressTransaction{AcceptingDAAScore: lower.AcceptingDAAScore + 1<<(8*uint(i%8)),
+			TransactionID: *randomTransactionID(r)}
+		if bytes.Compare(serializeAddressTransaction(lower), serializeAddressTransaction(higher)) >= 0 {
+			t.Fatalf("Expected %+v to be ordered before %+v", lower, higher)
+		}
+	}
+}
+
+func Test_deserializeAddressTransactionFailure(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	serialized := serializeAddressTransaction(&AddressTransaction{
+		AcceptingDAAScore: r.Uint64(),
+		TransactionID:     *randomTransactionID(r),
+	})
+	_, err := deserializeAddressTransaction(serialized[:len(serialized)-1])
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+}
@@ -0,0 +1,255 @@
+package addressindex
+
+import (
+	"encoding/binary"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+var addressIndexBucket = database.MakeBucket([]byte("address-index"))
+var virtualParentsKey = database.MakeBucket([]byte("")).Key([]byte("address-index-virtual-parents"))
+
+type addressIndexStore struct {
+	database database.Database
+	toAdd    map[scriptPublicKeyString]addressTransactions
+	toRemove map[scriptPublicKeyString]addressTransactions
+
+	virtualParents []*externalapi.DomainHash
+}
+
+func newAddressIndexStore(database database.Database) *addressIndexStore {
+	return &addressIndexStore{
+		database: database,
+		toAdd:    make(map[scriptPublicKeyString]addressTransactions),
+		toRemove: make(map[scriptPublicKeyString]addressTransactions),
+	}
+}
+
+func (ais *addressIndexStore) add(scriptPublicKey *externalapi.ScriptPublicKey, addressTransaction *AddressTransaction) {
+	key := scriptPublicKeyString(scriptPublicKey.String())
+	log.Tracef("Adding transaction %s accepted at DAA score %d to scriptPublicKey %s",
+		addressTransaction.TransactionID, addressTransaction.AcceptingDAAScore, key)
+
+	// If the transaction exists in `toRemove` simply remove it from there and return
+	if toRemoveTransactionsOfKey, ok := ais.toRemove[key]; ok {
+		if _, ok := toRemoveTransactionsOfKey[*addressTransaction]; ok {
+			delete(toRemoveTransactionsOfKey, *addressTransaction)
+			return
+		}
+	}
+
+	if _, ok := ais.toAdd[key]; !ok {
+		ais.toAdd[key] = make(addressTransactions)
+	}
+	ais.toAdd[key][*addressTransaction] = struct{}{}
+}
+
+func (ais *addressIndexStore) remove(scriptPublicKey *externalapi.ScriptPublicKey, addressTransaction *AddressTransaction) {
+	key := scriptPublicKeyString(scriptPublicKey.String())
+	log.Tracef("Removing transaction %s accepted at DAA score %d from scriptPublicKey %s",
+		addressTransaction.TransactionID, addressTransaction.AcceptingDAAScore, key)
+
+	// If the transaction exists in `toAdd` simply remove it from there and return
+	if toAddTransactionsOfKey, ok := ais.toAdd[key]; ok {
+		if _, ok := toAddTransactionsOfKey[*addressTransaction]; ok {
+			delete(toAddTransactionsOfKey, *addressTransaction)
+			return
+		}
+	}
+
+	if _, ok := ais.toRemove[key]; !ok {
+		ais.toRemove[key] = make(addressTransactions)
+	}
+	ais.toRemove[key][*addressTransaction] = struct{}{}
+}
+
+func (ais *addressIndexStore) updateVirtualParents(virtualParents []*externalapi.DomainHash) {
+	ais.virtualParents = virtualParents
+}
+
+func (ais *addressIndexStore) discard() {
+	ais.toAdd = make(map[scriptPublicKeyString]addressTransactions)
+	ais.toRemove = make(map[scriptPublicKeyString]addressTransactions)
+	ais.virtualParents = nil
+}
+
+func (ais *addressIndexStore) commit() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "addressIndexStore.commit")
+	defer onEnd()
+
+	dbTransaction, err := ais.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	err = ais.commitStagedData(dbTransaction)
+	if err != nil {
+		return err
+	}
+
+	if ais.virtualParents != nil {
+		err = dbTransaction.Put(virtualParentsKey, serializeHashes(ais.virtualParents))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = dbTransaction.Commit()
+	if err != nil {
+		return err
+	}
+
+	ais.discard()
+	return nil
+}
+
+func (ais *addressIndexStore) commitStagedData(dataAccessor database.DataAccessor) error {
+	for key, transactions := range ais.toRemove {
+		bucket := ais.bucketForScriptPublicKey(externalapi.NewScriptPublicKeyFromString(string(key)))
+		for addressTransaction := range transactions {
+			err := dataAccessor.Delete(bucket.Key(serializeAddressTransaction(&addressTransaction)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for key, transactions := range ais.toAdd {
+		bucket := ais.bucketForScriptPublicKey(externalapi.NewScriptPublicKeyFromString(string(key)))
+		for addressTransaction := range transactions {
+			err := dataAccessor.Put(bucket.Key(serializeAddressTransaction(&addressTransaction)), []byte{})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// commitWithoutTransaction writes the staged data directly to the database. It's
+// meant to be used while resetting the index, where the virtual parents are only
+// written once all the data is in place.
+func (ais *addressIndexStore) commitWithoutTransaction() error {
+	err := ais.commitStagedData(ais.database)
+	if err != nil {
+		return err
+	}
+
+	ais.discard()
+	return nil
+}
+
+func (ais *addressIndexStore) updateAndCommitVirtualParentsWithoutTransaction(virtualParents []*externalapi.DomainHash) error {
+	return ais.database.Put(virtualParentsKey, serializeHashes(virtualParents))
+}
+
+func (ais *addressIndexStore) bucketForScriptPublicKey(scriptPublicKey *externalapi.ScriptPublicKey) *database.Bucket {
+	var scriptPublicKeyBytes = make([]byte, 2+len(scriptPublicKey.Script)) // uint16
+	binary.LittleEndian.PutUint16(scriptPublicKeyBytes[:2], scriptPublicKey.Version)
+	copy(scriptPublicKeyBytes[2:], scriptPublicKey.Script)
+	return addressIndexBucket.Bucket(scriptPublicKeyBytes)
+}
+
+func (ais *addressIndexStore) isAnythingStaged() bool {
+	return len(ais.toAdd) > 0 || len(ais.toRemove) > 0
+}
+
+// getAddressTransactions returns up to limit transactions of the given script public key that
+// are ordered after the given start, alongside whether there are any more transactions after them
+func (ais *addressIndexStore) getAddressTransactions(scriptPublicKey *externalapi.ScriptPublicKey,
+	start *AddressTransaction, limit int) ([]*AddressTransaction, bool, error) {
+
+	if ais.isAnythingStaged() {
+		return nil, false, errors.Errorf("cannot get address transactions while staging isn't empty")
+	}
+
+	bucket := ais.bucketForScriptPublicKey(scriptPublicKey)
+	cursor, err := ais.database.Cursor(bucket)
+	if err != nil {
+		return nil, false, err
+	}
+	defer cursor.Close()
+
+	// Seeking to the start skips the transactions before it without reading them. If the start was
+	// removed since it was returned, the cursor is already at the transaction that follows it.
+	serializedStart := serializeAddressTransaction(start)
+	isAtFirstEntry := false
+	err = cursor.Seek(bucket.Key(serializedStart))
+	if err != nil {
+		if !database.IsNotFoundError(err) {
+			return nil, false, err
+		}
+		_, err := cursor.Key()
+		isAtFirstEntry = err == nil
+	}
+
+	var addressTransactions []*AddressTransaction
+	for isAtFirstEntry || cursor.Next() {
+		isAtFirstEntry = false
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, false, err
+		}
+		// The bucket of a script public key is a prefix of the buckets of script public keys
+		// that begin with it followed by the bucket separator. Keys of such buckets are longer
+		// than a serialized address transaction
+		if len(key.Suffix()) != len(serializedStart) {
+			continue
+		}
+		if len(addressTransactions) == limit {
+			return addressTransactions, true, nil
+		}
+		addressTransaction, err := deserializeAddressTransaction(key.Suffix())
+		if err != nil {
+			return nil, false, err
+		}
+		addressTransactions = append(addressTransactions, addressTransaction)
+	}
+	return addressTransactions, false, nil
+}
+
+func (ais *addressIndexStore) getVirtualParents() ([]*externalapi.DomainHash, error) {
+	if ais.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get the virtual parents while staging isn't empty")
+	}
+
+	serializedHashes, err := ais.database.Get(virtualParentsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return deserializeHashes(serializedHashes)
+}
+
+func (ais *addressIndexStore) deleteAll() error {
+	// First we delete the virtual parents, so if anything goes wrong, the address index will be marked as "not synced"
+	// and will be reset.
+	err := ais.database.Delete(virtualParentsKey)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := ais.database.Cursor(addressIndexBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+
+		err = ais.database.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
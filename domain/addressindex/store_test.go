@@ -0,0 +1,97 @@
+package addressindex
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/memorydb"
+)
+
+func TestGetAddressTransactionsPaging(t *testing.T) {
+	store := newAddressIndexStore(memorydb.NewMemoryDB())
+
+	scriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}, Version: 0}
+	// The bucket of this script public key begins with the bucket of the one above
+	scriptPublicKeyWithSamePrefix := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3, '/', 4}, Version: 0}
+
+	// Two transactions share each DAA score, and are added in reverse order
+	const transactionAmount = 10
+	expectedTransactions := make([]*AddressTransaction, transactionAmount)
+	for i := transactionAmount - 1; i >= 0; i-- {
+		var transactionIDBytes [externalapi.DomainHashSize]byte
+		transactionIDBytes[0] = byte(i + 1)
+		expectedTransactions[i] = &AddressTransaction{
+			AcceptingDAAScore: uint64(1000 * (i / 2)),
+			TransactionID:     *externalapi.NewDomainTransactionIDFromByteArray(&transactionIDBytes),
+		}
+		store.add(scriptPublicKey, expectedTransactions[i])
+		store.add(scriptPublicKeyWithSamePrefix, expectedTransactions[i])
+	}
+	err := store.commit()
+	if err != nil {
+		t.Fatalf("commit: %+v", err)
+	}
+
+	const pageSize = 3
+	var transactions []*AddressTransaction
+	start := &AddressTransaction{}
+	pageCount := 0
+	for {
+		page, hasMore, err := store.getAddressTransactions(scriptPublicKey, start, pageSize)
+		if err != nil {
+			t.Fatalf("getAddressTransactions: %+v", err)
+		}
+		if len(page) == 0 || len(page) > pageSize {
+			t.Fatalf("Unexpected page size %d", len(page))
+		}
+		pageCount++
+		transactions = append(transactions, page...)
+		if !hasMore {
+			break
+		}
+		start = page[len(page)-1]
+	}
+
+	expectedPageCount := (transactionAmount + pageSize - 1) / pageSize
+	if pageCount != expectedPageCount {
+		t.Fatalf("Expected %d pages of %d transactions, got %d", expectedPageCount, pageSize, pageCount)
+	}
+	if len(transactions) != transactionAmount {
+		t.Fatalf("Expected %d transactions, got %d", transactionAmount, len(transactions))
+	}
+	for i, transaction := range transactions {
+		if *transaction != *expectedTransactions[i] {
+			t.Fatalf("Expected transaction %d to be %+v, got %+v", i, expectedTransactions[i], transaction)
+		}
+	}
+
+	// Removing a transaction should remove it from its script public key alone
+	store.remove(scriptPublicKey, expectedTransactions[0])
+	err = store.commit()
+	if err != nil {
+		t.Fatalf("commit: %+v", err)
+	}
+	transactions, _, err = store.getAddressTransactions(scriptPublicKey, &AddressTransaction{}, transactionAmount)
+	if err != nil {
+		t.Fatalf("getAddressTransactions: %+v", err)
+	}
+	if len(transactions) != transactionAmount-1 || *transactions[0] != *expectedTransactions[1] {
+		t.Fatalf("Unexpected transactions after removal: %+v", transactions)
+	}
+
+	// A page that starts at the removed transaction should start at the one that followed it
+	transactions, _, err = store.getAddressTransactions(scriptPublicKey, expectedTransactions[0], pageSize)
+	if err != nil {
+		t.Fatalf("getAddressTransactions: %+v", err)
+	}
+	if len(transactions) != pageSize || *transactions[0] != *expectedTransactions[1] {
+		t.Fatalf("Unexpected page after the removed transaction: %+v", transactions)
+	}
+	transactions, _, err = store.getAddressTransactions(scriptPublicKeyWithSamePrefix, &AddressTransaction{}, transactionAmount)
+	if err != nil {
+		t.Fatalf("getAddressTransactions: %+v", err)
+	}
+	if len(transactions) != transactionAmount {
+		t.Fatalf("Expected %d transactions, got %d", transactionAmount, len(transactions))
+	}
+}
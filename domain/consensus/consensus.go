@@ -10,6 +10,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
 	"github.com/kaspanet/kaspad/util/staging"
 	"github.com/pkg/errors"
@@ -62,6 +63,10 @@ type consensus struct {
 
 	consensusEventsChan chan externalapi.ConsensusEvent
 	virtualNotUpdated   bool
+
+	// unresolvedFinalityConflicts holds the finality blocks of the conflicting chains a
+	// FinalityConflict event was already sent for, until ResolveFinalityConflict is called
+	unresolvedFinalityConflicts []*externalapi.DomainHash
 }
 
 // In order to prevent a situation that the consensus lock is held for too much time, we
@@ -70,6 +75,10 @@ type consensus struct {
 // where UpdatePruningPointByVirtual skips a pruning point.
 const virtualResolveChunk = 100
 
+// The block data of expired blocks is deleted in chunks, where every chunk traverses at most
+// `expiredBlockDataDeletionChunk` blocks, so that the consensus lock isn't held for too much time.
+const expiredBlockDataDeletionChunk = 1000
+
 func (s *consensus) ValidateAndInsertBlockWithTrustedData(block *externalapi.BlockWithTrustedData, validateUTXO bool) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -271,6 +280,14 @@ func (s *consensus) validateAndInsertBlockNoLock(block *externalapi.DomainBlock,
 		return nil, err
 	}
 
+	// Blocks that violate finality are left pending verification
+	if updateVirtual && blockStatus == externalapi.StatusUTXOPendingVerification {
+		err = s.sendFinalityConflictEventIfRequired(consensushashing.BlockHash(block))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return virtualChangeSet, nil
 }
 
@@ -288,6 +305,62 @@ func (s *consensus) sendBlockAddedEvent(block *externalapi.DomainBlock, blockSta
 	return nil
 }
 
+// sendFinalityConflictEventIfRequired sends a FinalityConflict event if the given block opens a
+// new finality conflict. Blocks on a conflicting chain that was already reported don't raise an
+// event of their own, and are recognized without checking whether they violate finality.
+func (s *consensus) sendFinalityConflictEventIfRequired(blockHash *externalapi.DomainHash) error {
+	if s.consensusEventsChan == nil {
+		return nil
+	}
+
+	stagingArea := model.NewStagingArea()
+	for _, finalityBlockHash := range s.unresolvedFinalityConflicts {
+		isOnConflictingChain, err := s.dagTopologyManagers[0].IsInSelectedParentChainOf(
+			stagingArea, finalityBlockHash, blockHash)
+		if err != nil {
+			return err
+		}
+		if isOnConflictingChain {
+			return nil
+		}
+	}
+
+	isFinalityConflict, err := s.consensusStateManager.IsFinalityConflict(stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	if !isFinalityConflict {
+		return nil
+	}
+
+	finalityBlockHash, err := s.consensusStateManager.FinalityConflictBlock(stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	s.unresolvedFinalityConflicts = append(s.unresolvedFinalityConflicts, finalityBlockHash)
+
+	if len(s.consensusEventsChan) == cap(s.consensusEventsChan) {
+		return errors.Errorf("consensusEventsChan is full")
+	}
+	s.consensusEventsChan <- &externalapi.FinalityConflict{
+		ViolatingBlockHash: blockHash,
+		FinalityBlockHash:  finalityBlockHash,
+	}
+	return nil
+}
+
+func (s *consensus) sendFinalityConflictResolvedEvent(finalityBlockHash *externalapi.DomainHash) error {
+	if s.consensusEventsChan == nil {
+		return nil
+	}
+
+	if len(s.consensusEventsChan) == cap(s.consensusEventsChan) {
+		return errors.Errorf("consensusEventsChan is full")
+	}
+	s.consensusEventsChan <- &externalapi.FinalityConflictResolved{FinalityBlockHash: finalityBlockHash}
+	return nil
+}
+
 func (s *consensus) sendVirtualChangedEvent(virtualChangeSet *externalapi.VirtualChangeSet, wasVirtualUpdated bool) error {
 	if !wasVirtualUpdated || s.consensusEventsChan == nil || virtualChangeSet == nil {
 		return nil
@@ -357,6 +430,69 @@ func (s *consensus) ValidateTransactionAndPopulateWithConsensusData(transaction
 		stagingArea, transaction, model.VirtualBlockHash)
 }
 
+// ValidateTransactionsAndPopulateWithConsensusData validates the given transactions
+// and populates them with any missing consensus data. The input scripts of all the
+// transactions are validated in parallel. Returns an error per transaction, which is
+// the same error ValidateTransactionAndPopulateWithConsensusData would have returned for it.
+func (s *consensus) ValidateTransactionsAndPopulateWithConsensusData(transactions []*externalapi.DomainTransaction) []error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+
+	errs := make([]error, len(transactions))
+	setAllErrs := func(err error) []error {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	daaScore, err := s.daaBlocksStore.DAAScore(s.databaseContext, stagingArea, model.VirtualBlockHash)
+	if err != nil {
+		return setAllErrs(err)
+	}
+
+	virtualPastMedianTime, err := s.pastMedianTimeManager.PastMedianTime(stagingArea, model.VirtualBlockHash)
+	if err != nil {
+		return setAllErrs(err)
+	}
+
+	transactionsToValidate := make([]*externalapi.DomainTransaction, 0, len(transactions))
+	transactionsToValidateIndexes := make([]int, 0, len(transactions))
+	for i, transaction := range transactions {
+		err := s.transactionValidator.ValidateTransactionInIsolation(transaction, daaScore)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		err = s.consensusStateManager.PopulateTransactionWithUTXOEntries(stagingArea, transaction)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		err = s.transactionValidator.ValidateTransactionInContextIgnoringUTXO(
+			stagingArea, transaction, model.VirtualBlockHash, virtualPastMedianTime)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		transactionsToValidate = append(transactionsToValidate, transaction)
+		transactionsToValidateIndexes = append(transactionsToValidateIndexes, i)
+	}
+
+	inContextErrs := s.transactionValidator.ValidateTransactionsInContextAndPopulateFee(
+		stagingArea, transactionsToValidate, model.VirtualBlockHash)
+	for i, err := range inContextErrs {
+		errs[transactionsToValidateIndexes[i]] = err
+	}
+
+	return errs
+}
+
 func (s *consensus) GetBlock(blockHash *externalapi.DomainHash) (*externalapi.DomainBlock, bool, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -623,6 +759,25 @@ func (s *consensus) PruningPoint() (*externalapi.DomainHash, error) {
 	return s.pruningStore.PruningPoint(s.databaseContext, stagingArea)
 }
 
+// RetentionRoot returns the pruning point from which on block data is retained
+func (s *consensus) RetentionRoot() (*externalapi.DomainHash, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+
+	return s.pruningManager.RetentionRoot(stagingArea)
+}
+
+// DeleteExpiredBlockData deletes a chunk of the block data that is no longer
+// retained, and returns whether there's no more block data left to delete
+func (s *consensus) DeleteExpiredBlockData() (isCompletelyDeleted bool, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.pruningManager.DeleteExpiredBlockData(expiredBlockDataDeletionChunk)
+}
+
 func (s *consensus) PruningPointHeaders() ([]externalapi.BlockHeader, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -963,10 +1118,57 @@ func (s *consensus) resolveVirtualChunkNoLock(maxBlocksToResolve uint64) (*exter
 	if err != nil {
 		return nil, false, err
 	}
+
+	return s.onVirtualChunkResolved(virtualChangeSet, isCompletelyResolved)
+}
+
+// ResolveFinalityConflict resolves the virtual towards the side of a finality conflict
+// that has the given finality block in its selected chain
+func (s *consensus) ResolveFinalityConflict(finalityBlockHash *externalapi.DomainHash) error {
+	isCompletelyResolved, err := s.resolveFinalityConflictChunkWithLock(finalityBlockHash)
+	if err != nil {
+		return err
+	}
+
+	// Once the first chunk is resolved, the virtual finality point is on the
+	// chosen side, so the rest of it is resolved like any other virtual
+	for !isCompletelyResolved {
+		_, isCompletelyResolved, err = s.resolveVirtualChunkWithLock(virtualResolveChunk)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.unresolvedFinalityConflicts = nil
+	return s.sendFinalityConflictResolvedEvent(finalityBlockHash)
+}
+
+func (s *consensus) resolveFinalityConflictChunkWithLock(finalityBlockHash *externalapi.DomainHash) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	virtualChangeSet, isCompletelyResolved, err :=
+		s.consensusStateManager.ResolveFinalityConflict(finalityBlockHash, virtualResolveChunk)
+	if err != nil {
+		return false, err
+	}
+
+	_, isCompletelyResolved, err = s.onVirtualChunkResolved(virtualChangeSet, isCompletelyResolved)
+	return isCompletelyResolved, err
+}
+
+// onVirtualChunkResolved updates the pruning point and notifies about the
+// virtual change after a chunk of the virtual has been resolved
+func (s *consensus) onVirtualChunkResolved(virtualChangeSet *externalapi.VirtualChangeSet, isCompletelyResolved bool) (
+	*externalapi.VirtualChangeSet, bool, error) {
+
 	s.virtualNotUpdated = !isCompletelyResolved
 
 	stagingArea := model.NewStagingArea()
-	err = s.pruningManager.UpdatePruningPointByVirtual(stagingArea)
+	err := s.pruningManager.UpdatePruningPointByVirtual(stagingArea)
 	if err != nil {
 		return nil, false, err
 	}
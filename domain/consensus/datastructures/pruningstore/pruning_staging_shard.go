@@ -11,6 +11,7 @@ type pruningStagingShard struct {
 	pruningPointByIndex              map[uint64]*externalapi.DomainHash
 	currentPruningPointIndex         *uint64
 	newPruningPointCandidate         *externalapi.DomainHash
+	newRetentionRoot                 *externalapi.DomainHash
 	startUpdatingPruningPointUTXOSet bool
 }
 
@@ -20,6 +21,7 @@ func (ps *pruningStore) stagingShard(stagingArea *model.StagingArea) *pruningSta
 			store:                            ps,
 			pruningPointByIndex:              map[uint64]*externalapi.DomainHash{},
 			newPruningPointCandidate:         nil,
+			newRetentionRoot:                 nil,
 			startUpdatingPruningPointUTXOSet: false,
 		}
 	}).(*pruningStagingShard)
@@ -66,6 +68,18 @@ func (mss *pruningStagingShard) Commit(dbTx model.DBTransaction) error {
 		mss.store.pruningPointCandidateCache = mss.newPruningPointCandidate
 	}
 
+	if mss.newRetentionRoot != nil {
+		retentionRootBytes, err := mss.store.serializeHash(mss.newRetentionRoot)
+		if err != nil {
+			return err
+		}
+		err = dbTx.Put(mss.store.retentionRootHashKey, retentionRootBytes)
+		if err != nil {
+			return err
+		}
+		mss.store.retentionRootCache = mss.newRetentionRoot
+	}
+
 	if mss.startUpdatingPruningPointUTXOSet {
 		err := dbTx.Put(mss.store.updatingPruningPointUTXOSetKey, []byte{0})
 		if err != nil {
@@ -77,5 +91,6 @@ func (mss *pruningStagingShard) Commit(dbTx model.DBTransaction) error {
 }
 
 func (mss *pruningStagingShard) isStaged() bool {
-	return len(mss.pruningPointByIndex) > 0 || mss.newPruningPointCandidate != nil || mss.startUpdatingPruningPointUTXOSet
+	return len(mss.pruningPointByIndex) > 0 || mss.newPruningPointCandidate != nil || mss.newRetentionRoot != nil ||
+		mss.startUpdatingPruningPointUTXOSet
 }
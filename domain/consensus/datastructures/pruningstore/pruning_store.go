@@ -15,6 +15,7 @@ import (
 
 var currentPruningPointIndexKeyName = []byte("pruning-block-index")
 var candidatePruningPointHashKeyName = []byte("candidate-pruning-point-hash")
+var retentionRootHashKeyName = []byte("retention-root-hash")
 var pruningPointUTXOSetBucketName = []byte("pruning-point-utxo-set")
 var updatingPruningPointUTXOSetKeyName = []byte("updating-pruning-point-utxo-set")
 var pruningPointByIndexBucketName = []byte("pruning-point-by-index")
@@ -25,9 +26,11 @@ type pruningStore struct {
 	pruningPointByIndexCache      *lrucacheuint64tohash.LRUCache
 	currentPruningPointIndexCache *uint64
 	pruningPointCandidateCache    *externalapi.DomainHash
+	retentionRootCache            *externalapi.DomainHash
 
 	currentPruningPointIndexKey     model.DBKey
 	candidatePruningPointHashKey    model.DBKey
+	retentionRootHashKey            model.DBKey
 	pruningPointUTXOSetBucket       model.DBBucket
 	updatingPruningPointUTXOSetKey  model.DBKey
 	importedPruningPointUTXOsBucket model.DBBucket
@@ -42,6 +45,7 @@ func New(prefixBucket model.DBBucket, cacheSize int, preallocate bool) model.Pru
 		pruningPointByIndexCache:        lrucacheuint64tohash.New(cacheSize, preallocate),
 		currentPruningPointIndexKey:     prefixBucket.Key(currentPruningPointIndexKeyName),
 		candidatePruningPointHashKey:    prefixBucket.Key(candidatePruningPointHashKeyName),
+		retentionRootHashKey:            prefixBucket.Key(retentionRootHashKeyName),
 		pruningPointUTXOSetBucket:       prefixBucket.Bucket(pruningPointUTXOSetBucketName),
 		importedPruningPointUTXOsBucket: prefixBucket.Bucket(importedPruningPointUTXOsBucketName),
 		updatingPruningPointUTXOSetKey:  prefixBucket.Key(updatingPruningPointUTXOSetKeyName),
@@ -94,6 +98,50 @@ func (ps *pruningStore) HasPruningPointCandidate(dbContext model.DBReader, stagi
 	return dbContext.Has(ps.candidatePruningPointHashKey)
 }
 
+func (ps *pruningStore) StageRetentionRoot(stagingArea *model.StagingArea, retentionRoot *externalapi.DomainHash) {
+	stagingShard := ps.stagingShard(stagingArea)
+
+	stagingShard.newRetentionRoot = retentionRoot
+}
+
+func (ps *pruningStore) RetentionRoot(dbContext model.DBReader, stagingArea *model.StagingArea) (*externalapi.DomainHash, error) {
+	stagingShard := ps.stagingShard(stagingArea)
+
+	if stagingShard.newRetentionRoot != nil {
+		return stagingShard.newRetentionRoot, nil
+	}
+
+	if ps.retentionRootCache != nil {
+		return ps.retentionRootCache, nil
+	}
+
+	retentionRootBytes, err := dbContext.Get(ps.retentionRootHashKey)
+	if err != nil {
+		return nil, err
+	}
+
+	retentionRoot, err := ps.deserializePruningPoint(retentionRootBytes)
+	if err != nil {
+		return nil, err
+	}
+	ps.retentionRootCache = retentionRoot
+	return retentionRoot, nil
+}
+
+func (ps *pruningStore) HasRetentionRoot(dbContext model.DBReader, stagingArea *model.StagingArea) (bool, error) {
+	stagingShard := ps.stagingShard(stagingArea)
+
+	if stagingShard.newRetentionRoot != nil {
+		return true, nil
+	}
+
+	if ps.retentionRootCache != nil {
+		return true, nil
+	}
+
+	return dbContext.Has(ps.retentionRootHashKey)
+}
+
 // StagePruningPoint stages the pruning state
 func (ps *pruningStore) StagePruningPoint(dbContext model.DBWriter, stagingArea *model.StagingArea, pruningPointBlockHash *externalapi.DomainHash) error {
 	newPruningPointIndex := uint64(0)
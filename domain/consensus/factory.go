@@ -1,7 +1,6 @@
 package consensus
 
 import (
-	"io/ioutil"
 	"os"
 	"sync"
 
@@ -56,6 +55,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/dagconfig"
 	infrastructuredatabase "github.com/kaspanet/kaspad/infrastructure/db/database"
 	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/memorydb"
 )
 
 const (
@@ -69,10 +69,20 @@ type Config struct {
 	dagconfig.Params
 	// IsArchival tells the consensus if it should not prune old blocks
 	IsArchival bool
+	// RetentionPruningPeriods tells the consensus to keep the block data of the given
+	// amount of the latest pruning periods, instead of deleting it when the pruning point moves
+	RetentionPruningPeriods uint64
+	// RetentionDAAScoreWindow tells the consensus to keep the block data of at least the
+	// given DAA score window below the virtual, instead of deleting it when the pruning point moves
+	RetentionDAAScoreWindow uint64
 	// EnableSanityCheckPruningUTXOSet checks the full pruning point utxo set against the commitment at every pruning movement
 	EnableSanityCheckPruningUTXOSet bool
 
 	SkipAddingGenesis bool
+	// SkipInit makes the consensus use the database as is, without initializing or
+	// recovering any of its data. It's meant for offline tools that must not modify
+	// the database, and the resulting consensus should only be used for reading.
+	SkipInit bool
 }
 
 // Factory instantiates new Consensuses
@@ -331,6 +341,8 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		daaWindowStore,
 
 		config.IsArchival,
+		config.RetentionPruningPeriods,
+		config.RetentionDAAScoreWindow,
 		genesisHash,
 		config.FinalityDepth(),
 		config.PruningDepth(),
@@ -526,6 +538,10 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		return c, true, nil
 	}
 
+	if config.SkipInit {
+		return c, false, nil
+	}
+
 	err = c.Init(config.SkipAddingGenesis)
 	if err != nil {
 		return nil, false, err
@@ -567,25 +583,28 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 
 func (f *factory) NewTestConsensus(config *Config, testName string) (
 	tc testapi.TestConsensus, teardown func(keepDataDir bool), err error) {
-	datadir := f.dataDir
-	if datadir == "" {
-		datadir, err = ioutil.TempDir("", testName)
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-	var cacheSizeMiB int
-	if f.cacheSizeMiB != nil {
-		cacheSizeMiB = *f.cacheSizeMiB
-	} else {
-		cacheSizeMiB = defaultTestLeveldbCacheSizeMiB
-	}
+
 	if f.preallocateCaches == nil {
 		f.SetTestPreAllocateCache(defaultTestPreallocateCaches)
 	}
-	db, err := ldb.NewLevelDB(datadir, cacheSizeMiB)
-	if err != nil {
-		return nil, nil, err
+
+	// Test consensuses that weren't given a data directory
+	// have nothing to keep on disk, so they use an in-memory
+	// database
+	var db infrastructuredatabase.Database
+	if f.dataDir == "" {
+		db = memorydb.NewMemoryDB()
+	} else {
+		var cacheSizeMiB int
+		if f.cacheSizeMiB != nil {
+			cacheSizeMiB = *f.cacheSizeMiB
+		} else {
+			cacheSizeMiB = defaultTestLeveldbCacheSizeMiB
+		}
+		db, err = ldb.NewLevelDB(f.dataDir, cacheSizeMiB)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	testConsensusDBPrefix := &prefix.Prefix{}
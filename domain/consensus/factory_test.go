@@ -34,3 +34,52 @@ func TestNewConsensus(t *testing.T) {
 		t.Fatalf("A fresh consensus should never return shouldMigrate=true")
 	}
 }
+
+func TestNewConsensusWithSkipInit(t *testing.T) {
+	f := NewFactory()
+
+	config := &Config{Params: dagconfig.DevnetParams}
+
+	tmpDir, err := ioutil.TempDir("", "TestNewConsensusWithSkipInit")
+	if err != nil {
+		return
+	}
+
+	db, err := ldb.NewLevelDB(tmpDir, 8)
+	if err != nil {
+		t.Fatalf("error in NewLevelDB: %s", err)
+	}
+	_, _, err = f.NewConsensus(config, db, &prefix.Prefix{}, nil)
+	if err != nil {
+		t.Fatalf("error in NewConsensus: %+v", err)
+	}
+	err = db.Close()
+	if err != nil {
+		t.Fatalf("error in Close: %s", err)
+	}
+
+	// A consensus that skips Init should be usable for verification on top of a read-only database
+	readOnlyDB, err := ldb.NewReadOnlyLevelDB(tmpDir, 8)
+	if err != nil {
+		t.Fatalf("error in NewReadOnlyLevelDB: %s", err)
+	}
+	defer readOnlyDB.Close()
+
+	config.SkipInit = true
+	consensusInstance, shouldMigrate, err := f.NewConsensus(config, readOnlyDB, &prefix.Prefix{}, nil)
+	if err != nil {
+		t.Fatalf("error in NewConsensus: %+v", err)
+	}
+	if shouldMigrate {
+		t.Fatalf("A fresh consensus should never return shouldMigrate=true")
+	}
+
+	report, err := consensusInstance.VerifyDatabase(false)
+	if err != nil {
+		t.Fatalf("error in VerifyDatabase: %+v", err)
+	}
+	if len(report.Issues) != 0 {
+		t.Fatalf("Expected no issues in a fresh database, got %d. First issue: %s",
+			len(report.Issues), report.Issues[0].Description)
+	}
+}
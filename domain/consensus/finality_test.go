@@ -613,3 +613,125 @@ func TestFinalityResolveVirtual(t *testing.T) {
 		}
 	})
 }
+
+func TestResolveFinalityConflict(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		// Set finalityInterval to 20 blocks, so that test runs quickly
+		consensusConfig.FinalityDuration = 20 * consensusConfig.TargetTimePerBlock
+
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestResolveFinalityConflict")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		// Build a main chain until the virtual finality point is no longer genesis
+		mainChain := []*externalapi.DomainHash{consensusConfig.GenesisHash}
+		for {
+			tip, _, err := tc.AddBlock([]*externalapi.DomainHash{mainChain[len(mainChain)-1]}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			mainChain = append(mainChain, tip)
+
+			virtualFinalityPoint, err := tc.FinalityManager().VirtualFinalityPoint(model.NewStagingArea())
+			if err != nil {
+				t.Fatalf("VirtualFinalityPoint: %+v", err)
+			}
+			if !virtualFinalityPoint.Equal(consensusConfig.GenesisHash) {
+				break
+			}
+		}
+
+		// Build a side chain that is heavier than the main chain, and therefore violates finality
+		sideChain := []*externalapi.DomainHash{consensusConfig.GenesisHash}
+		for len(sideChain) <= len(mainChain) {
+			tip, _, err := tc.AddBlock([]*externalapi.DomainHash{sideChain[len(sideChain)-1]},
+				&externalapi.DomainCoinbaseData{
+					ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{1}, Version: 0},
+					ExtraData:       []byte("side chain"),
+				}, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			sideChain = append(sideChain, tip)
+		}
+		sideChainTip := sideChain[len(sideChain)-1]
+		mainChainTip := mainChain[len(mainChain)-1]
+
+		isFinalityConflict, err := tc.ConsensusStateManager().IsFinalityConflict(model.NewStagingArea(), sideChainTip)
+		if err != nil {
+			t.Fatalf("IsFinalityConflict: %+v", err)
+		}
+		if !isFinalityConflict {
+			t.Fatalf("Expected side chain tip %s to be a finality conflict", sideChainTip)
+		}
+		isFinalityConflict, err = tc.ConsensusStateManager().IsFinalityConflict(model.NewStagingArea(), mainChainTip)
+		if err != nil {
+			t.Fatalf("IsFinalityConflict: %+v", err)
+		}
+		if isFinalityConflict {
+			t.Fatalf("Expected main chain tip %s not to be a finality conflict", mainChainTip)
+		}
+
+		finalityConflictBlock, err := tc.ConsensusStateManager().FinalityConflictBlock(model.NewStagingArea(), sideChainTip)
+		if err != nil {
+			t.Fatalf("FinalityConflictBlock: %+v", err)
+		}
+		if !finalityConflictBlock.Equal(sideChain[1]) {
+			t.Fatalf("Expected the finality conflict block to be %s, got %s", sideChain[1], finalityConflictBlock)
+		}
+
+		expectVirtualSelectedParent := func(expected *externalapi.DomainHash) {
+			virtualSelectedParent, err := tc.GetVirtualSelectedParent()
+			if err != nil {
+				t.Fatalf("GetVirtualSelectedParent: %+v", err)
+			}
+			if !virtualSelectedParent.Equal(expected) {
+				t.Fatalf("Expected virtual selected parent %s, got %s", expected, virtualSelectedParent)
+			}
+		}
+
+		// Resolving in favor of the main chain keeps the current virtual
+		err = tc.ResolveFinalityConflict(mainChain[1])
+		if err != nil {
+			t.Fatalf("ResolveFinalityConflict: %+v", err)
+		}
+		expectVirtualSelectedParent(mainChainTip)
+
+		err = tc.ResolveFinalityConflict(externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1}))
+		if !errors.Is(err, ruleerrors.ErrUnresolvableFinalityConflict) {
+			t.Fatalf("Expected ErrUnresolvableFinalityConflict for a block that doesn't exist, got %v", err)
+		}
+
+		// Resolving in favor of the side chain moves the virtual to it
+		err = tc.ResolveFinalityConflict(sideChain[1])
+		if err != nil {
+			t.Fatalf("ResolveFinalityConflict: %+v", err)
+		}
+		expectVirtualSelectedParent(sideChainTip)
+		blockInfo, err := tc.GetBlockInfo(sideChainTip)
+		if err != nil {
+			t.Fatalf("GetBlockInfo: %+v", err)
+		}
+		if blockInfo.BlockStatus != externalapi.StatusUTXOValid {
+			t.Fatalf("Expected side chain tip to have status '%s', but got '%s'",
+				externalapi.StatusUTXOValid, blockInfo.BlockStatus)
+		}
+
+		// The main chain is now the lighter side of the conflict, so it can't be chosen
+		err = tc.ResolveFinalityConflict(mainChain[1])
+		if !errors.Is(err, ruleerrors.ErrUnresolvableFinalityConflict) {
+			t.Fatalf("Expected ErrUnresolvableFinalityConflict for the lighter side, got %v", err)
+		}
+		expectVirtualSelectedParent(sideChainTip)
+
+		// New blocks are expected to be added on top of the side chain
+		newTip, _, err := tc.AddBlock([]*externalapi.DomainHash{sideChainTip}, nil, nil)
+		if err != nil {
+			t.Fatalf("AddBlock: %+v", err)
+		}
+		expectVirtualSelectedParent(newTip)
+	})
+}
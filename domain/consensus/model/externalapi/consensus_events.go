@@ -23,6 +23,25 @@ type VirtualChangeSet struct {
 
 func (*VirtualChangeSet) isConsensusEvent() {}
 
+// FinalityConflict is an event raised by consensus when a block that would
+// have become the virtual selected parent violates finality. It's raised once
+// per conflicting chain, which is identified by FinalityBlockHash - the block
+// that has to be passed to ResolveFinalityConflict in order to switch to it.
+type FinalityConflict struct {
+	ViolatingBlockHash *DomainHash
+	FinalityBlockHash  *DomainHash
+}
+
+func (*FinalityConflict) isConsensusEvent() {}
+
+// FinalityConflictResolved is an event raised by consensus when a finality
+// conflict was resolved in favor of the side of the given finality block
+type FinalityConflictResolved struct {
+	FinalityBlockHash *DomainHash
+}
+
+func (*FinalityConflictResolved) isConsensusEvent() {}
+
 // SelectedChainPath is a path the of the selected chains between two blocks.
 type SelectedChainPath struct {
 	Added   []*DomainHash
@@ -0,0 +1,47 @@
+package externalapi
+
+// DatabaseVerificationCheck is the name of one of the checks done when verifying the consensus database
+type DatabaseVerificationCheck string
+
+// The checks done when verifying the consensus database
+const (
+	DatabaseVerificationCheckBlockStatus    DatabaseVerificationCheck = "blockStatus"
+	DatabaseVerificationCheckGHOSTDAG       DatabaseVerificationCheck = "ghostdag"
+	DatabaseVerificationCheckReachability   DatabaseVerificationCheck = "reachability"
+	DatabaseVerificationCheckUTXODiff       DatabaseVerificationCheck = "utxoDiff"
+	DatabaseVerificationCheckUTXOCommitment DatabaseVerificationCheck = "utxoCommitment"
+
+	// DatabaseVerificationCheckGHOSTDAGDerivedData is reported for blocks whose GHOSTDAG data was
+	// repaired, but whose data that was derived from it (such as the reachability data, UTXO diff
+	// and multiset) wasn't, since only GHOSTDAG data can be repaired
+	DatabaseVerificationCheckGHOSTDAGDerivedData DatabaseVerificationCheck = "ghostdagDerivedData"
+)
+
+// DatabaseVerificationIssue is an inconsistency that was found in the consensus database
+type DatabaseVerificationIssue struct {
+	Check DatabaseVerificationCheck
+	// BlockHash is nil for issues that aren't related to a specific block
+	BlockHash   *DomainHash
+	Description string
+	IsRepaired  bool
+}
+
+// DatabaseVerificationReport is the result of verifying the consensus database
+type DatabaseVerificationReport struct {
+	VerifiedBlockCount uint64
+	// UnverifiableGHOSTDAGCount is the amount of blocks whose GHOSTDAG data couldn't be
+	// recomputed, because some of the data it depends on was pruned
+	UnverifiableGHOSTDAGCount uint64
+	Issues                    []*DatabaseVerificationIssue
+}
+
+// UnrepairedIssueCount returns the amount of issues in the report that weren't repaired
+func (report *DatabaseVerificationReport) UnrepairedIssueCount() int {
+	unrepairedIssueCount := 0
+	for _, issue := range report.Issues {
+		if !issue.IsRepaired {
+			unrepairedIssueCount++
+		}
+	}
+	return unrepairedIssueCount
+}
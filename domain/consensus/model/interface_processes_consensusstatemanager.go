@@ -14,4 +14,7 @@ type ConsensusStateManager interface {
 	RecoverUTXOIfRequired() error
 	ReverseUTXODiffs(tipHash *externalapi.DomainHash, reversalData *UTXODiffReversalData) error
 	ResolveVirtual(maxBlocksToResolve uint64) (*externalapi.VirtualChangeSet, bool, error)
+	IsFinalityConflict(stagingArea *StagingArea, blockHash *externalapi.DomainHash) (bool, error)
+	FinalityConflictBlock(stagingArea *StagingArea, violatingBlockHash *externalapi.DomainHash) (*externalapi.DomainHash, error)
+	ResolveFinalityConflict(finalityBlockHash *externalapi.DomainHash, maxBlocksToResolve uint64) (*externalapi.VirtualChangeSet, bool, error)
 }
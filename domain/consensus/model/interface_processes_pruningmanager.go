@@ -14,5 +14,7 @@ type PruningManager interface {
 	PruneAllBlocksBelow(stagingArea *StagingArea, pruningPointHash *externalapi.DomainHash) error
 	PruningPointAndItsAnticone() ([]*externalapi.DomainHash, error)
 	ExpectedHeaderPruningPoint(stagingArea *StagingArea, blockHash *externalapi.DomainHash) (*externalapi.DomainHash, error)
+	RetentionRoot(stagingArea *StagingArea) (*externalapi.DomainHash, error)
+	DeleteExpiredBlockData(maxBlocksToTraverse uint64) (isCompletelyDeleted bool, err error)
 	TrustedBlockAssociatedGHOSTDAGDataBlockHashes(stagingArea *StagingArea, blockHash *externalapi.DomainHash) ([]*externalapi.DomainHash, error)
 }
@@ -12,5 +12,7 @@ type TransactionValidator interface {
 		povBlockHash *externalapi.DomainHash, povBlockPastMedianTime int64) error
 	ValidateTransactionInContextAndPopulateFee(stagingArea *StagingArea,
 		tx *externalapi.DomainTransaction, povBlockHash *externalapi.DomainHash) error
+	ValidateTransactionsInContextAndPopulateFee(stagingArea *StagingArea,
+		txs []*externalapi.DomainTransaction, povBlockHash *externalapi.DomainHash) []error
 	PopulateMass(transaction *externalapi.DomainTransaction)
 }
@@ -10,4 +10,5 @@ type TestTransactionValidator interface {
 	model.TransactionValidator
 	SigCache() *txscript.SigCache
 	SetSigCache(sigCache *txscript.SigCache)
+	SetScriptValidationWorkerCount(scriptValidationWorkerCount int)
 }
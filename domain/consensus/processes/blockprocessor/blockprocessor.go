@@ -148,6 +148,7 @@ func (bp *blockProcessor) ValidateAndInsertBlock(block *externalapi.DomainBlock,
 	shouldValidateAgainstUTXO bool) (*externalapi.VirtualChangeSet, externalapi.BlockStatus, error) {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "ValidateAndInsertBlock")
 	defer onEnd()
+	defer blockProcessingDuration.ObserveDuration(time.Now())
 
 	stagingArea := model.NewStagingArea()
 	return bp.validateAndInsertBlock(stagingArea, block, false, shouldValidateAgainstUTXO, false)
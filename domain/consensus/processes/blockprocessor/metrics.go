@@ -0,0 +1,8 @@
+package blockprocessor
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
+)
+
+var blockProcessingDuration = metrics.NewHistogram("kaspad_block_processing_duration_seconds",
+	"The time it takes to validate and insert a block into the DAG", metrics.DefaultLatencyBuckets)
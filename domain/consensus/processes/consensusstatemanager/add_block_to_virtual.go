@@ -36,7 +36,7 @@ func (csm *consensusStateManager) AddBlock(stagingArea *model.StagingArea, block
 			}
 
 			if shouldNotify {
-				//TODO: Send finality conflict notification
+				// The finality conflict event itself is sent by consensus once the block is inserted
 				log.Warnf("Finality Violation Detected! Block %s violates finality!", blockHash)
 			}
 
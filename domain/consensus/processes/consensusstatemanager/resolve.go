@@ -109,6 +109,14 @@ func (csm *consensusStateManager) ResolveVirtual(maxBlocksToResolve uint64) (*ex
 		return nil, true, nil
 	}
 
+	return csm.resolveVirtualTowards(readStagingArea, pendingTip, pendingTipStatus, maxBlocksToResolve)
+}
+
+// resolveVirtualTowards resolves a chunk of at most maxBlocksToResolve blocks from the selected chain
+// of pendingTip, and updates the virtual so that the last resolved block becomes its selected parent
+func (csm *consensusStateManager) resolveVirtualTowards(readStagingArea *model.StagingArea, pendingTip *externalapi.DomainHash,
+	pendingTipStatus externalapi.BlockStatus, maxBlocksToResolve uint64) (*externalapi.VirtualChangeSet, bool, error) {
+
 	previousVirtualSelectedParent, err := csm.virtualSelectedParent(readStagingArea)
 	if err != nil {
 		return nil, false, err
@@ -0,0 +1,208 @@
+package consensusstatemanager
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+// IsFinalityConflict returns whether the given block would have become the next
+// virtual selected parent if it didn't violate finality. The virtual is never
+// resolved towards such a block on its own, so it has to be resolved manually
+// by calling ResolveFinalityConflict.
+func (csm *consensusStateManager) IsFinalityConflict(stagingArea *model.StagingArea,
+	blockHash *externalapi.DomainHash) (bool, error) {
+
+	isCandidateToBeNextVirtualSelectedParent, err := csm.isCandidateToBeNextVirtualSelectedParent(stagingArea, blockHash)
+	if err != nil {
+		return false, err
+	}
+	if !isCandidateToBeNextVirtualSelectedParent {
+		return false, nil
+	}
+
+	_, shouldNotify, err := csm.isViolatingFinality(stagingArea, blockHash)
+	if err != nil {
+		return false, err
+	}
+	return shouldNotify, nil
+}
+
+// FinalityConflictBlock returns the lowest block in the selected chain of the given finality conflict block
+// that isn't in the virtual selected parent chain. This is the block that is passed to ResolveFinalityConflict
+// in order to switch to the side of the conflict, and it identifies the conflicting chain as a whole.
+func (csm *consensusStateManager) FinalityConflictBlock(stagingArea *model.StagingArea,
+	violatingBlockHash *externalapi.DomainHash) (*externalapi.DomainHash, error) {
+
+	virtualSelectedParent, err := csm.virtualSelectedParent(stagingArea)
+	if err != nil {
+		return nil, err
+	}
+
+	current := violatingBlockHash
+	for {
+		ghostdagData, err := csm.ghostdagDataStore.Get(csm.databaseContext, stagingArea, current, false)
+		if err != nil {
+			return nil, err
+		}
+		selectedParent := ghostdagData.SelectedParent()
+		if selectedParent == nil {
+			return nil, errors.Errorf("block %s has no selected chain block in the virtual selected parent chain",
+				violatingBlockHash)
+		}
+		isInVirtualSelectedParentChain, err := csm.dagTopologyManager.IsInSelectedParentChainOf(
+			stagingArea, selectedParent, virtualSelectedParent)
+		if err != nil {
+			return nil, err
+		}
+		if isInVirtualSelectedParentChain {
+			return current, nil
+		}
+		current = selectedParent
+	}
+}
+
+// ResolveFinalityConflict resolves a chunk of the virtual towards the side of a finality
+// conflict that has finalityBlockHash in its selected chain, even though this side violates
+// the finality of the current virtual.
+// Once the first chunk is resolved, the finality point of the virtual is on the chosen side,
+// so the rest of it is resolved by ResolveVirtual.
+// Nothing is resolved if finalityBlockHash is already in the virtual selected parent chain,
+// since that means the current side of the conflict is kept.
+func (csm *consensusStateManager) ResolveFinalityConflict(finalityBlockHash *externalapi.DomainHash,
+	maxBlocksToResolve uint64) (*externalapi.VirtualChangeSet, bool, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "csm.ResolveFinalityConflict")
+	defer onEnd()
+
+	readStagingArea := model.NewStagingArea()
+
+	err := csm.validateFinalityBlock(readStagingArea, finalityBlockHash)
+	if err != nil {
+		return nil, false, err
+	}
+
+	virtualSelectedParent, err := csm.virtualSelectedParent(readStagingArea)
+	if err != nil {
+		return nil, false, err
+	}
+	isInVirtualSelectedParentChain, err := csm.dagTopologyManager.IsInSelectedParentChainOf(
+		readStagingArea, finalityBlockHash, virtualSelectedParent)
+	if err != nil {
+		return nil, false, err
+	}
+	if isInVirtualSelectedParentChain {
+		log.Infof("Finality block %s is in the virtual selected parent chain. Keeping the current virtual",
+			finalityBlockHash)
+		return nil, true, nil
+	}
+
+	pendingTip, pendingTipStatus, err := csm.findPendingTipWithChainBlock(readStagingArea, finalityBlockHash)
+	if err != nil {
+		return nil, false, err
+	}
+
+	isViolatingFinality, _, err := csm.isViolatingFinality(readStagingArea, pendingTip)
+	if err != nil {
+		return nil, false, err
+	}
+	if !isViolatingFinality {
+		return nil, false, errors.Wrapf(ruleerrors.ErrUnresolvableFinalityConflict, "tip %s, which has "+
+			"finality block %s in its selected chain, doesn't violate finality", pendingTip, finalityBlockHash)
+	}
+
+	isHeavierThanVirtualSelectedParent, err := csm.isNewSelectedTip(readStagingArea, pendingTip, virtualSelectedParent)
+	if err != nil {
+		return nil, false, err
+	}
+	if !isHeavierThanVirtualSelectedParent {
+		return nil, false, errors.Wrapf(ruleerrors.ErrUnresolvableFinalityConflict, "tip %s, which has "+
+			"finality block %s in its selected chain, is lighter than the virtual selected parent %s",
+			pendingTip, finalityBlockHash, virtualSelectedParent)
+	}
+
+	pendingTipFinalityPoint, err := csm.finalityManager.FinalityPoint(readStagingArea, pendingTip, false)
+	if err != nil {
+		return nil, false, err
+	}
+	log.Infof("Resolving the finality conflict towards tip %s, whose finality point is %s",
+		pendingTip, pendingTipFinalityPoint)
+
+	return csm.resolveVirtualTowards(readStagingArea, pendingTip, pendingTipStatus, maxBlocksToResolve)
+}
+
+func (csm *consensusStateManager) validateFinalityBlock(stagingArea *model.StagingArea,
+	finalityBlockHash *externalapi.DomainHash) error {
+
+	exists, err := csm.blockStatusStore.Exists(csm.databaseContext, stagingArea, finalityBlockHash)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Wrapf(ruleerrors.ErrUnresolvableFinalityConflict, "finality block %s doesn't exist",
+			finalityBlockHash)
+	}
+
+	status, err := csm.blockStatusStore.Get(csm.databaseContext, stagingArea, finalityBlockHash)
+	if err != nil {
+		return err
+	}
+	if status == externalapi.StatusInvalid || status == externalapi.StatusHeaderOnly ||
+		status == externalapi.StatusDisqualifiedFromChain {
+
+		return errors.Wrapf(ruleerrors.ErrUnresolvableFinalityConflict, "finality block %s has status %s",
+			finalityBlockHash, status)
+	}
+
+	// The UTXO sets of blocks whose selected chain doesn't contain the pruning point can't be
+	// resolved, because they will eventually require UTXO diffs from the past of the pruning point
+	pruningPoint, err := csm.pruningStore.PruningPoint(csm.databaseContext, stagingArea)
+	if err != nil {
+		return err
+	}
+	isPruningPointInSelectedChain, err := csm.dagTopologyManager.IsInSelectedParentChainOf(
+		stagingArea, pruningPoint, finalityBlockHash)
+	if err != nil {
+		return err
+	}
+	if !isPruningPointInSelectedChain {
+		return errors.Wrapf(ruleerrors.ErrUnresolvableFinalityConflict, "the pruning point %s is not in the "+
+			"selected chain of finality block %s", pruningPoint, finalityBlockHash)
+	}
+
+	return nil
+}
+
+// findPendingTipWithChainBlock returns the tip that is first in GHOSTDAG parent selection order out of
+// the tips that can be resolved and have chainBlockHash in their selected chain
+func (csm *consensusStateManager) findPendingTipWithChainBlock(stagingArea *model.StagingArea,
+	chainBlockHash *externalapi.DomainHash) (*externalapi.DomainHash, externalapi.BlockStatus, error) {
+
+	orderedTips, err := csm.tipsInDecreasingGHOSTDAGParentSelectionOrder(stagingArea)
+	if err != nil {
+		return nil, externalapi.StatusInvalid, err
+	}
+
+	for _, tip := range orderedTips {
+		isInSelectedParentChain, err := csm.dagTopologyManager.IsInSelectedParentChainOf(stagingArea, chainBlockHash, tip)
+		if err != nil {
+			return nil, externalapi.StatusInvalid, err
+		}
+		if !isInSelectedParentChain {
+			continue
+		}
+
+		status, err := csm.blockStatusStore.Get(csm.databaseContext, stagingArea, tip)
+		if err != nil {
+			return nil, externalapi.StatusInvalid, err
+		}
+		if status == externalapi.StatusUTXOValid || status == externalapi.StatusUTXOPendingVerification {
+			return tip, status, nil
+		}
+	}
+
+	return nil, externalapi.StatusInvalid, errors.Wrapf(ruleerrors.ErrUnresolvableFinalityConflict,
+		"none of the tips that have finality block %s in their selected chain are valid", chainBlockHash)
+}
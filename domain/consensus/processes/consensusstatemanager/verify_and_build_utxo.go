@@ -68,30 +68,41 @@ func (csm *consensusStateManager) validateBlockTransactionsAgainstPastUTXO(stagi
 	}
 	log.Tracef("The past median time of %s is %d", blockHash, selectedParentMedianTime)
 
+	// The transactions are populated with UTXO entries up to the first one that fails, and only
+	// then validated together, so that their input scripts could be validated in parallel.
+	// The first error is returned, same as when validating the transactions one by one.
+	transactionsToValidate := make([]*externalapi.DomainTransaction, 0, len(block.Transactions))
+	var populateErr error
 	for i, transaction := range block.Transactions {
 		transactionID := consensushashing.TransactionID(transaction)
-		log.Tracef("Validating transaction %s in block %s against "+
-			"the block's past UTXO", transactionID, blockHash)
 		if i == transactionhelper.CoinbaseTransactionIndex {
 			log.Tracef("Skipping transaction %s because it is the coinbase", transactionID)
 			continue
 		}
 
 		log.Tracef("Populating transaction %s with UTXO entries", transactionID)
-		err = csm.populateTransactionWithUTXOEntriesFromVirtualOrDiff(stagingArea, transaction, pastUTXODiff)
-		if err != nil {
-			return err
+		populateErr = csm.populateTransactionWithUTXOEntriesFromVirtualOrDiff(stagingArea, transaction, pastUTXODiff)
+		if populateErr != nil {
+			break
 		}
+		transactionsToValidate = append(transactionsToValidate, transaction)
+	}
 
-		log.Tracef("Validating transaction %s and populating it with fee", transactionID)
-		err = csm.transactionValidator.ValidateTransactionInContextAndPopulateFee(
-			stagingArea, transaction, blockHash)
+	log.Tracef("Validating %d transactions in block %s against the block's past UTXO "+
+		"and populating them with fees", len(transactionsToValidate), blockHash)
+	errs := csm.transactionValidator.ValidateTransactionsInContextAndPopulateFee(
+		stagingArea, transactionsToValidate, blockHash)
+	for _, err := range errs {
 		if err != nil {
 			return err
 		}
-		log.Tracef("Validation against the block's past UTXO "+
-			"passed for transaction %s in block %s", transactionID, blockHash)
 	}
+	if populateErr != nil {
+		return populateErr
+	}
+	log.Tracef("Validation against the block's past UTXO "+
+		"passed for all the transactions in block %s", blockHash)
+
 	return nil
 }
 
@@ -0,0 +1,732 @@
+package ghostdagmanager_test
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/processes/ghostdag2"
+	"github.com/kaspanet/kaspad/domain/consensus/processes/ghostdagmanager"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/testutils"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/util/difficulty"
+	"github.com/pkg/errors"
+)
+
+// ghostdagDivergence is a block whose GHOSTDAG data was calculated differently by
+// the original implementation and by ghostdag2
+type ghostdagDivergence struct {
+	ghostdagDataStore model.GHOSTDAGDataStore
+	blockHash         *externalapi.DomainHash
+	description       string
+
+	// knownDivergence is the name of the known divergence that explains this one,
+	// or an empty string if it isn't explained by any of them
+	knownDivergence string
+}
+
+// knownGHOSTDAGDivergence is a way in which ghostdag2 is known to diverge from the original implementation
+type knownGHOSTDAGDivergence struct {
+	name    string
+	matches func(dgm *differentialGHOSTDAGManager, stagingArea *model.StagingArea,
+		ghostdagData, alternativeGHOSTDAGData *externalapi.BlockGHOSTDAGData) (bool, error)
+}
+
+// knownGHOSTDAGDivergences is the allow-list of the ways in which ghostdag2 is known to diverge
+// from the original implementation. Any other divergence fails TestGHOSTDAGImplementationsAgree.
+// Once ghostdag2 is fixed, the respective entry should be removed, which is enforced by
+// TestKnownGHOSTDAGDivergences.
+var knownGHOSTDAGDivergences = []*knownGHOSTDAGDivergence{
+	{
+		// When checking whether coloring a block blue would give some blue more than K blues in
+		// its anticone, ghostdag2 doesn't count the colored block itself
+		name:    "ghostdag2 doesn't count the colored block in the anticones of the blues it checks",
+		matches: isUncountedCandidateDivergence,
+	},
+}
+
+// isUncountedCandidateDivergence returns whether the divergence is explained by ghostdag2 not counting
+// the block it colors in the anticones of the blues it checks. It replays the coloring of ghostdag2,
+// and matches only if the replay gives the result of ghostdag2 as is, and the result of the original
+// implementation once the colored block is counted.
+func isUncountedCandidateDivergence(dgm *differentialGHOSTDAGManager, stagingArea *model.StagingArea,
+	ghostdagData, alternativeGHOSTDAGData *externalapi.BlockGHOSTDAGData) (bool, error) {
+
+	if !ghostdagData.SelectedParent().Equal(alternativeGHOSTDAGData.SelectedParent()) {
+		return false, nil
+	}
+	mergeSet := append(append([]*externalapi.DomainHash{}, ghostdagData.MergeSetBlues()...), ghostdagData.MergeSetReds()...)
+
+	alternativeBlues, alternativeReds, err := dgm.replayGHOSTDAG2Coloring(stagingArea,
+		ghostdagData.SelectedParent(), mergeSet, false)
+	if err != nil {
+		return false, err
+	}
+	if !reflect.DeepEqual(alternativeBlues, alternativeGHOSTDAGData.MergeSetBlues()) ||
+		!reflect.DeepEqual(alternativeReds, alternativeGHOSTDAGData.MergeSetReds()) {
+		return false, nil
+	}
+	isScoreAndWorkConsistent, err := dgm.isBlueScoreAndWorkConsistent(stagingArea, alternativeGHOSTDAGData)
+	if err != nil || !isScoreAndWorkConsistent {
+		return false, err
+	}
+
+	blues, reds, err := dgm.replayGHOSTDAG2Coloring(stagingArea, ghostdagData.SelectedParent(), mergeSet, true)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(blues, ghostdagData.MergeSetBlues()) && reflect.DeepEqual(reds, ghostdagData.MergeSetReds()), nil
+}
+
+// replayGHOSTDAG2Coloring colors the given merge set the way ghostdag2 does, and returns the merge set
+// blues and reds. If countCandidate is set, the block being colored is counted in the anticones of
+// the blues that are checked, which is the one thing that the original implementation does differently.
+func (dgm *differentialGHOSTDAGManager) replayGHOSTDAG2Coloring(stagingArea *model.StagingArea,
+	selectedParent *externalapi.DomainHash, mergeSet []*externalapi.DomainHash, countCandidate bool) (
+	blues, reds []*externalapi.DomainHash, err error) {
+
+	// ghostdag2 colors the merge set by ascending blue work, and then by ascending hash
+	sortedMergeSet := append([]*externalapi.DomainHash{}, mergeSet...)
+	blueWorks := make(map[externalapi.DomainHash]*big.Int, len(mergeSet))
+	for _, block := range mergeSet {
+		blockGHOSTDAGData, err := dgm.ghostdagDataStore.Get(dgm.databaseContext, stagingArea, block, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		blueWorks[*block] = blockGHOSTDAGData.BlueWork()
+	}
+	sort.Slice(sortedMergeSet, func(i, j int) bool {
+		comparison := blueWorks[*sortedMergeSet[i]].Cmp(blueWorks[*sortedMergeSet[j]])
+		if comparison != 0 {
+			return comparison < 0
+		}
+		return sortedMergeSet[i].Less(sortedMergeSet[j])
+	})
+
+	// The blue set starts with the selected parent and the merge set blues of the blocks in its selected chain
+	blueSet := []*externalapi.DomainHash{selectedParent}
+	for current := selectedParent; ; {
+		currentGHOSTDAGData, err := dgm.ghostdagDataStore.Get(dgm.databaseContext, stagingArea, current, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		if currentGHOSTDAGData.SelectedParent().Equal(model.VirtualGenesisBlockHash) {
+			break
+		}
+		blueSet = append(blueSet, currentGHOSTDAGData.MergeSetBlues()...)
+		current = currentGHOSTDAGData.SelectedParent()
+	}
+
+	blues = []*externalapi.DomainHash{selectedParent}
+	reds = []*externalapi.DomainHash{}
+	for _, candidate := range sortedMergeSet {
+		if candidate.Equal(selectedParent) {
+			continue
+		}
+		isBlue, err := dgm.isGHOSTDAG2Blue(stagingArea, candidate, blueSet, countCandidate)
+		if err != nil {
+			return nil, nil, err
+		}
+		if isBlue {
+			blues = append(blues, candidate)
+			blueSet = append(blueSet, candidate)
+		} else {
+			reds = append(reds, candidate)
+		}
+	}
+	return blues, reds, nil
+}
+
+// isGHOSTDAG2Blue returns whether ghostdag2 colors the given candidate blue on top of the given blue set
+func (dgm *differentialGHOSTDAGManager) isGHOSTDAG2Blue(stagingArea *model.StagingArea,
+	candidate *externalapi.DomainHash, blueSet []*externalapi.DomainHash, countCandidate bool) (bool, error) {
+
+	candidateAnticoneBlues, err := dgm.anticoneBlues(stagingArea, candidate, blueSet)
+	if err != nil {
+		return false, err
+	}
+	if len(candidateAnticoneBlues) > int(dgm.k) {
+		return false, nil
+	}
+	for _, blue := range candidateAnticoneBlues {
+		blueAnticoneBlues, err := dgm.anticoneBlues(stagingArea, blue, blueSet)
+		if err != nil {
+			return false, err
+		}
+		blueAnticoneSize := len(blueAnticoneBlues)
+		if countCandidate {
+			blueAnticoneSize++
+		}
+		if blueAnticoneSize > int(dgm.k) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// anticoneBlues returns the blocks of the given blue set that are in the anticone of the given block
+func (dgm *differentialGHOSTDAGManager) anticoneBlues(stagingArea *model.StagingArea,
+	block *externalapi.DomainHash, blueSet []*externalapi.DomainHash) ([]*externalapi.DomainHash, error) {
+
+	var anticoneBlues []*externalapi.DomainHash
+	for _, blue := range blueSet {
+		isInAnticone, err := dgm.isInAnticone(stagingArea, block, blue)
+		if err != nil {
+			return nil, err
+		}
+		if isInAnticone {
+			anticoneBlues = append(anticoneBlues, blue)
+		}
+	}
+	return anticoneBlues, nil
+}
+
+// isBlueScoreAndWorkConsistent returns whether the blue score and blue work of the given GHOSTDAG
+// data are the ones of its selected parent, along with the blocks in its merge set blues
+func (dgm *differentialGHOSTDAGManager) isBlueScoreAndWorkConsistent(stagingArea *model.StagingArea,
+	ghostdagData *externalapi.BlockGHOSTDAGData) (bool, error) {
+
+	selectedParentGHOSTDAGData, err := dgm.ghostdagDataStore.Get(dgm.databaseContext, stagingArea,
+		ghostdagData.SelectedParent(), false)
+	if err != nil {
+		return false, err
+	}
+	expectedBlueScore := selectedParentGHOSTDAGData.BlueScore() + uint64(len(ghostdagData.MergeSetBlues()))
+	expectedBlueWork := new(big.Int).Set(selectedParentGHOSTDAGData.BlueWork())
+	for _, blue := range ghostdagData.MergeSetBlues() {
+		header, err := dgm.headerStore.BlockHeader(dgm.databaseContext, stagingArea, blue)
+		if err != nil {
+			return false, err
+		}
+		expectedBlueWork.Add(expectedBlueWork, difficulty.CalcWork(header.Bits()))
+	}
+	return ghostdagData.BlueScore() == expectedBlueScore && ghostdagData.BlueWork().Cmp(expectedBlueWork) == 0, nil
+}
+
+func (dgm *differentialGHOSTDAGManager) isInAnticone(stagingArea *model.StagingArea,
+	blockA, blockB *externalapi.DomainHash) (bool, error) {
+
+	isAncestorOf, err := dgm.dagTopologyManager.IsAncestorOf(stagingArea, blockA, blockB)
+	if err != nil {
+		return false, err
+	}
+	if isAncestorOf {
+		return false, nil
+	}
+	isDescendantOf, err := dgm.dagTopologyManager.IsAncestorOf(stagingArea, blockB, blockA)
+	if err != nil {
+		return false, err
+	}
+	return !isDescendantOf, nil
+}
+
+// differentialGHOSTDAGManager calculates the GHOSTDAG data of every block with both the
+// original implementation and ghostdag2, and records the blocks for which they diverge.
+// Only the data calculated by the original implementation is kept, so both implementations
+// always calculate the data of a block on top of the same data of its past.
+type differentialGHOSTDAGManager struct {
+	model.GHOSTDAGManager
+	alternativeGHOSTDAGManager model.GHOSTDAGManager
+
+	databaseContext    model.DBReader
+	dagTopologyManager model.DAGTopologyManager
+	ghostdagDataStore  model.GHOSTDAGDataStore
+	headerStore        model.BlockHeaderStore
+	k                  externalapi.KType
+	divergences        *[]*ghostdagDivergence
+}
+
+func newDifferentialGHOSTDAGManagerConstructor(divergences *[]*ghostdagDivergence) consensus.GHOSTDAGManagerConstructor {
+	return func(
+		databaseContext model.DBReader,
+		dagTopologyManager model.DAGTopologyManager,
+		ghostdagDataStore model.GHOSTDAGDataStore,
+		headerStore model.BlockHeaderStore,
+		k externalapi.KType,
+		genesisHash *externalapi.DomainHash) model.GHOSTDAGManager {
+
+		return &differentialGHOSTDAGManager{
+			GHOSTDAGManager: ghostdagmanager.New(
+				databaseContext, dagTopologyManager, ghostdagDataStore, headerStore, k, genesisHash),
+			alternativeGHOSTDAGManager: ghostdag2.New(
+				databaseContext, dagTopologyManager, ghostdagDataStore, headerStore, k, genesisHash),
+			databaseContext:    databaseContext,
+			dagTopologyManager: dagTopologyManager,
+			ghostdagDataStore:  ghostdagDataStore,
+			headerStore:        headerStore,
+			k:                  k,
+			divergences:        divergences,
+		}
+	}
+}
+
+func (dgm *differentialGHOSTDAGManager) GHOSTDAG(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash) error {
+	parents, err := dgm.dagTopologyManager.Parents(stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	// ghostdag2 doesn't handle the genesis, and the virtual isn't a part of the compared DAG
+	if len(parents) == 0 || blockHash.Equal(model.VirtualBlockHash) {
+		return dgm.GHOSTDAGManager.GHOSTDAG(stagingArea, blockHash)
+	}
+
+	alternativeGHOSTDAGData, alternativeErr := dgm.alternativeGHOSTDAG(stagingArea, blockHash)
+
+	err = dgm.GHOSTDAGManager.GHOSTDAG(stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	ghostdagData, err := dgm.ghostdagDataStore.Get(dgm.databaseContext, stagingArea, blockHash, false)
+	if err != nil {
+		return err
+	}
+
+	if alternativeErr != nil {
+		*dgm.divergences = append(*dgm.divergences, &ghostdagDivergence{
+			ghostdagDataStore: dgm.ghostdagDataStore,
+			blockHash:         blockHash,
+			description:       fmt.Sprintf("ghostdag2 failed: %s", alternativeErr),
+		})
+		return nil
+	}
+
+	description := ghostdagDataDifference(ghostdagData, alternativeGHOSTDAGData)
+	if description == "" {
+		return nil
+	}
+	divergence := &ghostdagDivergence{
+		ghostdagDataStore: dgm.ghostdagDataStore,
+		blockHash:         blockHash,
+		description:       description,
+	}
+	for _, knownDivergence := range knownGHOSTDAGDivergences {
+		matches, err := knownDivergence.matches(dgm, stagingArea, ghostdagData, alternativeGHOSTDAGData)
+		if err != nil {
+			return err
+		}
+		if matches {
+			divergence.knownDivergence = knownDivergence.name
+			break
+		}
+	}
+	*dgm.divergences = append(*dgm.divergences, divergence)
+
+	return nil
+}
+
+// alternativeGHOSTDAG calculates the GHOSTDAG data of the given block with ghostdag2. The data
+// it stages is overwritten by the original implementation right after, so it's not seen by consensus.
+func (dgm *differentialGHOSTDAGManager) alternativeGHOSTDAG(stagingArea *model.StagingArea,
+	blockHash *externalapi.DomainHash) (ghostdagData *externalapi.BlockGHOSTDAGData, err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("panic: %v", r)
+		}
+	}()
+
+	err = dgm.alternativeGHOSTDAGManager.GHOSTDAG(stagingArea, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	return dgm.ghostdagDataStore.Get(dgm.databaseContext, stagingArea, blockHash, false)
+}
+
+// ghostdagDataDifference describes the difference between the GHOSTDAG data calculated by
+// the original implementation and by ghostdag2, or returns an empty string if they're the same.
+// The blues anticone sizes aren't compared, since ghostdag2 doesn't calculate them.
+func ghostdagDataDifference(ghostdagData, alternativeGHOSTDAGData *externalapi.BlockGHOSTDAGData) string {
+	switch {
+	case ghostdagData.BlueScore() != alternativeGHOSTDAGData.BlueScore():
+		return fmt.Sprintf("blue score: %d != %d", ghostdagData.BlueScore(), alternativeGHOSTDAGData.BlueScore())
+	case ghostdagData.BlueWork().Cmp(alternativeGHOSTDAGData.BlueWork()) != 0:
+		return fmt.Sprintf("blue work: %d != %d", ghostdagData.BlueWork(), alternativeGHOSTDAGData.BlueWork())
+	case !ghostdagData.SelectedParent().Equal(alternativeGHOSTDAGData.SelectedParent()):
+		return fmt.Sprintf("selected parent: %s != %s",
+			ghostdagData.SelectedParent(), alternativeGHOSTDAGData.SelectedParent())
+	case !reflect.DeepEqual(ghostdagData.MergeSetBlues(), alternativeGHOSTDAGData.MergeSetBlues()):
+		return fmt.Sprintf("merge set blues: %v != %v",
+			ghostdagData.MergeSetBlues(), alternativeGHOSTDAGData.MergeSetBlues())
+	case !reflect.DeepEqual(ghostdagData.MergeSetReds(), alternativeGHOSTDAGData.MergeSetReds()):
+		return fmt.Sprintf("merge set reds: %v != %v",
+			ghostdagData.MergeSetReds(), alternativeGHOSTDAGData.MergeSetReds())
+	}
+	return ""
+}
+
+// shapeDivergence is a block in a DAG shape for which the GHOSTDAG implementations diverged
+type shapeDivergence struct {
+	block           int
+	description     string
+	knownDivergence string
+}
+
+// runDifferentialGHOSTDAG adds the given DAG shape to a consensus that runs both GHOSTDAG
+// implementations, and returns the blocks of the shape for which they diverged, ordered by block
+func runDifferentialGHOSTDAG(k externalapi.KType, shape *testutils.DAGShape) ([]*shapeDivergence, error) {
+	// With K=0 every block in the merge set of a block except its selected parent is red,
+	// which isn't a meaningful configuration to compare the implementations with
+	if k == 0 {
+		return nil, errors.Errorf("K must be positive")
+	}
+
+	consensusConfig := &consensus.Config{Params: dagconfig.DevnetParams}
+	consensusConfig.K = k
+	consensusConfig.SkipProofOfWork = true
+	consensusConfig.DisableDifficultyAdjustment = true
+
+	var divergences []*ghostdagDivergence
+	factory := consensus.NewFactory()
+	factory.SetTestGHOSTDAGManager(newDifferentialGHOSTDAGManagerConstructor(&divergences))
+	tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestGHOSTDAGImplementationsAgree")
+	if err != nil {
+		return nil, err
+	}
+	defer teardown(false)
+
+	blockHashes, err := testutils.AddDAGShapeToConsensus(tc, shape)
+	if err != nil {
+		return nil, err
+	}
+	blockIndexes := make(map[externalapi.DomainHash]int, len(blockHashes))
+	for i, blockHash := range blockHashes {
+		blockIndexes[*blockHash] = i
+	}
+
+	// Only the divergences in the shape itself are reported, as opposed to ones in the higher
+	// block levels or in temporary blocks, which have different parents
+	var shapeDivergences []*shapeDivergence
+	for _, divergence := range divergences {
+		if divergence.ghostdagDataStore != tc.GHOSTDAGDataStore() {
+			continue
+		}
+		block, ok := blockIndexes[*divergence.blockHash]
+		if !ok {
+			continue
+		}
+		shapeDivergences = append(shapeDivergences, &shapeDivergence{
+			block:           block,
+			description:     divergence.description,
+			knownDivergence: divergence.knownDivergence,
+		})
+	}
+	return shapeDivergences, nil
+}
+
+// firstUnknownDivergence returns the first of the given divergences that isn't a known one, if any
+func firstUnknownDivergence(divergences []*shapeDivergence) *shapeDivergence {
+	for _, divergence := range divergences {
+		if divergence.knownDivergence == "" {
+			return divergence
+		}
+	}
+	return nil
+}
+
+// minimizeDivergingDAGShape looks for the smallest DAG shape derived from the given diverging
+// shape for which the GHOSTDAG implementations still diverge in an unknown way. It cuts the
+// shape down to the past of the diverging block, and then repeatedly removes single blocks and
+// single parent references for as long as the implementations keep diverging.
+func minimizeDivergingDAGShape(k externalapi.KType, shape *testutils.DAGShape, divergence *shapeDivergence) (
+	*testutils.DAGShape, *shapeDivergence, error) {
+
+	shape = pastShape(shape, divergence.block)
+	divergence = &shapeDivergence{block: shape.BlockCount() - 1, description: divergence.description}
+
+	for {
+		isReduced := false
+		for _, candidate := range reducedShapes(shape) {
+			candidateDivergences, err := runDifferentialGHOSTDAG(k, candidate)
+			if err != nil {
+				return nil, nil, err
+			}
+			candidateDivergence := firstUnknownDivergence(candidateDivergences)
+			if candidateDivergence == nil {
+				continue
+			}
+			shape = pastShape(candidate, candidateDivergence.block)
+			divergence = &shapeDivergence{block: shape.BlockCount() - 1, description: candidateDivergence.description}
+			isReduced = true
+			break
+		}
+		if !isReduced {
+			return shape, divergence, nil
+		}
+	}
+}
+
+// reducedShapes returns all the shapes that are smaller than the given shape by a single
+// block or by a single parent reference, from the most reduced ones
+func reducedShapes(shape *testutils.DAGShape) []*testutils.DAGShape {
+	var shapes []*testutils.DAGShape
+	for block := 1; block < shape.BlockCount(); block++ {
+		shapes = append(shapes, shapeWithoutBlock(shape, block))
+	}
+	for block := 1; block < shape.BlockCount(); block++ {
+		if len(shape.Parents[block]) < 2 {
+			continue
+		}
+		for i := range shape.Parents[block] {
+			shapes = append(shapes, shapeWithoutParent(shape, block, i))
+		}
+	}
+	return shapes
+}
+
+// pastShape returns the shape of the past of the given block, including the block itself
+func pastShape(shape *testutils.DAGShape, block int) *testutils.DAGShape {
+	isInPast := make([]bool, shape.BlockCount())
+	isInPast[block] = true
+	for current := block; current >= 0; current-- {
+		if !isInPast[current] {
+			continue
+		}
+		for _, parent := range shape.Parents[current] {
+			isInPast[parent] = true
+		}
+	}
+
+	newIndexes := make([]int, shape.BlockCount())
+	pastShape := &testutils.DAGShape{}
+	for current := 0; current <= block; current++ {
+		if !isInPast[current] {
+			continue
+		}
+		newIndexes[current] = pastShape.BlockCount()
+		parents := make([]int, len(shape.Parents[current]))
+		for i, parent := range shape.Parents[current] {
+			parents[i] = newIndexes[parent]
+		}
+		pastShape.Parents = append(pastShape.Parents, parents)
+	}
+	return pastShape
+}
+
+// shapeWithoutBlock returns the given shape without the given block. The children of the
+// removed block point to its parents instead, except for the ones that are already in their past.
+func shapeWithoutBlock(shape *testutils.DAGShape, removedBlock int) *testutils.DAGShape {
+	pasts := make([]map[int]struct{}, shape.BlockCount())
+	for block, parents := range shape.Parents {
+		pasts[block] = map[int]struct{}{}
+		for _, parent := range parents {
+			pasts[block][parent] = struct{}{}
+			for ancestor := range pasts[parent] {
+				pasts[block][ancestor] = struct{}{}
+			}
+		}
+	}
+
+	newShape := &testutils.DAGShape{}
+	for block, parents := range shape.Parents {
+		if block == removedBlock {
+			continue
+		}
+
+		candidateParents := make([]int, 0, len(parents))
+		for _, parent := range parents {
+			if parent == removedBlock {
+				candidateParents = append(candidateParents, shape.Parents[removedBlock]...)
+			} else {
+				candidateParents = append(candidateParents, parent)
+			}
+		}
+
+		// Keep only the parents that aren't in the past of other parents
+		newParents := make([]int, 0, len(candidateParents))
+		for _, parent := range candidateParents {
+			isRedundant := false
+			for _, otherParent := range candidateParents {
+				if _, ok := pasts[otherParent][parent]; ok {
+					isRedundant = true
+					break
+				}
+			}
+			if !isRedundant && !containsInt(newParents, parent) {
+				newParents = append(newParents, parent)
+			}
+		}
+
+		for i, parent := range newParents {
+			if parent > removedBlock {
+				newParents[i] = parent - 1
+			}
+		}
+		newShape.Parents = append(newShape.Parents, newParents)
+	}
+	return newShape
+}
+
+// shapeWithoutParent returns the given shape without the parentIndex-th parent of the given block
+func shapeWithoutParent(shape *testutils.DAGShape, block int, parentIndex int) *testutils.DAGShape {
+	newShape := &testutils.DAGShape{Parents: make([][]int, shape.BlockCount())}
+	for current, parents := range shape.Parents {
+		newShape.Parents[current] = append([]int{}, parents...)
+	}
+	newShape.Parents[block] = append(newShape.Parents[block][:parentIndex], newShape.Parents[block][parentIndex+1:]...)
+	return newShape
+}
+
+func containsInt(slice []int, value int) bool {
+	for _, element := range slice {
+		if element == value {
+			return true
+		}
+	}
+	return false
+}
+
+// TestGHOSTDAGImplementationsAgree runs both GHOSTDAG implementations on random DAG shapes
+// with random K values, and fails with the minimal diverging DAG shape if they ever diverge
+// in a way that isn't in knownGHOSTDAGDivergences
+func TestGHOSTDAGImplementationsAgree(t *testing.T) {
+	randomDAGShapeCount := 2000
+	if testing.Short() {
+		randomDAGShapeCount = 200
+	}
+	const seed = 0
+
+	type divergingShape struct {
+		k          externalapi.KType
+		shape      *testutils.DAGShape
+		divergence *shapeDivergence
+	}
+	var divergingShapes []*divergingShape
+	knownDivergenceCounts := make(map[string]int)
+
+	random := rand.New(rand.NewSource(seed))
+	for i := 0; i < randomDAGShapeCount; i++ {
+		k := externalapi.KType(1 + random.Intn(7))
+		blockCount := 1 + random.Intn(40)
+		maxParents := 1 + random.Intn(5)
+		parentsWindowSize := 1 + random.Intn(10)
+		shape := testutils.GenerateRandomDAGShape(random, blockCount, maxParents, parentsWindowSize)
+
+		divergences, err := runDifferentialGHOSTDAG(k, shape)
+		if err != nil {
+			t.Fatalf("runDifferentialGHOSTDAG: %+v", err)
+		}
+		for _, divergence := range divergences {
+			if divergence.knownDivergence != "" {
+				knownDivergenceCounts[divergence.knownDivergence]++
+			}
+		}
+		divergence := firstUnknownDivergence(divergences)
+		if divergence != nil {
+			divergingShapes = append(divergingShapes, &divergingShape{k: k, shape: shape, divergence: divergence})
+		}
+	}
+	for knownDivergence, count := range knownDivergenceCounts {
+		t.Logf("The known divergence \"%s\" happened %d times", knownDivergence, count)
+	}
+	if len(divergingShapes) == 0 {
+		return
+	}
+
+	// Minimizing is relatively slow, so only the smallest diverging shape is minimized
+	smallest := divergingShapes[0]
+	for _, diverging := range divergingShapes[1:] {
+		if diverging.shape.BlockCount() < smallest.shape.BlockCount() {
+			smallest = diverging
+		}
+	}
+	minimalShape, minimalDivergence, err := minimizeDivergingDAGShape(smallest.k, smallest.shape, smallest.divergence)
+	if err != nil {
+		t.Fatalf("minimizeDivergingDAGShape: %+v", err)
+	}
+
+	t.Fatalf("The GHOSTDAG implementations diverged in an unknown way on %d out of %d random DAG shapes. "+
+		"The minimal diverging DAG shape, with K=%d, has %d blocks, and they diverged on its block %d (%s):\n%s",
+		len(divergingShapes), randomDAGShapeCount, smallest.k, minimalShape.BlockCount(), minimalDivergence.block,
+		minimalDivergence.description, minimalShape.ToJSON())
+}
+
+// TestKnownGHOSTDAGDivergences makes sure that every known divergence in knownGHOSTDAGDivergences
+// still happens, so that it's removed from the allow-list once ghostdag2 is fixed
+func TestKnownGHOSTDAGDivergences(t *testing.T) {
+	tests := []struct {
+		knownDivergence string
+		k               externalapi.KType
+		shape           *testutils.DAGShape
+		block           int
+	}{
+		{
+			knownDivergence: knownGHOSTDAGDivergences[0].name,
+			k:               4,
+			shape: &testutils.DAGShape{Parents: [][]int{
+				{}, {0}, {0}, {2}, {0}, {1, 4}, {0}, {3, 5, 6},
+			}},
+			block: 7,
+		},
+	}
+	if len(tests) != len(knownGHOSTDAGDivergences) {
+		t.Fatalf("Expected a test for each of the %d known divergences, got %d tests",
+			len(knownGHOSTDAGDivergences), len(tests))
+	}
+
+	for _, test := range tests {
+		divergences, err := runDifferentialGHOSTDAG(test.k, test.shape)
+		if err != nil {
+			t.Fatalf("runDifferentialGHOSTDAG: %+v", err)
+		}
+		if len(divergences) != 1 {
+			t.Fatalf("Expected a single divergence, got %d", len(divergences))
+		}
+		divergence := divergences[0]
+		if divergence.block != test.block || divergence.knownDivergence != test.knownDivergence {
+			t.Fatalf("Expected the known divergence \"%s\" in block %d, got the divergence \"%s\" (%s) in block %d",
+				test.knownDivergence, test.block, divergence.knownDivergence, divergence.description, divergence.block)
+		}
+	}
+}
+
+// BenchmarkGHOSTDAG compares the performance of the GHOSTDAG implementations by
+// calculating the GHOSTDAG data of all the blocks of a random DAG
+func BenchmarkGHOSTDAG(b *testing.B) {
+	implementationFactories := []implManager{
+		{ghostdagmanager.New, "Original"},
+		{ghostdag2.New, "Tal's impl"},
+	}
+
+	consensusConfig := &consensus.Config{Params: dagconfig.DevnetParams}
+	consensusConfig.SkipProofOfWork = true
+	consensusConfig.DisableDifficultyAdjustment = true
+
+	factory := consensus.NewFactory()
+	tc, teardown, err := factory.NewTestConsensus(consensusConfig, "BenchmarkGHOSTDAG")
+	if err != nil {
+		b.Fatalf("Error setting up consensus: %+v", err)
+	}
+	defer teardown(false)
+
+	const seed = 0
+	random := rand.New(rand.NewSource(seed))
+	shape := testutils.GenerateRandomDAGShape(random, 500, 4, int(consensusConfig.K))
+	blockHashes, err := testutils.AddDAGShapeToConsensus(tc, shape)
+	if err != nil {
+		b.Fatalf("AddDAGShapeToConsensus: %+v", err)
+	}
+
+	for _, implementationFactory := range implementationFactories {
+		manager := implementationFactory.function(tc.DatabaseContext(), tc.DAGTopologyManager(), tc.GHOSTDAGDataStore(),
+			tc.BlockHeaderStore(), consensusConfig.K, consensusConfig.GenesisHash)
+
+		b.Run(implementationFactory.implName, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				// Every block is calculated on top of the data this implementation staged for its parents
+				stagingArea := model.NewStagingArea()
+				for _, blockHash := range blockHashes[1:] {
+					err := manager.GHOSTDAG(stagingArea, blockHash)
+					if err != nil {
+						b.Fatalf("GHOSTDAG: %+v", err)
+					}
+				}
+			}
+		})
+	}
+}
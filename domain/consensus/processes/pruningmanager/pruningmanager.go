@@ -38,6 +38,8 @@ type pruningManager struct {
 	reachabilityDataStore               model.ReachabilityDataStore
 
 	isArchivalNode                  bool
+	retentionPruningPeriods         uint64
+	retentionDAAScoreWindow         uint64
 	genesisHash                     *externalapi.DomainHash
 	finalityInterval                uint64
 	pruningDepth                    uint64
@@ -47,6 +49,8 @@ type pruningManager struct {
 
 	cachedPruningPoint         *externalapi.DomainHash
 	cachedPruningPointAnticone []*externalapi.DomainHash
+
+	expiredBlockDataDeletion *expiredBlockDataDeletion
 }
 
 // New instantiates a new PruningManager
@@ -73,6 +77,8 @@ func New(
 	blocksWithTrustedDataDAAWindowStore model.BlocksWithTrustedDataDAAWindowStore,
 
 	isArchivalNode bool,
+	retentionPruningPeriods uint64,
+	retentionDAAScoreWindow uint64,
 	genesisHash *externalapi.DomainHash,
 	finalityInterval uint64,
 	pruningDepth uint64,
@@ -103,6 +109,8 @@ func New(
 		blocksWithTrustedDataDAAWindowStore: blocksWithTrustedDataDAAWindowStore,
 
 		isArchivalNode:                  isArchivalNode,
+		retentionPruningPeriods:         retentionPruningPeriods,
+		retentionDAAScoreWindow:         retentionDAAScoreWindow,
 		genesisHash:                     genesisHash,
 		pruningDepth:                    pruningDepth,
 		finalityInterval:                finalityInterval,
@@ -514,17 +522,22 @@ func (pm *pruningManager) deleteBlock(stagingArea *model.StagingArea, blockHash
 	}
 
 	pm.blockStatusStore.Stage(stagingArea, blockHash, externalapi.StatusHeaderOnly)
-	if pm.isArchivalNode {
+	if pm.isArchivalNode || pm.isRetainingBlockData() {
+		// On a retaining node the block data is deleted later on by DeleteExpiredBlockData
 		return false, nil
 	}
 
+	pm.deleteBlockData(stagingArea, blockHash)
+
+	return false, nil
+}
+
+func (pm *pruningManager) deleteBlockData(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash) {
 	pm.multiSetStore.Delete(stagingArea, blockHash)
 	pm.acceptanceDataStore.Delete(stagingArea, blockHash)
 	pm.blocksStore.Delete(stagingArea, blockHash)
 	pm.utxoDiffStore.Delete(stagingArea, blockHash)
 	pm.daaBlocksStore.Delete(stagingArea, blockHash)
-
-	return false, nil
 }
 
 func (pm *pruningManager) IsValidPruningPoint(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash) (bool, error) {
@@ -0,0 +1,292 @@
+package pruningmanager
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/virtual"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/util/staging"
+)
+
+// isRetainingBlockData returns whether this node keeps the block data of the
+// latest pruning periods (or DAA score window) instead of deleting it as soon
+// as the pruning point moves
+func (pm *pruningManager) isRetainingBlockData() bool {
+	return pm.retentionPruningPeriods > 0 || pm.retentionDAAScoreWindow > 0
+}
+
+// RetentionRoot returns the pruning point from which on block data is retained.
+// Blocks in the past of the retention root are header-only, except for the ones
+// that are kept for the sake of the current pruning point.
+func (pm *pruningManager) RetentionRoot(stagingArea *model.StagingArea) (*externalapi.DomainHash, error) {
+	if !pm.isArchivalNode && !pm.isRetainingBlockData() {
+		return pm.pruningStore.PruningPoint(pm.databaseContext, stagingArea)
+	}
+
+	if pm.isRetainingBlockData() {
+		hasRetentionRoot, err := pm.pruningStore.HasRetentionRoot(pm.databaseContext, stagingArea)
+		if err != nil {
+			return nil, err
+		}
+		if hasRetentionRoot {
+			return pm.pruningStore.RetentionRoot(pm.databaseContext, stagingArea)
+		}
+	}
+
+	return pm.earliestPruningPointWithBlockData(stagingArea)
+}
+
+// earliestPruningPointWithBlockData returns the earliest pruning point whose block
+// data was not deleted. The block data of a node is deleted from the past upwards,
+// so it's enough to binary search the pruning points by index.
+func (pm *pruningManager) earliestPruningPointWithBlockData(stagingArea *model.StagingArea) (*externalapi.DomainHash, error) {
+	currentPruningPointIndex, err := pm.pruningStore.CurrentPruningPointIndex(pm.databaseContext, stagingArea)
+	if err != nil {
+		return nil, err
+	}
+
+	low, high := uint64(0), currentPruningPointIndex
+	for low < high {
+		middle := (low + high) / 2
+		pruningPoint, err := pm.pruningStore.PruningPointByIndex(pm.databaseContext, stagingArea, middle)
+		if err != nil {
+			return nil, err
+		}
+		hasBlock, err := pm.blocksStore.HasBlock(pm.databaseContext, stagingArea, pruningPoint)
+		if err != nil {
+			return nil, err
+		}
+		if hasBlock {
+			high = middle
+		} else {
+			low = middle + 1
+		}
+	}
+
+	return pm.pruningStore.PruningPointByIndex(pm.databaseContext, stagingArea, low)
+}
+
+// nextRetentionRoot returns the pruning point that should be the retention root
+// according to the retention configuration of this node
+func (pm *pruningManager) nextRetentionRoot(stagingArea *model.StagingArea) (*externalapi.DomainHash, error) {
+	currentPruningPointIndex, err := pm.pruningStore.CurrentPruningPointIndex(pm.databaseContext, stagingArea)
+	if err != nil {
+		return nil, err
+	}
+
+	if pm.retentionPruningPeriods > 0 {
+		if currentPruningPointIndex < pm.retentionPruningPeriods {
+			return pm.pruningStore.PruningPointByIndex(pm.databaseContext, stagingArea, 0)
+		}
+		return pm.pruningStore.PruningPointByIndex(pm.databaseContext, stagingArea,
+			currentPruningPointIndex-pm.retentionPruningPeriods)
+	}
+
+	virtualDAAScore, err := pm.daaBlocksStore.DAAScore(pm.databaseContext, stagingArea, model.VirtualBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if virtualDAAScore <= pm.retentionDAAScoreWindow {
+		return pm.pruningStore.PruningPointByIndex(pm.databaseContext, stagingArea, 0)
+	}
+
+	// The retention root is the latest pruning point that still leaves the whole
+	// window above it
+	maxRetentionRootDAAScore := virtualDAAScore - pm.retentionDAAScoreWindow
+	for i := currentPruningPointIndex; ; i-- {
+		pruningPoint, err := pm.pruningStore.PruningPointByIndex(pm.databaseContext, stagingArea, i)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			return pruningPoint, nil
+		}
+		pruningPointHeader, err := pm.blockHeaderStore.BlockHeader(pm.databaseContext, stagingArea, pruningPoint)
+		if err != nil {
+			return nil, err
+		}
+		if pruningPointHeader.DAAScore() <= maxRetentionRootDAAScore {
+			return pruningPoint, nil
+		}
+	}
+}
+
+// expiredBlockDataDeletion is an ongoing traversal of the blocks between the retention root and the
+// next retention root, whose block data is deleted as they're traversed. It's kept between calls to
+// DeleteExpiredBlockData, so that every call only does a bounded amount of work.
+type expiredBlockDataDeletion struct {
+	retentionRoot     *externalapi.DomainHash
+	nextRetentionRoot *externalapi.DomainHash
+
+	// The traversal only reads data that isn't changed by deleting block data, so it
+	// uses a staging area of its own that outlives the staging area of a single call
+	readStagingArea *model.StagingArea
+	queue           model.BlockHeap
+	visited         map[externalapi.DomainHash]struct{}
+}
+
+// DeleteExpiredBlockData traverses up to maxBlocksToTraverse of the blocks below the next
+// retention root and deletes the block data of the expired ones, moving the retention
+// root up once all of them are traversed. It's a no-op for nodes that don't retain block data.
+func (pm *pruningManager) DeleteExpiredBlockData(maxBlocksToTraverse uint64) (isCompletelyDeleted bool, err error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "pruningManager.DeleteExpiredBlockData")
+	defer onEnd()
+
+	if !pm.isRetainingBlockData() {
+		return true, nil
+	}
+
+	// The block data between the previous and the current pruning points might
+	// still be required for updating the pruning point UTXO set
+	hadStartedUpdatingPruningPointUTXOSet, err := pm.pruningStore.HadStartedUpdatingPruningPointUTXOSet(pm.databaseContext)
+	if err != nil {
+		return false, err
+	}
+	if hadStartedUpdatingPruningPointUTXOSet {
+		return true, nil
+	}
+
+	if pm.expiredBlockDataDeletion == nil {
+		deletion, err := pm.startExpiredBlockDataDeletion()
+		if err != nil {
+			return false, err
+		}
+		if deletion == nil {
+			return true, nil
+		}
+		pm.expiredBlockDataDeletion = deletion
+	}
+	deletion := pm.expiredBlockDataDeletion
+
+	stagingArea := model.NewStagingArea()
+	deletedBlockCount := 0
+	for traversedBlockCount := uint64(0); traversedBlockCount < maxBlocksToTraverse && deletion.queue.Len() > 0; {
+		current := deletion.queue.Pop()
+		if _, ok := deletion.visited[*current]; ok {
+			continue
+		}
+		deletion.visited[*current] = struct{}{}
+		traversedBlockCount++
+
+		isExpired, err := pm.traverseExpiredBlockDataDeletion(deletion, current)
+		if err != nil {
+			return false, err
+		}
+		if isExpired {
+			pm.deleteBlockData(stagingArea, current)
+			deletedBlockCount++
+		}
+	}
+
+	isCompletelyDeleted = deletion.queue.Len() == 0
+	if isCompletelyDeleted {
+		pm.pruningStore.StageRetentionRoot(stagingArea, deletion.nextRetentionRoot)
+	}
+
+	err = staging.CommitAllChanges(pm.databaseContext, stagingArea)
+	if err != nil {
+		return false, err
+	}
+	log.Debugf("Deleted the block data of %d blocks below retention root %s", deletedBlockCount,
+		deletion.nextRetentionRoot)
+
+	if isCompletelyDeleted {
+		log.Infof("Moved the retention root to %s", deletion.nextRetentionRoot)
+		pm.expiredBlockDataDeletion = nil
+	}
+
+	return isCompletelyDeleted, nil
+}
+
+// startExpiredBlockDataDeletion starts a traversal of the blocks whose block data has to be
+// deleted in order to move the retention root up. Returns nil if the retention root doesn't
+// need to move.
+func (pm *pruningManager) startExpiredBlockDataDeletion() (*expiredBlockDataDeletion, error) {
+	stagingArea := model.NewStagingArea()
+	retentionRoot, err := pm.RetentionRoot(stagingArea)
+	if err != nil {
+		return nil, err
+	}
+	nextRetentionRoot, err := pm.nextRetentionRoot(stagingArea)
+	if err != nil {
+		return nil, err
+	}
+
+	// Both retention roots are pruning points, so they're ordered by their DAA scores.
+	// The headers of pruning points are never deleted, so this works even for ones
+	// that are below the pruning point this node had synced from.
+	retentionRootHeader, err := pm.blockHeaderStore.BlockHeader(pm.databaseContext, stagingArea, retentionRoot)
+	if err != nil {
+		return nil, err
+	}
+	nextRetentionRootHeader, err := pm.blockHeaderStore.BlockHeader(pm.databaseContext, stagingArea, nextRetentionRoot)
+	if err != nil {
+		return nil, err
+	}
+	if nextRetentionRootHeader.DAAScore() <= retentionRootHeader.DAAScore() {
+		return nil, nil
+	}
+
+	deletion := &expiredBlockDataDeletion{
+		retentionRoot:     retentionRoot,
+		nextRetentionRoot: nextRetentionRoot,
+		readStagingArea:   stagingArea,
+		queue:             pm.dagTraversalManager.NewDownHeap(stagingArea),
+		visited:           map[externalapi.DomainHash]struct{}{},
+	}
+	err = pm.pushParentsToExpiredBlockDataDeletion(deletion, nextRetentionRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Deleting the expired block data in order to move the retention root from %s to %s",
+		retentionRoot, nextRetentionRoot)
+	return deletion, nil
+}
+
+// traverseExpiredBlockDataDeletion returns whether the given block, which is in the past of the
+// next retention root, is a header-only block that still has block data. The past of the
+// retention root isn't traversed, since its block data was already deleted.
+func (pm *pruningManager) traverseExpiredBlockDataDeletion(deletion *expiredBlockDataDeletion,
+	blockHash *externalapi.DomainHash) (isExpired bool, err error) {
+
+	stagingArea := deletion.readStagingArea
+	if blockHash.Equal(deletion.retentionRoot) {
+		return false, nil
+	}
+	isInPastOfRetentionRoot, err := pm.dagTopologyManager.IsAncestorOf(stagingArea, blockHash, deletion.retentionRoot)
+	if err != nil {
+		return false, err
+	}
+	if isInPastOfRetentionRoot {
+		return false, nil
+	}
+
+	err = pm.pushParentsToExpiredBlockDataDeletion(deletion, blockHash)
+	if err != nil {
+		return false, err
+	}
+
+	// Blocks that are kept for the sake of the current pruning point aren't header-only
+	status, err := pm.blockStatusStore.Get(pm.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return false, err
+	}
+	if status != externalapi.StatusHeaderOnly {
+		return false, nil
+	}
+	return pm.blocksStore.HasBlock(pm.databaseContext, stagingArea, blockHash)
+}
+
+func (pm *pruningManager) pushParentsToExpiredBlockDataDeletion(deletion *expiredBlockDataDeletion,
+	blockHash *externalapi.DomainHash) error {
+
+	parents, err := pm.dagTopologyManager.Parents(deletion.readStagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	if virtual.ContainsOnlyVirtualGenesis(parents) {
+		return nil
+	}
+	return deletion.queue.PushSlice(parents)
+}
@@ -0,0 +1,132 @@
+package pruningmanager_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/testutils"
+)
+
+func TestBlockDataRetention(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		// This is done to reduce the pruning depth to 12 blocks
+		finalityDepth := 5
+		consensusConfig.FinalityDuration = time.Duration(finalityDepth) * consensusConfig.TargetTimePerBlock
+		consensusConfig.K = 0
+		consensusConfig.DifficultyAdjustmentWindowSize = 5
+		consensusConfig.DisableDifficultyAdjustment = true
+
+		const retentionPruningPeriods = 2
+		consensusConfig.RetentionPruningPeriods = retentionPruningPeriods
+
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestBlockDataRetention")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		const chainLength = 100
+		chain := make([]*externalapi.DomainHash, 0, chainLength)
+		tipHash := consensusConfig.GenesisHash
+		for i := 0; i < chainLength; i++ {
+			tipHash, _, err = tc.AddBlock([]*externalapi.DomainHash{tipHash}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			chain = append(chain, tipHash)
+		}
+
+		// The block data of all the blocks is expected to be retained until it's deleted in the background
+		for i, blockHash := range chain {
+			_, found, err := tc.GetBlock(blockHash)
+			if err != nil {
+				t.Fatalf("GetBlock: %+v", err)
+			}
+			if !found {
+				t.Fatalf("Block %d in the chain was deleted before calling DeleteExpiredBlockData", i)
+			}
+		}
+
+		// Every call is expected to traverse only a few blocks, so the deletion takes several calls
+		const maxBlocksToTraverse = 3
+		callCount := 0
+		for {
+			callCount++
+			if callCount > chainLength {
+				t.Fatalf("DeleteExpiredBlockData didn't finish after %d calls", chainLength)
+			}
+			isCompletelyDeleted, err := tc.PruningManager().DeleteExpiredBlockData(maxBlocksToTraverse)
+			if err != nil {
+				t.Fatalf("DeleteExpiredBlockData: %+v", err)
+			}
+			if isCompletelyDeleted {
+				break
+			}
+		}
+		if callCount == 1 {
+			t.Fatalf("Expected the block data to be deleted in more than one call")
+		}
+
+		pruningPointHeaders, err := tc.PruningPointHeaders()
+		if err != nil {
+			t.Fatalf("PruningPointHeaders: %+v", err)
+		}
+		if len(pruningPointHeaders) <= retentionPruningPeriods {
+			t.Fatalf("Expected more than %d pruning points, got %d", retentionPruningPeriods, len(pruningPointHeaders))
+		}
+		expectedRetentionRoot := consensushashing.HeaderHash(
+			pruningPointHeaders[len(pruningPointHeaders)-1-retentionPruningPeriods])
+		retentionRoot, err := tc.RetentionRoot()
+		if err != nil {
+			t.Fatalf("RetentionRoot: %+v", err)
+		}
+		if !retentionRoot.Equal(expectedRetentionRoot) {
+			t.Fatalf("Unexpected retention root. Want: %s, got: %s", expectedRetentionRoot, retentionRoot)
+		}
+
+		// Only the header-only blocks in the past of the retention root are expected to be deleted
+		stagingArea := model.NewStagingArea()
+		deletedBlockCount := 0
+		for i, blockHash := range chain {
+			isInPastOfRetentionRoot, err := tc.DAGTopologyManager().IsAncestorOf(stagingArea, blockHash, retentionRoot)
+			if err != nil {
+				t.Fatalf("IsAncestorOf: %+v", err)
+			}
+			status, err := tc.BlockStatusStore().Get(tc.DatabaseContext(), stagingArea, blockHash)
+			if err != nil {
+				t.Fatalf("BlockStatusStore.Get: %+v", err)
+			}
+			_, found, err := tc.GetBlock(blockHash)
+			if err != nil {
+				t.Fatalf("GetBlock: %+v", err)
+			}
+
+			isInPastOfRetentionRoot = isInPastOfRetentionRoot && !blockHash.Equal(retentionRoot)
+			expectedFound := !isInPastOfRetentionRoot || status != externalapi.StatusHeaderOnly
+			if found != expectedFound {
+				t.Fatalf("Unexpected block data presence of block %d in the chain with status %s. Want: %t, got: %t",
+					i, status, expectedFound, found)
+			}
+			if !found {
+				deletedBlockCount++
+			}
+		}
+		if deletedBlockCount == 0 {
+			t.Fatalf("Expected the block data of some of the blocks to be deleted")
+		}
+
+		// The retention root is already up to date, so nothing is expected to be deleted
+		isCompletelyDeleted, err := tc.DeleteExpiredBlockData()
+		if err != nil {
+			t.Fatalf("DeleteExpiredBlockData: %+v", err)
+		}
+		if !isCompletelyDeleted {
+			t.Fatalf("Expected no block data to be left for deletion")
+		}
+	})
+}
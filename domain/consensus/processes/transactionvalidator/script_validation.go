@@ -0,0 +1,179 @@
+package transactionvalidator
+
+import (
+	"sync"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/pkg/errors"
+)
+
+// minInputsForParallelScriptValidation is the minimal amount of inputs for which
+// it's worth dispatching the script validation to the script validation workers
+const minInputsForParallelScriptValidation = 2
+
+// scriptValidationJob is a range of a transaction's inputs whose scripts are validated
+// by a single worker, so that they could share the same SighashReusedValues
+type scriptValidationJob struct {
+	tx          *externalapi.DomainTransaction
+	startIndex  int
+	endIndex    int
+	inputErrors []error
+}
+
+func (v *transactionValidator) validateTransactionScripts(tx *externalapi.DomainTransaction) error {
+	return v.validateTransactionsScripts([]*externalapi.DomainTransaction{tx})[0]
+}
+
+// validateTransactionsScripts validates the input scripts of all the given transactions,
+// and returns an error per transaction.
+//
+// The inputs are validated in parallel, so the error of every transaction is picked
+// only once all of its inputs were validated. This way it's the same error the sequential
+// validation would have returned: the error of the first input whose script is invalid,
+// or ErrMissingTxOut if the scripts of all the inputs that have UTXO entries are valid.
+func (v *transactionValidator) validateTransactionsScripts(txs []*externalapi.DomainTransaction) []error {
+	inputErrors := make([][]error, len(txs))
+	inputCount := 0
+	for i, tx := range txs {
+		inputErrors[i] = make([]error, len(tx.Inputs))
+		inputCount += len(tx.Inputs)
+	}
+
+	if v.scriptValidationWorkerCount <= 1 || inputCount < minInputsForParallelScriptValidation {
+		for i, tx := range txs {
+			v.validateInputScripts(tx, 0, len(tx.Inputs), inputErrors[i])
+		}
+	} else {
+		v.runScriptValidationJobs(v.scriptValidationJobs(txs, inputErrors))
+	}
+
+	errs := make([]error, len(txs))
+	for i, tx := range txs {
+		errs[i] = transactionScriptsError(tx, inputErrors[i])
+	}
+	return errs
+}
+
+// scriptValidationJobs splits the inputs of every transaction to up to
+// scriptValidationWorkerCount jobs
+func (v *transactionValidator) scriptValidationJobs(txs []*externalapi.DomainTransaction,
+	inputErrors [][]error) []*scriptValidationJob {
+
+	jobs := make([]*scriptValidationJob, 0, len(txs))
+	for i, tx := range txs {
+		if len(tx.Inputs) == 0 {
+			continue
+		}
+		inputsPerJob := (len(tx.Inputs) + v.scriptValidationWorkerCount - 1) / v.scriptValidationWorkerCount
+		for startIndex := 0; startIndex < len(tx.Inputs); startIndex += inputsPerJob {
+			endIndex := startIndex + inputsPerJob
+			if endIndex > len(tx.Inputs) {
+				endIndex = len(tx.Inputs)
+			}
+			jobs = append(jobs, &scriptValidationJob{
+				tx:          tx,
+				startIndex:  startIndex,
+				endIndex:    endIndex,
+				inputErrors: inputErrors[i],
+			})
+		}
+	}
+	return jobs
+}
+
+// runScriptValidationJobs runs the given jobs on up to scriptValidationWorkerCount
+// workers, and returns once all of them are done
+func (v *transactionValidator) runScriptValidationJobs(jobs []*scriptValidationJob) {
+	jobsChan := make(chan *scriptValidationJob, len(jobs))
+	for _, job := range jobs {
+		jobsChan <- job
+	}
+	close(jobsChan)
+
+	workerCount := v.scriptValidationWorkerCount
+	if len(jobs) < workerCount {
+		workerCount = len(jobs)
+	}
+
+	waitGroup := sync.WaitGroup{}
+	waitGroup.Add(workerCount)
+	for i := 0; i < workerCount; i++ {
+		spawn("scriptValidationWorker", func() {
+			defer waitGroup.Done()
+			for job := range jobsChan {
+				v.validateInputScripts(job.tx, job.startIndex, job.endIndex, job.inputErrors)
+			}
+		})
+	}
+	waitGroup.Wait()
+}
+
+// validateInputScripts validates the scripts of the inputs in the range [startIndex, endIndex)
+// and writes their errors to inputErrors. Inputs with no UTXO entry are skipped, and the
+// validation stops at the first invalid input, since the errors of the inputs after it are
+// never reported.
+func (v *transactionValidator) validateInputScripts(tx *externalapi.DomainTransaction,
+	startIndex int, endIndex int, inputErrors []error) {
+
+	sighashReusedValues := &consensushashing.SighashReusedValues{}
+	for i := startIndex; i < endIndex; i++ {
+		if tx.Inputs[i].UTXOEntry == nil {
+			continue
+		}
+		inputErrors[i] = v.validateInputScript(tx, i, sighashReusedValues)
+		if inputErrors[i] != nil {
+			return
+		}
+	}
+}
+
+func (v *transactionValidator) validateInputScript(tx *externalapi.DomainTransaction, inputIndex int,
+	sighashReusedValues *consensushashing.SighashReusedValues) error {
+
+	// Create a new script engine for the script pair.
+	input := tx.Inputs[inputIndex]
+	sigScript := input.SignatureScript
+	scriptPubKey := input.UTXOEntry.ScriptPublicKey()
+	vm, err := txscript.NewEngine(scriptPubKey, tx, inputIndex, txscript.ScriptNoFlags, v.sigCache, v.sigCacheECDSA, sighashReusedValues)
+	if err != nil {
+		return errors.Wrapf(ruleerrors.ErrScriptMalformed, "failed to parse input "+
+			"%d which references output %s - "+
+			"%s (input script bytes %x, prev "+
+			"output script bytes %x)",
+			inputIndex,
+			input.PreviousOutpoint, err, sigScript, scriptPubKey)
+	}
+
+	// Execute the script pair.
+	if err := vm.Execute(); err != nil {
+		return errors.Wrapf(ruleerrors.ErrScriptValidation, "failed to validate input "+
+			"%d which references output %s - "+
+			"%s (input script bytes %x, prev output "+
+			"script bytes %x)",
+			inputIndex,
+			input.PreviousOutpoint, err, sigScript, scriptPubKey)
+	}
+
+	return nil
+}
+
+// transactionScriptsError returns the error of the first invalid input of the given transaction,
+// or ErrMissingTxOut if some of its inputs have no UTXO entry
+func transactionScriptsError(tx *externalapi.DomainTransaction, inputErrors []error) error {
+	var missingOutpoints []*externalapi.DomainOutpoint
+	for i, input := range tx.Inputs {
+		if inputErrors[i] != nil {
+			return inputErrors[i]
+		}
+		if input.UTXOEntry == nil {
+			missingOutpoints = append(missingOutpoints, &input.PreviousOutpoint)
+		}
+	}
+	if len(missingOutpoints) > 0 {
+		return ruleerrors.NewErrMissingTxOut(missingOutpoints)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package transactionvalidator_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/kaspanet/go-secp256k1"
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/subnetworks"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/testutils"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/util"
+	"github.com/pkg/errors"
+)
+
+func TestValidateTransactionsInContextAndPopulateFee(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestValidateTransactionsInContextAndPopulateFee")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		// Disable the sig cache, so that every validation actually verifies the signatures
+		tc.TransactionValidator().SetSigCache(txscript.NewSigCache(0))
+
+		const transactionCount = 5
+		const inputsPerTransaction = 8
+		txs := createSignedTransactionsForTest(t, consensusConfig.Prefix, transactionCount, inputsPerTransaction)
+
+		corruptSignature := func(input *externalapi.DomainTransactionInput) {
+			input.SignatureScript[1] ^= 0xff
+		}
+		corruptSignature(txs[1].Inputs[5])
+		// Only the error of the first invalid input is expected to be reported
+		corruptSignature(txs[2].Inputs[6])
+		corruptSignature(txs[2].Inputs[2])
+		corruptSignature(txs[3].Inputs[inputsPerTransaction-1])
+
+		expectedInvalidInputs := map[int]int{1: 5, 2: 2, 3: inputsPerTransaction - 1}
+
+		stagingArea := model.NewStagingArea()
+		validateSequentially := func() []error {
+			errs := make([]error, len(txs))
+			tc.TransactionValidator().SetScriptValidationWorkerCount(1)
+			for i, tx := range txs {
+				errs[i] = tc.TransactionValidator().ValidateTransactionInContextAndPopulateFee(
+					stagingArea, tx, consensusConfig.GenesisHash)
+			}
+			return errs
+		}
+		validateInBatch := func(scriptValidationWorkerCount int) []error {
+			tc.TransactionValidator().SetScriptValidationWorkerCount(scriptValidationWorkerCount)
+			return tc.TransactionValidator().ValidateTransactionsInContextAndPopulateFee(
+				stagingArea, txs, consensusConfig.GenesisHash)
+		}
+
+		sequentialErrs := validateSequentially()
+		for i, err := range sequentialErrs {
+			invalidInputIndex, isInvalid := expectedInvalidInputs[i]
+			if !isInvalid {
+				if err != nil {
+					t.Fatalf("Unexpected error in transaction %d: %+v", i, err)
+				}
+				continue
+			}
+			if !errors.Is(err, ruleerrors.ErrScriptValidation) {
+				t.Fatalf("Expected ErrScriptValidation in transaction %d, got: %+v", i, err)
+			}
+			expectedErrPrefix := fmt.Sprintf("failed to validate input %d ", invalidInputIndex)
+			if err.Error()[:len(expectedErrPrefix)] != expectedErrPrefix {
+				t.Fatalf("Expected the error of transaction %d to start with '%s', got: %s",
+					i, expectedErrPrefix, err)
+			}
+		}
+
+		for _, scriptValidationWorkerCount := range []int{1, 2, 3, inputsPerTransaction, 4 * inputsPerTransaction} {
+			errs := validateInBatch(scriptValidationWorkerCount)
+			if len(errs) != len(txs) {
+				t.Fatalf("Expected %d errors, got %d", len(txs), len(errs))
+			}
+			for i, err := range errs {
+				if (err == nil) != (sequentialErrs[i] == nil) ||
+					(err != nil && err.Error() != sequentialErrs[i].Error()) {
+
+					t.Fatalf("Unexpected error in transaction %d with %d script validation workers. "+
+						"Want: %v, got: %v", i, scriptValidationWorkerCount, sequentialErrs[i], err)
+				}
+			}
+		}
+	})
+}
+
+func BenchmarkValidateTransactionsInContextAndPopulateFee(b *testing.B) {
+	consensusConfig := &consensus.Config{Params: dagconfig.DevnetParams}
+	factory := consensus.NewFactory()
+	tc, teardown, err := factory.NewTestConsensus(consensusConfig, "BenchmarkValidateTransactionsInContextAndPopulateFee")
+	if err != nil {
+		b.Fatalf("Error setting up consensus: %+v", err)
+	}
+	defer teardown(false)
+
+	// Disable the sig cache, so that every iteration actually verifies the signatures
+	tc.TransactionValidator().SetSigCache(txscript.NewSigCache(0))
+
+	const transactionCount = 100
+	const inputsPerTransaction = 4
+	txs := createSignedTransactionsForTest(b, consensusConfig.Prefix, transactionCount, inputsPerTransaction)
+	stagingArea := model.NewStagingArea()
+
+	benchmarks := []struct {
+		name                        string
+		scriptValidationWorkerCount int
+	}{
+		{name: "sequential", scriptValidationWorkerCount: 1},
+		{name: "parallel", scriptValidationWorkerCount: runtime.GOMAXPROCS(0)},
+	}
+	for _, benchmark := range benchmarks {
+		scriptValidationWorkerCount := benchmark.scriptValidationWorkerCount
+		b.Run(benchmark.name, func(b *testing.B) {
+			tc.TransactionValidator().SetScriptValidationWorkerCount(scriptValidationWorkerCount)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				errs := tc.TransactionValidator().ValidateTransactionsInContextAndPopulateFee(
+					stagingArea, txs, consensusConfig.GenesisHash)
+				for _, err := range errs {
+					if err != nil {
+						b.Fatalf("ValidateTransactionsInContextAndPopulateFee: %+v", err)
+					}
+				}
+			}
+		})
+	}
+}
+
+// createSignedTransactionsForTest creates transactions whose inputs spend made up
+// pay-to-pubkey UTXO entries, and are all validly signed
+func createSignedTransactionsForTest(tb testing.TB, prefix util.Bech32Prefix,
+	transactionCount int, inputsPerTransaction int) []*externalapi.DomainTransaction {
+
+	privateKey, err := secp256k1.GenerateSchnorrKeyPair()
+	if err != nil {
+		tb.Fatalf("Failed to generate a private key: %v", err)
+	}
+	publicKey, err := privateKey.SchnorrPublicKey()
+	if err != nil {
+		tb.Fatalf("Failed to generate a public key: %v", err)
+	}
+	publicKeySerialized, err := publicKey.Serialize()
+	if err != nil {
+		tb.Fatalf("Failed to serialize public key: %v", err)
+	}
+	addr, err := util.NewAddressPublicKey(publicKeySerialized[:], prefix)
+	if err != nil {
+		tb.Fatalf("Failed to generate p2pk address: %v", err)
+	}
+	scriptPublicKey, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		tb.Fatalf("PayToAddrScript: unexpected error: %v", err)
+	}
+
+	txs := make([]*externalapi.DomainTransaction, transactionCount)
+	for i := range txs {
+		inputs := make([]*externalapi.DomainTransactionInput, inputsPerTransaction)
+		for j := range inputs {
+			var previousTransactionIDBytes [externalapi.DomainHashSize]byte
+			previousTransactionIDBytes[0] = byte(i)
+			previousTransactionIDBytes[1] = byte(j)
+			previousTransactionID := externalapi.NewDomainTransactionIDFromByteArray(&previousTransactionIDBytes)
+			inputs[j] = &externalapi.DomainTransactionInput{
+				PreviousOutpoint: externalapi.DomainOutpoint{TransactionID: *previousTransactionID},
+				Sequence:         constants.MaxTxInSequenceNum,
+				SigOpCount:       1,
+				UTXOEntry:        utxo.NewUTXOEntry(100, scriptPublicKey, false, 0),
+			}
+		}
+		txs[i] = &externalapi.DomainTransaction{
+			Version: constants.MaxTransactionVersion,
+			Inputs:  inputs,
+			Outputs: []*externalapi.DomainTransactionOutput{{
+				Value:           1,
+				ScriptPublicKey: &externalapi.ScriptPublicKey{Script: nil, Version: 0},
+			}},
+			SubnetworkID: subnetworks.SubnetworkIDNative,
+		}
+
+		sighashReusedValues := &consensushashing.SighashReusedValues{}
+		for j, input := range txs[i].Inputs {
+			input.SignatureScript, err = txscript.SignatureScript(txs[i], j, consensushashing.SigHashAll, privateKey,
+				sighashReusedValues)
+			if err != nil {
+				tb.Fatalf("Failed to create a sigScript: %v", err)
+			}
+		}
+	}
+
+	return txs
+}
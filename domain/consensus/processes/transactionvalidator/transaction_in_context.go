@@ -6,7 +6,6 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
-	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/transactionhelper"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
@@ -68,39 +67,66 @@ func (v *transactionValidator) ValidateTransactionInContextIgnoringUTXO(stagingA
 func (v *transactionValidator) ValidateTransactionInContextAndPopulateFee(stagingArea *model.StagingArea,
 	tx *externalapi.DomainTransaction, povBlockHash *externalapi.DomainHash) error {
 
-	err := v.checkTransactionCoinbaseMaturity(stagingArea, povBlockHash, tx)
+	err := v.validateTransactionInContextIgnoringScriptsAndPopulateFee(stagingArea, tx, povBlockHash)
 	if err != nil {
 		return err
 	}
 
-	totalSompiIn, err := v.checkTransactionInputAmounts(tx)
-	if err != nil {
-		return err
+	return v.validateTransactionScripts(tx)
+}
+
+// ValidateTransactionsInContextAndPopulateFee validates the given transactions against their referenced
+// UTXO, and populates their fee fields. The input scripts of all the transactions are validated together
+// by the script validation workers. The returned errors are ordered by the transactions, and each of them
+// is the same error that ValidateTransactionInContextAndPopulateFee would have returned for its transaction.
+func (v *transactionValidator) ValidateTransactionsInContextAndPopulateFee(stagingArea *model.StagingArea,
+	txs []*externalapi.DomainTransaction, povBlockHash *externalapi.DomainHash) []error {
+
+	errs := make([]error, len(txs))
+	txsToValidateScripts := make([]*externalapi.DomainTransaction, 0, len(txs))
+	txsToValidateScriptsIndexes := make([]int, 0, len(txs))
+	for i, tx := range txs {
+		errs[i] = v.validateTransactionInContextIgnoringScriptsAndPopulateFee(stagingArea, tx, povBlockHash)
+		if errs[i] == nil {
+			txsToValidateScripts = append(txsToValidateScripts, tx)
+			txsToValidateScriptsIndexes = append(txsToValidateScriptsIndexes, i)
+		}
 	}
 
-	totalSompiOut, err := v.checkTransactionOutputAmounts(tx, totalSompiIn)
+	scriptErrs := v.validateTransactionsScripts(txsToValidateScripts)
+	for i, scriptErr := range scriptErrs {
+		errs[txsToValidateScriptsIndexes[i]] = scriptErr
+	}
+
+	return errs
+}
+
+func (v *transactionValidator) validateTransactionInContextIgnoringScriptsAndPopulateFee(stagingArea *model.StagingArea,
+	tx *externalapi.DomainTransaction, povBlockHash *externalapi.DomainHash) error {
+
+	err := v.checkTransactionCoinbaseMaturity(stagingArea, povBlockHash, tx)
 	if err != nil {
 		return err
 	}
 
-	tx.Fee = totalSompiIn - totalSompiOut
-
-	err = v.checkTransactionSequenceLock(stagingArea, povBlockHash, tx)
+	totalSompiIn, err := v.checkTransactionInputAmounts(tx)
 	if err != nil {
 		return err
 	}
 
-	err = v.validateTransactionSigOpCounts(tx)
+	totalSompiOut, err := v.checkTransactionOutputAmounts(tx, totalSompiIn)
 	if err != nil {
 		return err
 	}
 
-	err = v.validateTransactionScripts(tx)
+	tx.Fee = totalSompiIn - totalSompiOut
+
+	err = v.checkTransactionSequenceLock(stagingArea, povBlockHash, tx)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return v.validateTransactionSigOpCounts(tx)
 }
 
 func (v *transactionValidator) checkTransactionCoinbaseMaturity(stagingArea *model.StagingArea,
@@ -225,46 +251,6 @@ func (v *transactionValidator) checkTransactionSequenceLock(stagingArea *model.S
 	return nil
 }
 
-func (v *transactionValidator) validateTransactionScripts(tx *externalapi.DomainTransaction) error {
-	var missingOutpoints []*externalapi.DomainOutpoint
-	sighashReusedValues := &consensushashing.SighashReusedValues{}
-
-	for i, input := range tx.Inputs {
-		// Create a new script engine for the script pair.
-		sigScript := input.SignatureScript
-		utxoEntry := input.UTXOEntry
-		if utxoEntry == nil {
-			missingOutpoints = append(missingOutpoints, &input.PreviousOutpoint)
-			continue
-		}
-
-		scriptPubKey := utxoEntry.ScriptPublicKey()
-		vm, err := txscript.NewEngine(scriptPubKey, tx, i, txscript.ScriptNoFlags, v.sigCache, v.sigCacheECDSA, sighashReusedValues)
-		if err != nil {
-			return errors.Wrapf(ruleerrors.ErrScriptMalformed, "failed to parse input "+
-				"%d which references output %s - "+
-				"%s (input script bytes %x, prev "+
-				"output script bytes %x)",
-				i,
-				input.PreviousOutpoint, err, sigScript, scriptPubKey)
-		}
-
-		// Execute the script pair.
-		if err := vm.Execute(); err != nil {
-			return errors.Wrapf(ruleerrors.ErrScriptValidation, "failed to validate input "+
-				"%d which references output %s - "+
-				"%s (input script bytes %x, prev output "+
-				"script bytes %x)",
-				i,
-				input.PreviousOutpoint, err, sigScript, scriptPubKey)
-		}
-	}
-	if len(missingOutpoints) > 0 {
-		return ruleerrors.NewErrMissingTxOut(missingOutpoints)
-	}
-	return nil
-}
-
 func (v *transactionValidator) calcTxSequenceLockFromReferencedUTXOEntries(stagingArea *model.StagingArea,
 	povBlockHash *externalapi.DomainHash, tx *externalapi.DomainTransaction) (*sequenceLock, error) {
 
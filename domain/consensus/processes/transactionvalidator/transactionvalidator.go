@@ -1,6 +1,8 @@
 package transactionvalidator
 
 import (
+	"runtime"
+
 	"github.com/kaspanet/kaspad/domain/consensus/model"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
@@ -23,6 +25,7 @@ type transactionValidator struct {
 	coinbasePayloadScriptPublicKeyMaxLength uint8
 	sigCache                                *txscript.SigCache
 	sigCacheECDSA                           *txscript.SigCacheECDSA
+	scriptValidationWorkerCount             int
 	txMassCalculator                        *txmass.Calculator
 }
 
@@ -50,6 +53,7 @@ func New(blockCoinbaseMaturity uint64,
 		daaBlocksStore:                          daaBlocksStore,
 		sigCache:                                txscript.NewSigCache(sigCacheSize),
 		sigCacheECDSA:                           txscript.NewSigCacheECDSA(sigCacheSize),
+		scriptValidationWorkerCount:             runtime.NumCPU(),
 		txMassCalculator:                        txMassCalculator,
 	}
 }
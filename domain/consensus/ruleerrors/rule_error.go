@@ -209,6 +209,10 @@ var (
 
 	ErrSuggestedPruningViolatesFinality = newRuleError("ErrSuggestedPruningViolatesFinality")
 
+	// ErrUnresolvableFinalityConflict indicates that a finality conflict can't be
+	// resolved in favor of the requested finality block
+	ErrUnresolvableFinalityConflict = newRuleError("ErrUnresolvableFinalityConflict")
+
 	//ErrBlockVersionIsUnknown indicates that the block version is unknown.
 	ErrBlockVersionIsUnknown = newRuleError("ErrBlockVersionIsUnknown")
 
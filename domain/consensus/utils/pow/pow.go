@@ -61,6 +61,14 @@ func (state *State) CalculateProofOfWorkValue() *big.Int {
 	return toBig(heavyHash)
 }
 
+// PrePowHash returns the hash of the header with its timestamp and nonce zeroed
+// out. It's the part of the header that remote miners hash along with the
+// timestamp and the nonce, and the seed of the HeavyHash matrix
+func (state *State) PrePowHash() *externalapi.DomainHash {
+	prePowHash := state.prePowHash
+	return &prePowHash
+}
+
 // IncrementNonce the nonce in State by 1
 func (state *State) IncrementNonce() {
 	state.Nonce++
@@ -0,0 +1,128 @@
+package testutils
+
+import (
+	"encoding/json"
+	"math/rand"
+	"sort"
+	"strconv"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/model/testapi"
+	"github.com/pkg/errors"
+)
+
+// DAGShape describes the structure of a DAG regardless of the contents of its blocks.
+// The blocks are identified by their index, where block 0 is the genesis, and the
+// parents of every block are blocks with lower indexes.
+type DAGShape struct {
+	// Parents holds the parents of every block, where Parents[0] is always empty
+	Parents [][]int
+}
+
+// BlockCount returns the amount of blocks in the shape, including the genesis
+func (shape *DAGShape) BlockCount() int {
+	return len(shape.Parents)
+}
+
+// GenerateRandomDAGShape generates a DAGShape with blockCount blocks on top of the genesis.
+// Every block points to between 1 and maxParents parents, chosen among the latest
+// parentsWindowSize blocks such that none of them is in the past of another. This
+// resembles the DAG of a network that propagates blocks with random delays.
+// Both maxParents and parentsWindowSize must be positive, since otherwise blocks
+// would have no parents.
+func GenerateRandomDAGShape(random *rand.Rand, blockCount int, maxParents int, parentsWindowSize int) *DAGShape {
+	if maxParents < 1 || parentsWindowSize < 1 {
+		panic(errors.Errorf("maxParents (%d) and parentsWindowSize (%d) must be positive", maxParents, parentsWindowSize))
+	}
+
+	shape := &DAGShape{Parents: make([][]int, blockCount+1)}
+	pasts := make([]map[int]struct{}, blockCount+1)
+	pasts[0] = map[int]struct{}{}
+
+	isInPast := func(block, other int) bool {
+		_, ok := pasts[other][block]
+		return ok
+	}
+
+	for block := 1; block <= blockCount; block++ {
+		lowestCandidate := block - parentsWindowSize
+		if lowestCandidate < 0 {
+			lowestCandidate = 0
+		}
+		candidates := random.Perm(block - lowestCandidate)
+		parentCount := 1 + random.Intn(maxParents)
+
+		parents := make([]int, 0, parentCount)
+		for _, candidateOffset := range candidates {
+			if len(parents) == parentCount {
+				break
+			}
+			candidate := lowestCandidate + candidateOffset
+			isInAnticoneOfParents := true
+			for _, parent := range parents {
+				if isInPast(candidate, parent) || isInPast(parent, candidate) {
+					isInAnticoneOfParents = false
+					break
+				}
+			}
+			if isInAnticoneOfParents {
+				parents = append(parents, candidate)
+			}
+		}
+		sort.Ints(parents)
+		shape.Parents[block] = parents
+
+		pasts[block] = map[int]struct{}{}
+		for _, parent := range parents {
+			pasts[block][parent] = struct{}{}
+			for ancestor := range pasts[parent] {
+				pasts[block][ancestor] = struct{}{}
+			}
+		}
+	}
+
+	return shape
+}
+
+// AddDAGShapeToConsensus adds the blocks of the given shape on top of the genesis of the given
+// consensus, and returns the hashes of all the blocks in the shape by their index
+func AddDAGShapeToConsensus(tc testapi.TestConsensus, shape *DAGShape) ([]*externalapi.DomainHash, error) {
+	blockHashes := make([]*externalapi.DomainHash, shape.BlockCount())
+	blockHashes[0] = tc.DAGParams().GenesisHash
+	for block := 1; block < shape.BlockCount(); block++ {
+		parentHashes := make([]*externalapi.DomainHash, len(shape.Parents[block]))
+		for i, parent := range shape.Parents[block] {
+			parentHashes[i] = blockHashes[parent]
+		}
+
+		var err error
+		blockHashes[block], _, err = tc.AddBlock(parentHashes, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return blockHashes, nil
+}
+
+// ToJSON returns the shape in the JSON format that's accepted by TestConsensus.MineJSON
+func (shape *DAGShape) ToJSON() string {
+	type jsonBlock struct {
+		ID      string   `json:"id"`
+		Parents []string `json:"parents"`
+	}
+
+	jsonBlocks := make([]jsonBlock, shape.BlockCount())
+	for block, parents := range shape.Parents {
+		jsonBlocks[block] = jsonBlock{ID: strconv.Itoa(block), Parents: make([]string, len(parents))}
+		for i, parent := range parents {
+			jsonBlocks[block].Parents[i] = strconv.Itoa(parent)
+		}
+	}
+
+	serialized, err := json.Marshal(jsonBlocks)
+	if err != nil {
+		// This can't happen, since all the fields are strings
+		panic(err)
+	}
+	return string(serialized)
+}
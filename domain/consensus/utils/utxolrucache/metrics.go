@@ -0,0 +1,12 @@
+package utxolrucache
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
+)
+
+var (
+	cacheHits = metrics.NewCounter("kaspad_utxo_cache_hits_total",
+		"The number of UTXO entry lookups that were found in the UTXO cache")
+	cacheMisses = metrics.NewCounter("kaspad_utxo_cache_misses_total",
+		"The number of UTXO entry lookups that were not found in the UTXO cache")
+)
@@ -38,8 +38,10 @@ func (c *LRUCache) Add(key *externalapi.DomainOutpoint, value externalapi.UTXOEn
 func (c *LRUCache) Get(key *externalapi.DomainOutpoint) (externalapi.UTXOEntry, bool) {
 	value, ok := c.cache[*key]
 	if !ok {
+		cacheMisses.Inc()
 		return nil, false
 	}
+	cacheHits.Inc()
 	return value, true
 }
 
@@ -0,0 +1,548 @@
+package consensus
+
+import (
+	"fmt"
+
+	"github.com/kaspanet/kaspad/domain/consensus/database"
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/multiset"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/util/staging"
+	"github.com/pkg/errors"
+)
+
+// verifyDatabaseProgressInterval is the amount of blocks between
+// progress reports while verifying the database
+const verifyDatabaseProgressInterval = 10_000
+
+// VerifyDatabase checks the invariants of the data that consensus keeps about the pruning
+// point, its anticone and their future, as well as the virtual and pruning point UTXO sets.
+// When shouldRepair is set, GHOSTDAG data that is found to be inconsistent is rewritten. No other
+// data is repaired, so if the selected parent or the merge set of a block change by the repair, the
+// data that was derived from them is reported as an unrepaired issue.
+// VerifyDatabase is meant to run while the node is offline, since it holds the consensus lock
+// for its entire duration.
+func (s *consensus) VerifyDatabase(shouldRepair bool) (*externalapi.DatabaseVerificationReport, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "VerifyDatabase")
+	defer onEnd()
+
+	verifier := &databaseVerifier{
+		consensus:    s,
+		shouldRepair: shouldRepair,
+		report:       &externalapi.DatabaseVerificationReport{},
+	}
+
+	err := verifier.verifyBlocks()
+	if err != nil {
+		return nil, err
+	}
+	err = verifier.verifyUTXOSets()
+	if err != nil {
+		return nil, err
+	}
+
+	return verifier.report, nil
+}
+
+type databaseVerifier struct {
+	consensus    *consensus
+	shouldRepair bool
+	report       *externalapi.DatabaseVerificationReport
+}
+
+func (v *databaseVerifier) addIssue(check externalapi.DatabaseVerificationCheck, blockHash *externalapi.DomainHash,
+	format string, args ...interface{}) *externalapi.DatabaseVerificationIssue {
+
+	issue := &externalapi.DatabaseVerificationIssue{
+		Check:       check,
+		BlockHash:   blockHash,
+		Description: fmt.Sprintf(format, args...),
+	}
+	if blockHash != nil {
+		log.Warnf("Found a %s issue in block %s: %s", check, blockHash, issue.Description)
+	} else {
+		log.Warnf("Found a %s issue: %s", check, issue.Description)
+	}
+	v.report.Issues = append(v.report.Issues, issue)
+	return issue
+}
+
+// verifyBlocks goes over the pruning point, its anticone and their future from
+// the bottom up, so that the parents of a block are usually verified (and if
+// required, repaired) before it
+func (v *databaseVerifier) verifyBlocks() error {
+	s := v.consensus
+	stagingArea := model.NewStagingArea()
+
+	pruningPointAndItsAnticone, err := s.pruningManager.PruningPointAndItsAnticone()
+	if err != nil {
+		return err
+	}
+	roots := make(map[externalapi.DomainHash]struct{}, len(pruningPointAndItsAnticone))
+	for _, blockHash := range pruningPointAndItsAnticone {
+		roots[*blockHash] = struct{}{}
+	}
+
+	queue := s.dagTraversalManager.NewUpHeap(stagingArea)
+	err = queue.PushSlice(pruningPointAndItsAnticone)
+	if err != nil {
+		return err
+	}
+
+	visited := make(map[externalapi.DomainHash]struct{})
+	for queue.Len() > 0 {
+		current := queue.Pop()
+		if _, ok := visited[*current]; ok {
+			continue
+		}
+		visited[*current] = struct{}{}
+
+		// The data of the pruning point and its anticone is either trusted data from
+		// the syncer, or depends on blocks that were already pruned, so only the data
+		// that doesn't depend on their past is verified
+		_, isRoot := roots[*current]
+		err := v.verifyBlock(stagingArea, current, isRoot)
+		if err != nil {
+			return err
+		}
+
+		v.report.VerifiedBlockCount++
+		if v.report.VerifiedBlockCount%verifyDatabaseProgressInterval == 0 {
+			log.Infof("Verified %d blocks", v.report.VerifiedBlockCount)
+		}
+
+		children, err := s.dagTopologyManagers[0].Children(stagingArea, current)
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			if child.Equal(model.VirtualBlockHash) {
+				continue
+			}
+			if _, ok := visited[*child]; ok {
+				continue
+			}
+			err := queue.Push(child)
+			if err != nil {
+				if !errors.Is(err, database.ErrNotFound) {
+					return err
+				}
+				// A block without GHOSTDAG data can't be ordered, so its future isn't verified
+				v.addIssue(externalapi.DatabaseVerificationCheckGHOSTDAG, child,
+					"the block has no GHOSTDAG data, so its future was not verified")
+				visited[*child] = struct{}{}
+			}
+		}
+	}
+	log.Infof("Verified %d blocks", v.report.VerifiedBlockCount)
+
+	return nil
+}
+
+func (v *databaseVerifier) verifyBlock(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash,
+	isRoot bool) error {
+
+	status, hasStatus, err := v.verifyBlockStatus(stagingArea, blockHash, isRoot)
+	if err != nil {
+		return err
+	}
+
+	if !isRoot {
+		err = v.verifyGHOSTDAGData(stagingArea, blockHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = v.verifyReachabilityData(stagingArea, blockHash, isRoot)
+	if err != nil {
+		return err
+	}
+
+	if !isRoot && hasStatus && status == externalapi.StatusUTXOValid {
+		err = v.verifyUTXOData(stagingArea, blockHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyBlockStatus makes sure that the status of the block agrees with the data
+// that is stored for it
+func (v *databaseVerifier) verifyBlockStatus(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash,
+	isRoot bool) (status externalapi.BlockStatus, hasStatus bool, err error) {
+
+	s := v.consensus
+	hasStatus, err = s.blockStatusStore.Exists(s.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return 0, false, err
+	}
+	if !hasStatus {
+		v.addIssue(externalapi.DatabaseVerificationCheckBlockStatus, blockHash, "the block has no status")
+		return 0, false, nil
+	}
+
+	status, err = s.blockStatusStore.Get(s.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return 0, false, err
+	}
+
+	switch status {
+	case externalapi.StatusInvalid:
+		v.addIssue(externalapi.DatabaseVerificationCheckBlockStatus, blockHash,
+			"the block is invalid, but it's part of the DAG")
+
+	case externalapi.StatusUTXOValid, externalapi.StatusUTXOPendingVerification, externalapi.StatusDisqualifiedFromChain:
+		hasBlock, err := s.blockStore.HasBlock(s.databaseContext, stagingArea, blockHash)
+		if err != nil {
+			return 0, false, err
+		}
+		if !hasBlock {
+			v.addIssue(externalapi.DatabaseVerificationCheckBlockStatus, blockHash,
+				"the block has status %s, but its body is missing", status)
+		}
+
+		if status == externalapi.StatusUTXOValid && !isRoot {
+			_, err := s.acceptanceDataStore.Get(s.databaseContext, stagingArea, blockHash)
+			if err != nil {
+				if !errors.Is(err, database.ErrNotFound) {
+					return 0, false, err
+				}
+				v.addIssue(externalapi.DatabaseVerificationCheckBlockStatus, blockHash,
+					"the block has status %s, but its acceptance data is missing", status)
+			}
+		}
+	}
+
+	return status, true, nil
+}
+
+// verifyGHOSTDAGData compares the stored GHOSTDAG data of the block against
+// GHOSTDAG data that is recomputed from its parents
+func (v *databaseVerifier) verifyGHOSTDAGData(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash) error {
+	s := v.consensus
+	storedGHOSTDAGData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, blockHash, false)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return err
+	}
+
+	recomputationStagingArea := model.NewStagingArea()
+	err = s.ghostdagManagers[0].GHOSTDAG(recomputationStagingArea, blockHash)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		v.report.UnverifiableGHOSTDAGCount++
+		return nil
+	}
+	recomputedGHOSTDAGData, err := s.ghostdagDataStores[0].Get(s.databaseContext, recomputationStagingArea, blockHash, false)
+	if err != nil {
+		return err
+	}
+
+	var issue *externalapi.DatabaseVerificationIssue
+	if storedGHOSTDAGData == nil {
+		issue = v.addIssue(externalapi.DatabaseVerificationCheckGHOSTDAG, blockHash, "the block has no GHOSTDAG data")
+	} else if !areGHOSTDAGDataEqual(storedGHOSTDAGData, recomputedGHOSTDAGData) {
+		issue = v.addIssue(externalapi.DatabaseVerificationCheckGHOSTDAG, blockHash,
+			"the stored GHOSTDAG data (selected parent %s, blue score %d) doesn't match the recomputed "+
+				"GHOSTDAG data (selected parent %s, blue score %d)",
+			storedGHOSTDAGData.SelectedParent(), storedGHOSTDAGData.BlueScore(),
+			recomputedGHOSTDAGData.SelectedParent(), recomputedGHOSTDAGData.BlueScore())
+	} else {
+		return nil
+	}
+
+	if v.shouldRepair {
+		err = staging.CommitAllChanges(s.databaseContext, recomputationStagingArea)
+		if err != nil {
+			return err
+		}
+		issue.IsRepaired = true
+
+		// The reachability data of the block depends on its selected parent, and its UTXO data
+		// on its merge set, none of which are re-derived here
+		if storedGHOSTDAGData == nil || !areGHOSTDAGDerivationInputsEqual(storedGHOSTDAGData, recomputedGHOSTDAGData) {
+			v.addIssue(externalapi.DatabaseVerificationCheckGHOSTDAGDerivedData, blockHash,
+				"the GHOSTDAG data was repaired, but the data that was derived from it wasn't")
+		}
+	}
+
+	return nil
+}
+
+// areGHOSTDAGDerivationInputsEqual returns whether the parts of the given GHOSTDAG data
+// that other block data is derived from are equal
+func areGHOSTDAGDerivationInputsEqual(a, b *externalapi.BlockGHOSTDAGData) bool {
+	return a.SelectedParent().Equal(b.SelectedParent()) &&
+		externalapi.HashesEqual(a.MergeSetBlues(), b.MergeSetBlues()) &&
+		externalapi.HashesEqual(a.MergeSetReds(), b.MergeSetReds())
+}
+
+func areGHOSTDAGDataEqual(a, b *externalapi.BlockGHOSTDAGData) bool {
+	if a.BlueScore() != b.BlueScore() || a.BlueWork().Cmp(b.BlueWork()) != 0 ||
+		!a.SelectedParent().Equal(b.SelectedParent()) ||
+		!externalapi.HashesEqual(a.MergeSetBlues(), b.MergeSetBlues()) ||
+		!externalapi.HashesEqual(a.MergeSetReds(), b.MergeSetReds()) ||
+		len(a.BluesAnticoneSizes()) != len(b.BluesAnticoneSizes()) {
+
+		return false
+	}
+	for blockHash, anticoneSize := range a.BluesAnticoneSizes() {
+		otherAnticoneSize, ok := b.BluesAnticoneSizes()[blockHash]
+		if !ok || anticoneSize != otherAnticoneSize {
+			return false
+		}
+	}
+	return true
+}
+
+// verifyReachabilityData makes sure that the reachability interval of the block is
+// within the interval of its reachability tree parent, and that all of its parents
+// are reachable from it
+func (v *databaseVerifier) verifyReachabilityData(stagingArea *model.StagingArea,
+	blockHash *externalapi.DomainHash, isRoot bool) error {
+
+	s := v.consensus
+	hasReachabilityData, err := s.reachabilityDataStore.HasReachabilityData(s.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	if !hasReachabilityData {
+		v.addIssue(externalapi.DatabaseVerificationCheckReachability, blockHash, "the block has no reachability data")
+		return nil
+	}
+
+	reachabilityData, err := s.reachabilityDataStore.ReachabilityData(s.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	interval := reachabilityData.Interval()
+	treeParent := reachabilityData.Parent()
+	if treeParent != nil {
+		hasTreeParentReachabilityData, err := s.reachabilityDataStore.HasReachabilityData(
+			s.databaseContext, stagingArea, treeParent)
+		if err != nil {
+			return err
+		}
+		if !hasTreeParentReachabilityData {
+			v.addIssue(externalapi.DatabaseVerificationCheckReachability, blockHash,
+				"the reachability tree parent %s has no reachability data", treeParent)
+		} else {
+			treeParentReachabilityData, err := s.reachabilityDataStore.ReachabilityData(
+				s.databaseContext, stagingArea, treeParent)
+			if err != nil {
+				return err
+			}
+			treeParentInterval := treeParentReachabilityData.Interval()
+			if interval.Start < treeParentInterval.Start || interval.End > treeParentInterval.End {
+				v.addIssue(externalapi.DatabaseVerificationCheckReachability, blockHash,
+					"the interval %s is not within the interval %s of the reachability tree parent %s",
+					interval, treeParentInterval, treeParent)
+			}
+
+			isChildOfTreeParent := false
+			for _, child := range treeParentReachabilityData.Children() {
+				if child.Equal(blockHash) {
+					isChildOfTreeParent = true
+					break
+				}
+			}
+			if !isChildOfTreeParent {
+				v.addIssue(externalapi.DatabaseVerificationCheckReachability, blockHash,
+					"the block is not a child of its reachability tree parent %s", treeParent)
+			}
+		}
+	}
+
+	// The parents of the roots might have been pruned
+	if isRoot {
+		return nil
+	}
+	parents, err := s.dagTopologyManagers[0].Parents(stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		hasParentReachabilityData, err := s.reachabilityDataStore.HasReachabilityData(
+			s.databaseContext, stagingArea, parent)
+		if err != nil {
+			return err
+		}
+		if !hasParentReachabilityData {
+			continue
+		}
+		isDAGAncestorOf, err := s.reachabilityManager.IsDAGAncestorOf(stagingArea, parent, blockHash)
+		if err != nil {
+			return err
+		}
+		if !isDAGAncestorOf {
+			v.addIssue(externalapi.DatabaseVerificationCheckReachability, blockHash,
+				"the parent %s is not a DAG ancestor of the block according to the reachability data", parent)
+		}
+	}
+
+	return nil
+}
+
+// verifyUTXOData makes sure that the multiset of a UTXO-valid block fits the UTXO
+// commitment in its header, and that its UTXO diff leads towards the virtual
+func (v *databaseVerifier) verifyUTXOData(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash) error {
+	s := v.consensus
+	blockMultiset, err := s.multisetStore.Get(s.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		v.addIssue(externalapi.DatabaseVerificationCheckUTXOCommitment, blockHash,
+			"the block is UTXO-valid, but its multiset is missing")
+	} else {
+		header, err := s.blockHeaderStore.BlockHeader(s.databaseContext, stagingArea, blockHash)
+		if err != nil {
+			return err
+		}
+		if !header.UTXOCommitment().Equal(blockMultiset.Hash()) {
+			v.addIssue(externalapi.DatabaseVerificationCheckUTXOCommitment, blockHash,
+				"the multiset hash %s doesn't match the UTXO commitment %s", blockMultiset.Hash(), header.UTXOCommitment())
+		}
+	}
+
+	_, err = s.utxoDiffStore.UTXODiff(s.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		v.addIssue(externalapi.DatabaseVerificationCheckUTXODiff, blockHash,
+			"the block is UTXO-valid, but its UTXO diff is missing")
+	}
+
+	hasUTXODiffChild, err := s.utxoDiffStore.HasUTXODiffChild(s.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	if !hasUTXODiffChild {
+		return nil
+	}
+	utxoDiffChild, err := s.utxoDiffStore.UTXODiffChild(s.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+
+	// A UTXO diff child is a block that became the virtual selected parent after the
+	// block did, so requiring it to come after the block in GHOSTDAG order also makes
+	// sure that UTXO diff chains have no cycles
+	utxoDiffChildStatus, err := s.blockStatusStore.Get(s.databaseContext, stagingArea, utxoDiffChild)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		v.addIssue(externalapi.DatabaseVerificationCheckUTXODiff, blockHash,
+			"the UTXO diff child %s doesn't exist", utxoDiffChild)
+		return nil
+	}
+	if utxoDiffChildStatus != externalapi.StatusUTXOValid {
+		v.addIssue(externalapi.DatabaseVerificationCheckUTXODiff, blockHash,
+			"the UTXO diff child %s has status %s", utxoDiffChild, utxoDiffChildStatus)
+	}
+	ghostdagData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, blockHash, false)
+	if err != nil {
+		return err
+	}
+	utxoDiffChildGHOSTDAGData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, utxoDiffChild, false)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		return nil
+	}
+	if !s.ghostdagManagers[0].Less(blockHash, ghostdagData, utxoDiffChild, utxoDiffChildGHOSTDAGData) {
+		v.addIssue(externalapi.DatabaseVerificationCheckUTXODiff, blockHash,
+			"the UTXO diff child %s (blue work %s) doesn't come after the block (blue work %s) in GHOSTDAG order",
+			utxoDiffChild, utxoDiffChildGHOSTDAGData.BlueWork(), ghostdagData.BlueWork())
+	}
+
+	return nil
+}
+
+// verifyUTXOSets makes sure that the virtual UTXO set fits the virtual multiset, and
+// that the pruning point UTXO set fits the UTXO commitment of the pruning point
+func (v *databaseVerifier) verifyUTXOSets() error {
+	s := v.consensus
+	stagingArea := model.NewStagingArea()
+
+	log.Infof("Verifying the virtual UTXO set")
+	virtualUTXOSetIterator, err := s.consensusStateStore.VirtualUTXOSetIterator(s.databaseContext, stagingArea)
+	if err != nil {
+		return err
+	}
+	virtualUTXOSetHash, err := utxoSetHash(virtualUTXOSetIterator)
+	if err != nil {
+		return err
+	}
+	virtualMultiset, err := s.multisetStore.Get(s.databaseContext, stagingArea, model.VirtualBlockHash)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		v.addIssue(externalapi.DatabaseVerificationCheckUTXOCommitment, nil, "the virtual multiset is missing")
+	} else if !virtualMultiset.Hash().Equal(virtualUTXOSetHash) {
+		v.addIssue(externalapi.DatabaseVerificationCheckUTXOCommitment, nil,
+			"the virtual UTXO set hash %s doesn't match the virtual multiset hash %s",
+			virtualUTXOSetHash, virtualMultiset.Hash())
+	}
+
+	pruningPoint, err := s.pruningStore.PruningPoint(s.databaseContext, stagingArea)
+	if err != nil {
+		return err
+	}
+	if pruningPoint.Equal(s.genesisHash) {
+		return nil
+	}
+
+	log.Infof("Verifying the pruning point UTXO set")
+	pruningPointUTXOSetIterator, err := s.pruningStore.PruningPointUTXOIterator(s.databaseContext)
+	if err != nil {
+		return err
+	}
+	pruningPointUTXOSetHash, err := utxoSetHash(pruningPointUTXOSetIterator)
+	if err != nil {
+		return err
+	}
+	pruningPointHeader, err := s.blockHeaderStore.BlockHeader(s.databaseContext, stagingArea, pruningPoint)
+	if err != nil {
+		return err
+	}
+	if !pruningPointHeader.UTXOCommitment().Equal(pruningPointUTXOSetHash) {
+		v.addIssue(externalapi.DatabaseVerificationCheckUTXOCommitment, pruningPoint,
+			"the pruning point UTXO set hash %s doesn't match the UTXO commitment %s",
+			pruningPointUTXOSetHash, pruningPointHeader.UTXOCommitment())
+	}
+
+	return nil
+}
+
+func utxoSetHash(iterator externalapi.ReadOnlyUTXOSetIterator) (*externalapi.DomainHash, error) {
+	defer iterator.Close()
+
+	utxoSetMultiset := multiset.New()
+	for ok := iterator.First(); ok; ok = iterator.Next() {
+		outpoint, entry, err := iterator.Get()
+		if err != nil {
+			return nil, err
+		}
+		serializedUTXO, err := utxo.SerializeUTXO(entry, outpoint)
+		if err != nil {
+			return nil, err
+		}
+		utxoSetMultiset.Add(serializedUTXO)
+	}
+
+	return utxoSetMultiset.Hash(), nil
+}
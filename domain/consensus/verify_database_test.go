@@ -0,0 +1,127 @@
+package consensus_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/testutils"
+	"github.com/kaspanet/kaspad/util/staging"
+)
+
+func TestVerifyDatabase(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestVerifyDatabase")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		// Build a DAG with two branches that are merged by the tip
+		tipHashes := []*externalapi.DomainHash{consensusConfig.GenesisHash, consensusConfig.GenesisHash}
+		for i := 0; i < 5; i++ {
+			for j := range tipHashes {
+				tipHashes[j], _, err = tc.AddBlock([]*externalapi.DomainHash{tipHashes[j]}, nil, nil)
+				if err != nil {
+					t.Fatalf("AddBlock: %+v", err)
+				}
+			}
+		}
+		mergingBlockHash, _, err := tc.AddBlock(tipHashes, nil, nil)
+		if err != nil {
+			t.Fatalf("AddBlock: %+v", err)
+		}
+
+		verifyDatabase := func(shouldRepair bool) *externalapi.DatabaseVerificationReport {
+			report, err := tc.VerifyDatabase(shouldRepair)
+			if err != nil {
+				t.Fatalf("VerifyDatabase: %+v", err)
+			}
+			return report
+		}
+
+		report := verifyDatabase(false)
+		if len(report.Issues) != 0 {
+			t.Fatalf("Expected no issues in a sound database, got %d. First issue: %s",
+				len(report.Issues), report.Issues[0].Description)
+		}
+		const expectedVerifiedBlockCount = 12
+		if report.VerifiedBlockCount != expectedVerifiedBlockCount {
+			t.Fatalf("Expected %d verified blocks, got %d", expectedVerifiedBlockCount, report.VerifiedBlockCount)
+		}
+
+		// Corrupt the GHOSTDAG data of the tip
+		stagingArea := model.NewStagingArea()
+		ghostdagData, err := tc.GHOSTDAGDataStore().Get(tc.DatabaseContext(), stagingArea, mergingBlockHash, false)
+		if err != nil {
+			t.Fatalf("GHOSTDAGDataStore.Get: %+v", err)
+		}
+		corruptedGHOSTDAGData := externalapi.NewBlockGHOSTDAGData(ghostdagData.BlueScore()+1,
+			new(big.Int).Add(ghostdagData.BlueWork(), big.NewInt(1)), ghostdagData.SelectedParent(),
+			ghostdagData.MergeSetBlues(), ghostdagData.MergeSetReds(), ghostdagData.BluesAnticoneSizes())
+		tc.GHOSTDAGDataStore().Stage(stagingArea, mergingBlockHash, corruptedGHOSTDAGData, false)
+		err = staging.CommitAllChanges(tc.DatabaseContext(), stagingArea)
+		if err != nil {
+			t.Fatalf("CommitAllChanges: %+v", err)
+		}
+
+		expectGHOSTDAGIssue := func(report *externalapi.DatabaseVerificationReport, expectedIsRepaired bool) {
+			if len(report.Issues) != 1 {
+				t.Fatalf("Expected a single issue, got %d", len(report.Issues))
+			}
+			issue := report.Issues[0]
+			if issue.Check != externalapi.DatabaseVerificationCheckGHOSTDAG || !issue.BlockHash.Equal(mergingBlockHash) {
+				t.Fatalf("Expected a GHOSTDAG issue in block %s, got a %s issue in block %s",
+					mergingBlockHash, issue.Check, issue.BlockHash)
+			}
+			if issue.IsRepaired != expectedIsRepaired {
+				t.Fatalf("Unexpected IsRepaired. Want: %t, got: %t", expectedIsRepaired, issue.IsRepaired)
+			}
+		}
+		expectGHOSTDAGIssue(verifyDatabase(false), false)
+		expectGHOSTDAGIssue(verifyDatabase(true), true)
+
+		report = verifyDatabase(false)
+		if len(report.Issues) != 0 {
+			t.Fatalf("Expected no issues after repairing the database, got %d. First issue: %s",
+				len(report.Issues), report.Issues[0].Description)
+		}
+
+		// Corrupt the selected parent of the tip, which the rest of its data was derived from
+		otherParent := tipHashes[0]
+		if otherParent.Equal(ghostdagData.SelectedParent()) {
+			otherParent = tipHashes[1]
+		}
+		corruptedGHOSTDAGData = externalapi.NewBlockGHOSTDAGData(ghostdagData.BlueScore(), ghostdagData.BlueWork(),
+			otherParent, ghostdagData.MergeSetBlues(), ghostdagData.MergeSetReds(), ghostdagData.BluesAnticoneSizes())
+		stagingArea = model.NewStagingArea()
+		tc.GHOSTDAGDataStore().Stage(stagingArea, mergingBlockHash, corruptedGHOSTDAGData, false)
+		err = staging.CommitAllChanges(tc.DatabaseContext(), stagingArea)
+		if err != nil {
+			t.Fatalf("CommitAllChanges: %+v", err)
+		}
+		expectGHOSTDAGIssue(verifyDatabase(false), false)
+
+		// Only the GHOSTDAG data is repaired, so the data that was derived from it is reported as unrepaired
+		report = verifyDatabase(true)
+		if len(report.Issues) != 2 || report.UnrepairedIssueCount() != 1 {
+			t.Fatalf("Expected two issues, one of them unrepaired, got %d issues, %d of them unrepaired",
+				len(report.Issues), report.UnrepairedIssueCount())
+		}
+		if !report.Issues[0].IsRepaired || report.Issues[0].Check != externalapi.DatabaseVerificationCheckGHOSTDAG {
+			t.Fatalf("Expected the first issue to be a repaired GHOSTDAG issue, got a %s issue with IsRepaired %t",
+				report.Issues[0].Check, report.Issues[0].IsRepaired)
+		}
+		derivedDataIssue := report.Issues[1]
+		if derivedDataIssue.IsRepaired || derivedDataIssue.Check != externalapi.DatabaseVerificationCheckGHOSTDAGDerivedData ||
+			!derivedDataIssue.BlockHash.Equal(mergingBlockHash) {
+
+			t.Fatalf("Expected an unrepaired %s issue in block %s, got a %s issue in block %s with IsRepaired %t",
+				externalapi.DatabaseVerificationCheckGHOSTDAGDerivedData, mergingBlockHash,
+				derivedDataIssue.Check, derivedDataIssue.BlockHash, derivedDataIssue.IsRepaired)
+		}
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/miningmanager"
 	"github.com/kaspanet/kaspad/domain/miningmanager/mempool"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
 	"github.com/kaspanet/kaspad/domain/prefixmanager"
 	"github.com/kaspanet/kaspad/domain/prefixmanager/prefix"
 	infrastructuredatabase "github.com/kaspanet/kaspad/infrastructure/db/database"
@@ -26,6 +27,7 @@ type Domain interface {
 	CommitStagingConsensus() error
 	DeleteStagingConsensus() error
 	ConsensusEventsChannel() chan externalapi.ConsensusEvent
+	MempoolEventsChannel() chan *miningmanagermodel.MempoolChangeSet
 }
 
 type domain struct {
@@ -36,12 +38,17 @@ type domain struct {
 	consensusConfig        *consensus.Config
 	db                     infrastructuredatabase.Database
 	consensusEventsChannel chan externalapi.ConsensusEvent
+	mempoolEventsChannel   chan *miningmanagermodel.MempoolChangeSet
 }
 
 func (d *domain) ConsensusEventsChannel() chan externalapi.ConsensusEvent {
 	return d.consensusEventsChannel
 }
 
+func (d *domain) MempoolEventsChannel() chan *miningmanagermodel.MempoolChangeSet {
+	return d.mempoolEventsChannel
+}
+
 func (d *domain) Consensus() externalapi.Consensus {
 	return *d.consensus
 }
@@ -204,11 +211,13 @@ func New(consensusConfig *consensus.Config, mempoolConfig *mempool.Config, db in
 		return nil, err
 	}
 
+	mempoolEventsChan := make(chan *miningmanagermodel.MempoolChangeSet, 100e3)
 	domainInstance := &domain{
 		consensus:              &consensusInstance,
 		consensusConfig:        consensusConfig,
 		db:                     db,
 		consensusEventsChannel: consensusEventsChan,
+		mempoolEventsChannel:   mempoolEventsChan,
 	}
 
 	if shouldMigrate {
@@ -222,6 +231,7 @@ func New(consensusConfig *consensus.Config, mempoolConfig *mempool.Config, db in
 
 	// We create a consensus wrapper because the actual consensus might change
 	consensusReference := consensusreference.NewConsensusReference(&domainInstance.consensus)
-	domainInstance.miningManager = miningManagerFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+	domainInstance.miningManager = miningManagerFactory.NewMiningManager(consensusReference, &consensusConfig.Params,
+		mempoolConfig, mempoolEventsChan)
 	return domainInstance, nil
 }
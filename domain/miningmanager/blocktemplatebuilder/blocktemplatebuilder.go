@@ -120,15 +120,16 @@ func (btb *blockTemplateBuilder) BuildBlockTemplate(
 
 	mempoolTransactions := btb.mempool.BlockCandidateTransactions()
 	candidateTxs := make([]*candidateTx, 0, len(mempoolTransactions))
-	for _, tx := range mempoolTransactions {
+	for _, mempoolTransaction := range mempoolTransactions {
 		// Calculate the tx value
+		tx := mempoolTransaction.Transaction
 		gasLimit := uint64(0)
 		if !subnetworks.IsBuiltInOrNative(tx.SubnetworkID) {
 			panic("We currently don't support non native subnetworks")
 		}
 		candidateTxs = append(candidateTxs, &candidateTx{
 			DomainTransaction: tx,
-			txValue:           btb.calcTxValue(tx),
+			txValue:           btb.calcTxValue(mempoolTransaction),
 			gasLimit:          gasLimit,
 		})
 	}
@@ -207,12 +208,15 @@ func (btb *blockTemplateBuilder) ModifyBlockTemplate(newCoinbaseData *consensuse
 
 // calcTxValue calculates a value to be used in transaction selection.
 // The higher the number the more likely it is that the transaction will be
-// included in the block.
-func (btb *blockTemplateBuilder) calcTxValue(tx *consensusexternalapi.DomainTransaction) float64 {
+// included in the block. The value is derived from the fee rate of the best
+// package the transaction is a part of, so that transactions whose
+// descendants in the mempool pay high fees are preferred.
+func (btb *blockTemplateBuilder) calcTxValue(candidate *miningmanagerapi.BlockCandidateTransaction) float64 {
 	massLimit := btb.policy.BlockMaxMass
 
-	mass := tx.Mass
-	fee := tx.Fee
+	tx := candidate.Transaction
+	mass := candidate.PackageMass
+	fee := candidate.PackageFee
 	if subnetworks.IsBuiltInOrNative(tx.SubnetworkID) {
 		return float64(fee) / (float64(mass) / float64(massLimit))
 	}
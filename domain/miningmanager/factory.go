@@ -4,29 +4,36 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensusreference"
 	"github.com/kaspanet/kaspad/domain/dagconfig"
 	"github.com/kaspanet/kaspad/domain/miningmanager/blocktemplatebuilder"
+	"github.com/kaspanet/kaspad/domain/miningmanager/feeestimator"
 	mempoolpkg "github.com/kaspanet/kaspad/domain/miningmanager/mempool"
+	"github.com/kaspanet/kaspad/domain/miningmanager/model"
 	"sync"
 	"time"
 )
 
 // Factory instantiates new mining managers
 type Factory interface {
-	NewMiningManager(consensus consensusreference.ConsensusReference, params *dagconfig.Params, mempoolConfig *mempoolpkg.Config) MiningManager
+	NewMiningManager(consensus consensusreference.ConsensusReference, params *dagconfig.Params, mempoolConfig *mempoolpkg.Config,
+		mempoolEventsChan chan *model.MempoolChangeSet) MiningManager
 }
 
 type factory struct{}
 
 // NewMiningManager instantiate a new mining manager
 func (f *factory) NewMiningManager(consensusReference consensusreference.ConsensusReference, params *dagconfig.Params,
-	mempoolConfig *mempoolpkg.Config) MiningManager {
+	mempoolConfig *mempoolpkg.Config, mempoolEventsChan chan *model.MempoolChangeSet) MiningManager {
 
-	mempool := mempoolpkg.New(mempoolConfig, consensusReference)
+	mempool := mempoolpkg.New(mempoolConfig, consensusReference, mempoolEventsChan)
 	blockTemplateBuilder := blocktemplatebuilder.New(consensusReference, mempool, params.MaxBlockMass, params.CoinbasePayloadScriptPublicKeyMaxLength)
+	// MinimumRelayTransactionFee is in sompi/kg, while the fee estimator works in sompi/gram
+	feeEstimator := feeestimator.New(params.MaxBlockMass, params.TargetTimePerBlock,
+		float64(mempoolConfig.MinimumRelayTransactionFee)/1000)
 
 	return &miningManager{
 		consensusReference:   consensusReference,
 		mempool:              mempool,
 		blockTemplateBuilder: blockTemplateBuilder,
+		feeEstimator:         feeEstimator,
 		cachingTime:          time.Time{},
 		cacheLock:            &sync.Mutex{},
 	}
@@ -0,0 +1,205 @@
+package feeestimator
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/transactionhelper"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+)
+
+const (
+	// blockTemplateWindowDuration is the amount of time, measured in DAA score, for which
+	// block template samples are kept
+	blockTemplateWindowDuration = 10 * time.Minute
+
+	// fullBlockTemplateMassRatio is the ratio of the maximum block mass above which a block
+	// template is considered full, meaning that transactions competed over getting into it
+	fullBlockTemplateMassRatio = 0.9
+
+	// inclusionConfidence is the required probability for a transaction paying the
+	// estimated fee rate to be included within the bucket's estimated time
+	inclusionConfidence = 0.95
+
+	// normalInclusionDuration and lowInclusionDuration are the target inclusion times of
+	// the normal and low buckets. The priority bucket targets the next block.
+	// lowInclusionDuration is kept within the default mempool transaction expiry interval.
+	normalInclusionDuration = 10 * time.Second
+	lowInclusionDuration    = 60 * time.Second
+)
+
+// FeeRateBucket is a fee rate, in sompi per gram of mass, alongside the expected
+// amount of time until a transaction paying it is included in a block
+type FeeRateBucket struct {
+	FeeRate          float64
+	EstimatedSeconds float64
+}
+
+// FeeEstimate is the set of fee rates a transaction may pay
+// depending on how soon it needs to be included in a block
+type FeeEstimate struct {
+	PriorityBucket *FeeRateBucket
+	NormalBucket   *FeeRateBucket
+	LowBucket      *FeeRateBucket
+}
+
+type blockTemplateSample struct {
+	daaScore uint64
+	// feeRateThreshold is the lowest fee rate that got into a full block template,
+	// or the minimum fee rate if the block template had room to spare
+	feeRateThreshold float64
+}
+
+// FeeEstimator estimates the fee rate a transaction has to pay in order to be
+// included in a block within a given amount of time. It does so by looking at
+// the fee rate distribution of the transactions currently in the mempool, as
+// well as at the transactions selected into recent block templates.
+type FeeEstimator struct {
+	maximumMassPerBlock uint64
+	targetTimePerBlock  time.Duration
+	minimumFeeRate      float64
+	windowDAAScore      uint64
+
+	blockTemplateSamples []*blockTemplateSample
+	mutex                sync.Mutex
+}
+
+// New creates a new FeeEstimator. minimumFeeRate is the lowest fee rate, in sompi
+// per gram of mass, accepted by the mempool.
+func New(maximumMassPerBlock uint64, targetTimePerBlock time.Duration, minimumFeeRate float64) *FeeEstimator {
+	return &FeeEstimator{
+		maximumMassPerBlock: maximumMassPerBlock,
+		targetTimePerBlock:  targetTimePerBlock,
+		minimumFeeRate:      minimumFeeRate,
+		windowDAAScore:      uint64(blockTemplateWindowDuration / targetTimePerBlock),
+	}
+}
+
+// AddBlockTemplate samples the fee rates of the transactions selected into the given
+// block template. Samples older than the estimation window are discarded.
+func (fe *FeeEstimator) AddBlockTemplate(block *externalapi.DomainBlock) {
+	fe.mutex.Lock()
+	defer fe.mutex.Unlock()
+
+	totalMass := uint64(0)
+	lowestFeeRate := math.Inf(1)
+	for _, transaction := range block.Transactions {
+		if transactionhelper.IsCoinBase(transaction) || transaction.Mass == 0 {
+			continue
+		}
+		totalMass += transaction.Mass
+		feeRate := float64(transaction.Fee) / float64(transaction.Mass)
+		if feeRate < lowestFeeRate {
+			lowestFeeRate = feeRate
+		}
+	}
+
+	sample := &blockTemplateSample{
+		daaScore:         block.Header.DAAScore(),
+		feeRateThreshold: fe.minimumFeeRate,
+	}
+	if float64(totalMass) >= fullBlockTemplateMassRatio*float64(fe.maximumMassPerBlock) &&
+		lowestFeeRate > fe.minimumFeeRate {
+
+		sample.feeRateThreshold = lowestFeeRate
+	}
+
+	// Templates are rebuilt several times per DAA score, so only the latest one is kept
+	lastIndex := len(fe.blockTemplateSamples) - 1
+	if lastIndex >= 0 && fe.blockTemplateSamples[lastIndex].daaScore == sample.daaScore {
+		fe.blockTemplateSamples[lastIndex] = sample
+	} else {
+		fe.blockTemplateSamples = append(fe.blockTemplateSamples, sample)
+	}
+
+	firstSampleInWindow := 0
+	for firstSampleInWindow < len(fe.blockTemplateSamples) &&
+		fe.blockTemplateSamples[firstSampleInWindow].daaScore+fe.windowDAAScore < sample.daaScore {
+
+		firstSampleInWindow++
+	}
+	fe.blockTemplateSamples = fe.blockTemplateSamples[firstSampleInWindow:]
+}
+
+// Estimate returns the fee estimate given the fee rates of the transactions
+// currently in the mempool, ordered from the highest fee rate to the lowest,
+// and the lowest fee rate the mempool currently accepts
+func (fe *FeeEstimator) Estimate(mempoolFeeRates []*miningmanagermodel.TransactionFeeRate,
+	mempoolMinimumFeeRate float64) *FeeEstimate {
+
+	fe.mutex.Lock()
+	defer fe.mutex.Unlock()
+
+	return &FeeEstimate{
+		PriorityBucket: fe.bucket(mempoolFeeRates, mempoolMinimumFeeRate, 1),
+		NormalBucket:   fe.bucket(mempoolFeeRates, mempoolMinimumFeeRate, fe.blockCount(normalInclusionDuration)),
+		LowBucket:      fe.bucket(mempoolFeeRates, mempoolMinimumFeeRate, fe.blockCount(lowInclusionDuration)),
+	}
+}
+
+func (fe *FeeEstimator) blockCount(inclusionDuration time.Duration) uint64 {
+	blockCount := uint64(inclusionDuration / fe.targetTimePerBlock)
+	if blockCount == 0 {
+		return 1
+	}
+	return blockCount
+}
+
+// bucket returns the fee rate required for a transaction to be included within blockCount blocks
+func (fe *FeeEstimator) bucket(mempoolFeeRates []*miningmanagermodel.TransactionFeeRate,
+	mempoolMinimumFeeRate float64, blockCount uint64) *FeeRateBucket {
+
+	feeRate := math.Max(fe.minimumFeeRate, mempoolMinimumFeeRate)
+	if mempoolFeeRate := fe.mempoolFeeRate(mempoolFeeRates, blockCount); mempoolFeeRate > feeRate {
+		feeRate = mempoolFeeRate
+	}
+	if blockTemplateFeeRate := fe.blockTemplateFeeRate(blockCount); blockTemplateFeeRate > feeRate {
+		feeRate = blockTemplateFeeRate
+	}
+
+	return &FeeRateBucket{
+		FeeRate:          feeRate,
+		EstimatedSeconds: float64(blockCount) * fe.targetTimePerBlock.Seconds(),
+	}
+}
+
+// mempoolFeeRate returns the fee rate of the mempool transaction at which the mempool
+// fills blockCount blocks. A transaction paying less than that would have to wait for
+// more than blockCount blocks, assuming no new transactions arrive.
+func (fe *FeeEstimator) mempoolFeeRate(mempoolFeeRates []*miningmanagermodel.TransactionFeeRate, blockCount uint64) float64 {
+	massCapacity := blockCount * fe.maximumMassPerBlock
+	accumulatedMass := uint64(0)
+	for _, transactionFeeRate := range mempoolFeeRates {
+		accumulatedMass += transactionFeeRate.Mass
+		if accumulatedMass >= massCapacity {
+			return transactionFeeRate.FeeRate
+		}
+	}
+	return fe.minimumFeeRate
+}
+
+// blockTemplateFeeRate returns the lowest fee rate that would have made it into at least
+// one out of blockCount recent block templates with a probability of inclusionConfidence.
+// This is the fee rate that made it into a fraction of 1-(1-inclusionConfidence)^(1/blockCount)
+// of the sampled block templates.
+func (fe *FeeEstimator) blockTemplateFeeRate(blockCount uint64) float64 {
+	if len(fe.blockTemplateSamples) == 0 {
+		return fe.minimumFeeRate
+	}
+
+	feeRateThresholds := make([]float64, len(fe.blockTemplateSamples))
+	for i, sample := range fe.blockTemplateSamples {
+		feeRateThresholds[i] = sample.feeRateThreshold
+	}
+	sort.Float64s(feeRateThresholds)
+
+	requiredInclusionRatio := 1 - math.Pow(1-inclusionConfidence, 1/float64(blockCount))
+	index := int(math.Ceil(requiredInclusionRatio*float64(len(feeRateThresholds)))) - 1
+	if index < 0 {
+		index = 0
+	}
+	return feeRateThresholds[index]
+}
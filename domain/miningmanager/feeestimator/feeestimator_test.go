@@ -0,0 +1,93 @@
+package feeestimator
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/blockheader"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/subnetworks"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+)
+
+const (
+	testMaximumMassPerBlock = 1000
+	testTargetTimePerBlock  = time.Second
+	testMinimumFeeRate      = 1
+)
+
+func TestEstimateFromMempool(t *testing.T) {
+	feeEstimator := New(testMaximumMassPerBlock, testTargetTimePerBlock, testMinimumFeeRate)
+
+	estimate := feeEstimator.Estimate(nil, 0)
+	expectEstimate(t, estimate, 1, 1, 1)
+	if estimate.PriorityBucket.EstimatedSeconds != 1 || estimate.NormalBucket.EstimatedSeconds != 10 ||
+		estimate.LowBucket.EstimatedSeconds != 60 {
+
+		t.Fatalf("Unexpected estimated seconds: %f, %f, %f", estimate.PriorityBucket.EstimatedSeconds,
+			estimate.NormalBucket.EstimatedSeconds, estimate.LowBucket.EstimatedSeconds)
+	}
+
+	mempoolFeeRates := []*miningmanagermodel.TransactionFeeRate{
+		{FeeRate: 10, Mass: 600},
+		{FeeRate: 5, Mass: 600},
+		{FeeRate: 2, Mass: 10000},
+	}
+	expectEstimate(t, feeEstimator.Estimate(mempoolFeeRates, 0), 5, 2, 1)
+}
+
+func TestEstimateFromBlockTemplates(t *testing.T) {
+	feeEstimator := New(testMaximumMassPerBlock, testTargetTimePerBlock, testMinimumFeeRate)
+
+	// Half of the block templates are full with a lowest fee rate of 3, and half have room to spare
+	for daaScore := uint64(1); daaScore <= 20; daaScore++ {
+		if daaScore%2 == 0 {
+			feeEstimator.AddBlockTemplate(createBlockTemplate(daaScore, 3, testMaximumMassPerBlock))
+		} else {
+			feeEstimator.AddBlockTemplate(createBlockTemplate(daaScore, 3, testMaximumMassPerBlock/2))
+		}
+	}
+	expectEstimate(t, feeEstimator.Estimate(nil, 0), 3, 1, 1)
+
+	// The mempool takes precedence if it requires a higher fee rate
+	mempoolFeeRates := []*miningmanagermodel.TransactionFeeRate{{FeeRate: 4, Mass: 20000}}
+	expectEstimate(t, feeEstimator.Estimate(mempoolFeeRates, 0), 4, 4, 1)
+
+	// No estimate should be lower than the minimum fee rate of the mempool
+	expectEstimate(t, feeEstimator.Estimate(mempoolFeeRates, 2), 4, 4, 2)
+
+	// Adding a block template beyond the window should discard all the previous samples
+	feeEstimator.AddBlockTemplate(createBlockTemplate(20+feeEstimator.windowDAAScore+1, 3, testMaximumMassPerBlock/2))
+	expectEstimate(t, feeEstimator.Estimate(nil, 0), 1, 1, 1)
+}
+
+func expectEstimate(t *testing.T, estimate *FeeEstimate, expectedPriority, expectedNormal, expectedLow float64) {
+	if estimate.PriorityBucket.FeeRate != expectedPriority {
+		t.Fatalf("Unexpected priority fee rate: expected %f but got %f", expectedPriority, estimate.PriorityBucket.FeeRate)
+	}
+	if estimate.NormalBucket.FeeRate != expectedNormal {
+		t.Fatalf("Unexpected normal fee rate: expected %f but got %f", expectedNormal, estimate.NormalBucket.FeeRate)
+	}
+	if estimate.LowBucket.FeeRate != expectedLow {
+		t.Fatalf("Unexpected low fee rate: expected %f but got %f", expectedLow, estimate.LowBucket.FeeRate)
+	}
+}
+
+func createBlockTemplate(daaScore uint64, lowestFeeRate float64, totalMass uint64) *externalapi.DomainBlock {
+	coinbase := &externalapi.DomainTransaction{SubnetworkID: subnetworks.SubnetworkIDCoinbase}
+	transactions := []*externalapi.DomainTransaction{coinbase}
+	const transactionMass = 100
+	for i := uint64(0); i < totalMass/transactionMass; i++ {
+		feeRate := lowestFeeRate + float64(i)
+		transactions = append(transactions, &externalapi.DomainTransaction{
+			SubnetworkID: subnetworks.SubnetworkIDNative,
+			Fee:          uint64(feeRate * transactionMass),
+			Mass:         transactionMass,
+		})
+	}
+
+	header := blockheader.NewImmutableBlockHeader(0, nil, &externalapi.DomainHash{}, &externalapi.DomainHash{},
+		&externalapi.DomainHash{}, 0, 0, 0, daaScore, 0, big.NewInt(0), &externalapi.DomainHash{})
+	return &externalapi.DomainBlock{Header: header, Transactions: transactions}
+}
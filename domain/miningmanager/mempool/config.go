@@ -12,6 +12,9 @@ import (
 
 const (
 	defaultMaximumTransactionCount = 1_000_000
+	// defaultMaximumTransactionsMass bounds the memory used by the transaction pool,
+	// since the mass of a transaction grows with its size
+	defaultMaximumTransactionsMass = 1_000_000_000
 
 	defaultTransactionExpireIntervalSeconds     uint64 = 60
 	defaultTransactionExpireScanIntervalSeconds uint64 = 10
@@ -38,6 +41,7 @@ const (
 // Config represents a mempool configuration
 type Config struct {
 	MaximumTransactionCount               uint64
+	MaximumTransactionsMass               uint64
 	TransactionExpireIntervalDAAScore     uint64
 	TransactionExpireScanIntervalDAAScore uint64
 	TransactionExpireScanIntervalSeconds  uint64
@@ -58,6 +62,7 @@ func DefaultConfig(dagParams *dagconfig.Params) *Config {
 
 	return &Config{
 		MaximumTransactionCount:               defaultMaximumTransactionCount,
+		MaximumTransactionsMass:               defaultMaximumTransactionsMass,
 		TransactionExpireIntervalDAAScore:     uint64(float64(defaultTransactionExpireIntervalSeconds) / targetBlocksPerSecond),
 		TransactionExpireScanIntervalDAAScore: uint64(float64(defaultTransactionExpireScanIntervalSeconds) / targetBlocksPerSecond),
 		TransactionExpireScanIntervalSeconds:  defaultTransactionExpireScanIntervalSeconds,
@@ -18,23 +18,55 @@ func (mp *mempool) fillInputsAndGetMissingParents(transaction *externalapi.Domai
 
 	err = mp.consensusReference.Consensus().ValidateTransactionAndPopulateWithConsensusData(transaction)
 	if err != nil {
-		errMissingOutpoints := ruleerrors.ErrMissingTxOut{}
-		if errors.As(err, &errMissingOutpoints) {
-			return parentsInPool, errMissingOutpoints.MissingOutpoints, nil
+		missingOutpoints, err := missingOutpointsFromValidationError(err)
+		if err != nil {
+			return nil, nil, err
 		}
-		if errors.Is(err, ruleerrors.ErrImmatureSpend) {
-			return nil, nil, transactionRuleError(
-				RejectImmatureSpend, "one of the transaction inputs spends an immature UTXO")
-		}
-		if errors.As(err, &ruleerrors.RuleError{}) {
-			return nil, nil, newRuleError(err)
-		}
-		return nil, nil, err
+		return parentsInPool, missingOutpoints, nil
 	}
 
 	return parentsInPool, nil, nil
 }
 
+// fillInputsAndGetMissingOutpointsOfTransactions is the same as calling fillInputsAndGetMissingParents
+// for each of the given transactions, except that the transactions are validated by consensus together,
+// so that their input scripts are validated in parallel
+func (mp *mempool) fillInputsAndGetMissingOutpointsOfTransactions(transactions []*externalapi.DomainTransaction) (
+	missingOutpoints [][]*externalapi.DomainOutpoint, errs []error) {
+
+	for _, transaction := range transactions {
+		fillInputs(transaction, mp.transactionsPool.getParentTransactionsInPool(transaction))
+	}
+
+	errs = mp.consensusReference.Consensus().ValidateTransactionsAndPopulateWithConsensusData(transactions)
+
+	missingOutpoints = make([][]*externalapi.DomainOutpoint, len(transactions))
+	for i, err := range errs {
+		if err != nil {
+			missingOutpoints[i], errs[i] = missingOutpointsFromValidationError(err)
+		}
+	}
+
+	return missingOutpoints, errs
+}
+
+// missingOutpointsFromValidationError returns the missing outpoints if the given consensus
+// validation error is ErrMissingTxOut, or the error converted to a mempool error otherwise
+func missingOutpointsFromValidationError(err error) ([]*externalapi.DomainOutpoint, error) {
+	errMissingOutpoints := ruleerrors.ErrMissingTxOut{}
+	if errors.As(err, &errMissingOutpoints) {
+		return errMissingOutpoints.MissingOutpoints, nil
+	}
+	if errors.Is(err, ruleerrors.ErrImmatureSpend) {
+		return nil, transactionRuleError(
+			RejectImmatureSpend, "one of the transaction inputs spends an immature UTXO")
+	}
+	if errors.As(err, &ruleerrors.RuleError{}) {
+		return nil, newRuleError(err)
+	}
+	return nil, err
+}
+
 func fillInputs(transaction *externalapi.DomainTransaction, parentsInPool model.IDToTransactionMap) {
 	for _, input := range transaction.Inputs {
 		parent, ok := parentsInPool[input.PreviousOutpoint.TransactionID]
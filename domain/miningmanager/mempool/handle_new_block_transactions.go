@@ -4,6 +4,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/transactionhelper"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
 )
 
 func (mp *mempool) handleNewBlockTransactions(blockTransactions []*externalapi.DomainTransaction) (
@@ -15,7 +16,7 @@ func (mp *mempool) handleNewBlockTransactions(blockTransactions []*externalapi.D
 	acceptedOrphans := []*externalapi.DomainTransaction{}
 	for _, transaction := range blockTransactions {
 		transactionID := consensushashing.TransactionID(transaction)
-		err := mp.removeTransaction(transactionID, false)
+		err := mp.removeTransaction(transactionID, false, miningmanagermodel.TransactionRemovalReasonIncludedInBlock)
 		if err != nil {
 			return nil, err
 		}
@@ -25,7 +26,7 @@ func (mp *mempool) handleNewBlockTransactions(blockTransactions []*externalapi.D
 			return nil, err
 		}
 
-		err = mp.orphansPool.removeOrphan(transactionID, false)
+		err = mp.orphansPool.removeOrphan(transactionID, false, miningmanagermodel.TransactionRemovalReasonIncludedInBlock)
 		if err != nil {
 			return nil, err
 		}
@@ -52,7 +53,7 @@ func (mp *mempool) handleNewBlockTransactions(blockTransactions []*externalapi.D
 func (mp *mempool) removeDoubleSpends(transaction *externalapi.DomainTransaction) error {
 	for _, input := range transaction.Inputs {
 		if redeemer, ok := mp.mempoolUTXOSet.transactionByPreviousOutpoint[input.PreviousOutpoint]; ok {
-			err := mp.removeTransaction(redeemer.TransactionID(), true)
+			err := mp.removeTransaction(redeemer.TransactionID(), true, miningmanagermodel.TransactionRemovalReasonDoubleSpent)
 			if err != nil {
 				return err
 			}
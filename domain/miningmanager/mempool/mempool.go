@@ -20,13 +20,20 @@ type mempool struct {
 	mempoolUTXOSet   *mempoolUTXOSet
 	transactionsPool *transactionsPool
 	orphansPool      *orphansPool
+
+	mempoolEventsChan chan *miningmanagermodel.MempoolChangeSet
+	pendingChanges    []*miningmanagermodel.MempoolChange
 }
 
-// New constructs a new mempool
-func New(config *Config, consensusReference consensusreference.ConsensusReference) miningmanagermodel.Mempool {
+// New constructs a new mempool. If mempoolEventsChan isn't nil, every change made
+// to the mempool is sent to it as part of a MempoolChangeSet
+func New(config *Config, consensusReference consensusreference.ConsensusReference,
+	mempoolEventsChan chan *miningmanagermodel.MempoolChangeSet) miningmanagermodel.Mempool {
+
 	mp := &mempool{
 		config:             config,
 		consensusReference: consensusReference,
+		mempoolEventsChan:  mempoolEventsChan,
 	}
 
 	mp.mempoolUTXOSet = newMempoolUTXOSet(mp)
@@ -41,8 +48,20 @@ func (mp *mempool) ValidateAndInsertTransaction(transaction *externalapi.DomainT
 
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	defer mp.sendMempoolChangedEvent()
+
+	acceptedTransactions, _, err = mp.validateAndInsertTransaction(transaction, isHighPriority, allowOrphan, false)
+	return acceptedTransactions, err
+}
+
+func (mp *mempool) ValidateAndInsertTransactionReplacement(transaction *externalapi.DomainTransaction, isHighPriority bool) (
+	acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	defer mp.sendMempoolChangedEvent()
 
-	return mp.validateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
+	return mp.validateAndInsertTransaction(transaction, isHighPriority, false, true)
 }
 
 func (mp *mempool) GetTransaction(transactionID *externalapi.DomainTransactionID,
@@ -130,16 +149,33 @@ func (mp *mempool) TransactionCount(includeTransactionPool bool, includeOrphanPo
 	return transactionCount
 }
 
+func (mp *mempool) TransactionMass(includeTransactionPool bool, includeOrphanPool bool) uint64 {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	transactionMass := uint64(0)
+
+	if includeOrphanPool {
+		transactionMass += mp.orphansPool.orphanTransactionMass()
+	}
+	if includeTransactionPool {
+		transactionMass += mp.transactionsPool.transactionMass()
+	}
+
+	return transactionMass
+}
+
 func (mp *mempool) HandleNewBlockTransactions(transactions []*externalapi.DomainTransaction) (
 	acceptedOrphans []*externalapi.DomainTransaction, err error) {
 
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	defer mp.sendMempoolChangedEvent()
 
 	return mp.handleNewBlockTransactions(transactions)
 }
 
-func (mp *mempool) BlockCandidateTransactions() []*externalapi.DomainTransaction {
+func (mp *mempool) BlockCandidateTransactions() []*miningmanagermodel.BlockCandidateTransaction {
 	mp.mtx.RLock()
 	defer mp.mtx.RUnlock()
 
@@ -193,12 +229,36 @@ func (mp *mempool) BlockCandidateTransactions() []*externalapi.DomainTransaction
 		candidateTxs = append(candidateTxs, spamTx)
 	}
 
-	return candidateTxs
+	return mp.transactionsPool.blockCandidates(candidateTxs)
+}
+
+// TransactionFeeRates returns the fee rates of all the transactions in the transaction
+// pool, ordered from the highest fee rate to the lowest
+func (mp *mempool) TransactionFeeRates() []*miningmanagermodel.TransactionFeeRate {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.transactionsPool.transactionFeeRates()
+}
+
+// MinimumFeeRate returns the lowest fee rate, in sompi per gram, a transaction must
+// currently pay in order to be accepted into the mempool. It rises above the minimum
+// relay fee rate while the mempool is full, and decays back once it's no longer full
+func (mp *mempool) MinimumFeeRate() float64 {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	minimumFeeRate := float64(mp.config.MinimumRelayTransactionFee) / 1000
+	if dynamicMinimumFeeRate := mp.transactionsPool.currentMinimumFeeRate(); dynamicMinimumFeeRate > minimumFeeRate {
+		return dynamicMinimumFeeRate
+	}
+	return minimumFeeRate
 }
 
 func (mp *mempool) RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error) {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	defer mp.sendMempoolChangedEvent()
 
 	return mp.revalidateHighPriorityTransactions()
 }
@@ -206,13 +266,15 @@ func (mp *mempool) RevalidateHighPriorityTransactions() (validTransactions []*ex
 func (mp *mempool) RemoveTransactions(transactions []*externalapi.DomainTransaction, removeRedeemers bool) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	defer mp.sendMempoolChangedEvent()
 
-	return mp.removeTransactions(transactions, removeRedeemers)
+	return mp.removeTransactions(transactions, removeRedeemers, miningmanagermodel.TransactionRemovalReasonInvalid)
 }
 
 func (mp *mempool) RemoveTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	defer mp.sendMempoolChangedEvent()
 
-	return mp.removeTransaction(transactionID, removeRedeemers)
+	return mp.removeTransaction(transactionID, removeRedeemers, miningmanagermodel.TransactionRemovalReasonInvalid)
 }
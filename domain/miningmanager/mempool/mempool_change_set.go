@@ -0,0 +1,55 @@
+package mempool
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+)
+
+// recordTransactionAdded records that the given transaction was added to the mempool,
+// to be sent by the next call to sendMempoolChangedEvent
+func (mp *mempool) recordTransactionAdded(transaction *externalapi.DomainTransaction, isOrphan bool) {
+	if mp.mempoolEventsChan == nil {
+		return
+	}
+	mp.pendingChanges = append(mp.pendingChanges, &miningmanagermodel.MempoolChange{
+		Transaction: transaction.Clone(), //this pointer leaves the mempool, hence we clone.
+		IsOrphan:    isOrphan,
+	})
+}
+
+// recordTransactionRemoved records that the given transaction was removed from the mempool
+// for the given reason, to be sent by the next call to sendMempoolChangedEvent
+func (mp *mempool) recordTransactionRemoved(transaction *externalapi.DomainTransaction, isOrphan bool,
+	reason miningmanagermodel.TransactionRemovalReason) {
+
+	if mp.mempoolEventsChan == nil {
+		return
+	}
+	mp.pendingChanges = append(mp.pendingChanges, &miningmanagermodel.MempoolChange{
+		Transaction:   transaction.Clone(), //this pointer leaves the mempool, hence we clone.
+		IsOrphan:      isOrphan,
+		IsRemoved:     true,
+		RemovalReason: reason,
+	})
+}
+
+// sendMempoolChangedEvent sends all the changes recorded since it was last called as a
+// single MempoolChangeSet. It's meant to be deferred by every exported method that might
+// modify the mempool, while the mempool lock is still held, so that the events are sent
+// in the order the changes happened in.
+func (mp *mempool) sendMempoolChangedEvent() {
+	if len(mp.pendingChanges) == 0 {
+		return
+	}
+	changeSet := &miningmanagermodel.MempoolChangeSet{Changes: mp.pendingChanges}
+	mp.pendingChanges = nil
+
+	// A full channel means that nobody is consuming the events fast enough. Dropping
+	// them is preferable to failing to update the mempool, which must keep up with the DAG
+	if len(mp.mempoolEventsChan) == cap(mp.mempoolEventsChan) {
+		log.Warnf("mempoolEventsChan is full. Dropping a change set of %d mempool changes",
+			len(changeSet.Changes))
+		return
+	}
+	mp.mempoolEventsChan <- changeSet
+}
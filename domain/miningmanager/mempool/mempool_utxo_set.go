@@ -80,3 +80,24 @@ func (mpus *mempoolUTXOSet) checkDoubleSpends(transaction *externalapi.DomainTra
 
 	return nil
 }
+
+// getConflictingTransactions returns all the mempool transactions that spend
+// any of the outpoints spent by the given transaction
+func (mpus *mempoolUTXOSet) getConflictingTransactions(transaction *externalapi.DomainTransaction) []*model.MempoolTransaction {
+	conflictingTransactions := []*model.MempoolTransaction{}
+	conflictingTransactionIDs := make(map[externalapi.DomainTransactionID]struct{})
+
+	for _, input := range transaction.Inputs {
+		existingTransaction, exists := mpus.transactionByPreviousOutpoint[input.PreviousOutpoint]
+		if !exists {
+			continue
+		}
+		if _, ok := conflictingTransactionIDs[*existingTransaction.TransactionID()]; ok {
+			continue
+		}
+		conflictingTransactionIDs[*existingTransaction.TransactionID()] = struct{}{}
+		conflictingTransactions = append(conflictingTransactions, existingTransaction)
+	}
+
+	return conflictingTransactions
+}
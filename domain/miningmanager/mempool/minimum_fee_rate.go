@@ -0,0 +1,90 @@
+package mempool
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+)
+
+// minimumFeeRateHalfLife is the time it takes the dynamic minimum fee rate to
+// drop by half while the transaction pool is at least half full. It drops faster
+// while the transaction pool is emptier.
+const minimumFeeRateHalfLife = 12 * time.Hour
+
+// minimumFeeRate is the lowest fee rate, in sompi per gram, a transaction must pay in
+// order to enter the transaction pool while it's full or was recently full.
+//
+// Whenever transactions are evicted because the transaction pool is full, it rises
+// above the fee rate of the evicted packages by the minimum relay fee rate, since
+// a transaction paying less would be evicted right away. It then decays exponentially,
+// and drops to zero once it's less than half the minimum relay fee rate.
+type minimumFeeRate struct {
+	feeRate             float64 // The fee rate as of lastUpdateTime
+	lastUpdateTime      time.Time
+	minimumRelayFeeRate float64
+}
+
+func newMinimumFeeRate(minimumRelayFeeRate float64) *minimumFeeRate {
+	return &minimumFeeRate{
+		feeRate:             0,
+		lastUpdateTime:      time.Now(),
+		minimumRelayFeeRate: minimumRelayFeeRate,
+	}
+}
+
+// get returns the current minimum fee rate, decayed according to the time passed since
+// it was last raised and to how full the transaction pool currently is
+func (mfr *minimumFeeRate) get(totalMass uint64, maximumTotalMass uint64) float64 {
+	if mfr.feeRate == 0 {
+		return 0
+	}
+
+	halfLife := minimumFeeRateHalfLife
+	if totalMass < maximumTotalMass/4 {
+		halfLife /= 4
+	} else if totalMass < maximumTotalMass/2 {
+		halfLife /= 2
+	}
+	feeRate := mfr.feeRate / math.Pow(2, float64(time.Since(mfr.lastUpdateTime))/float64(halfLife))
+	if feeRate < mfr.minimumRelayFeeRate/2 {
+		return 0
+	}
+	return feeRate
+}
+
+// raise raises the minimum fee rate above the fee rate of an evicted package
+func (mfr *minimumFeeRate) raise(evictedPackageFeeRate float64, totalMass uint64, maximumTotalMass uint64) {
+	feeRate := evictedPackageFeeRate + mfr.minimumRelayFeeRate
+	if currentFeeRate := mfr.get(totalMass, maximumTotalMass); currentFeeRate > feeRate {
+		feeRate = currentFeeRate
+	}
+	mfr.feeRate = feeRate
+	mfr.lastUpdateTime = time.Now()
+}
+
+// currentMinimumFeeRate returns the dynamic minimum fee rate of the transaction pool.
+// See minimumFeeRate for details
+func (tp *transactionsPool) currentMinimumFeeRate() float64 {
+	return tp.minimumFeeRate.get(tp.totalMass, tp.mempool.config.MaximumTransactionsMass)
+}
+
+// checkMinimumFeeRate makes sure that the given transaction pays at least the dynamic
+// minimum fee rate, which only exceeds the minimum relay fee while the transaction pool
+// is full or was recently full
+func (mp *mempool) checkMinimumFeeRate(transaction *externalapi.DomainTransaction) error {
+	minimumFeeRate := mp.transactionsPool.currentMinimumFeeRate()
+	if minimumFeeRate == 0 {
+		return nil
+	}
+	minimumFee := uint64(math.Ceil(float64(transaction.Mass) * minimumFeeRate))
+	if transaction.Fee < minimumFee {
+		str := fmt.Sprintf("transaction %s has %d fees which is under the required amount of %d, "+
+			"since the mempool is full and only accepts transactions paying at least %f sompi per gram",
+			consensushashing.TransactionID(transaction), transaction.Fee, minimumFee, minimumFeeRate)
+		return transactionRuleError(RejectInsufficientFee, str)
+	}
+	return nil
+}
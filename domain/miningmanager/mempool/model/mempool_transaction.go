@@ -7,10 +7,13 @@ import (
 
 // MempoolTransaction represents a transaction inside the main TransactionPool
 type MempoolTransaction struct {
-	transaction              *externalapi.DomainTransaction
-	parentTransactionsInPool IDToTransactionMap
-	isHighPriority           bool
-	addedAtDAAScore          uint64
+	transaction                *externalapi.DomainTransaction
+	parentTransactionsInPool   IDToTransactionMap
+	ancestorTransactionsInPool IDToTransactionMap
+	ancestorFee                uint64
+	ancestorMass               uint64
+	isHighPriority             bool
+	addedAtDAAScore            uint64
 }
 
 // NewMempoolTransaction constructs a new MempoolTransaction
@@ -20,11 +23,28 @@ func NewMempoolTransaction(
 	isHighPriority bool,
 	addedAtDAAScore uint64,
 ) *MempoolTransaction {
+	ancestorTransactionsInPool := IDToTransactionMap{}
+	for parentID, parent := range parentTransactionsInPool {
+		ancestorTransactionsInPool[parentID] = parent
+		for ancestorID, ancestor := range parent.ancestorTransactionsInPool {
+			ancestorTransactionsInPool[ancestorID] = ancestor
+		}
+	}
+
+	ancestorFee, ancestorMass := transaction.Fee, transaction.Mass
+	for _, ancestor := range ancestorTransactionsInPool {
+		ancestorFee += ancestor.transaction.Fee
+		ancestorMass += ancestor.transaction.Mass
+	}
+
 	return &MempoolTransaction{
-		transaction:              transaction,
-		parentTransactionsInPool: parentTransactionsInPool,
-		isHighPriority:           isHighPriority,
-		addedAtDAAScore:          addedAtDAAScore,
+		transaction:                transaction,
+		parentTransactionsInPool:   parentTransactionsInPool,
+		ancestorTransactionsInPool: ancestorTransactionsInPool,
+		ancestorFee:                ancestorFee,
+		ancestorMass:               ancestorMass,
+		isHighPriority:             isHighPriority,
+		addedAtDAAScore:            addedAtDAAScore,
 	}
 }
 
@@ -48,6 +68,34 @@ func (mt *MempoolTransaction) RemoveParentTransactionInPool(transactionID *exter
 	delete(mt.parentTransactionsInPool, *transactionID)
 }
 
+// AncestorTransactionsInPool returns all the ancestors of this transaction that exist in the mempool,
+// that is its parents in the mempool, their parents in the mempool and so on
+func (mt *MempoolTransaction) AncestorTransactionsInPool() IDToTransactionMap {
+	return mt.ancestorTransactionsInPool
+}
+
+// RemoveAncestorTransactionInPool deletes a transaction from the ancestorTransactionsInPool set,
+// and from the ancestor fee and mass
+func (mt *MempoolTransaction) RemoveAncestorTransactionInPool(transactionID *externalapi.DomainTransactionID) {
+	ancestor, ok := mt.ancestorTransactionsInPool[*transactionID]
+	if !ok {
+		return
+	}
+	delete(mt.ancestorTransactionsInPool, *transactionID)
+	mt.ancestorFee -= ancestor.transaction.Fee
+	mt.ancestorMass -= ancestor.transaction.Mass
+}
+
+// AncestorFee returns the total fee of this transaction and all its ancestors in the mempool
+func (mt *MempoolTransaction) AncestorFee() uint64 {
+	return mt.ancestorFee
+}
+
+// AncestorMass returns the total mass of this transaction and all its ancestors in the mempool
+func (mt *MempoolTransaction) AncestorMass() uint64 {
+	return mt.ancestorMass
+}
+
 // IsHighPriority returns whether this MempoolTransaction is a high-priority one
 func (mt *MempoolTransaction) IsHighPriority() bool {
 	return mt.isHighPriority
@@ -57,3 +105,9 @@ func (mt *MempoolTransaction) IsHighPriority() bool {
 func (mt *MempoolTransaction) AddedAtDAAScore() uint64 {
 	return mt.addedAtDAAScore
 }
+
+// SetAddedAtDAAScore overrides the virtual DAA score at which this MempoolTransaction was added to the mempool.
+// It's used when a transaction is loaded back into the mempool, so that it expires as if it never left it
+func (mt *MempoolTransaction) SetAddedAtDAAScore(addedAtDAAScore uint64) {
+	mt.addedAtDAAScore = addedAtDAAScore
+}
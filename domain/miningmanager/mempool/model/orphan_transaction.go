@@ -44,3 +44,9 @@ func (ot *OrphanTransaction) IsHighPriority() bool {
 func (ot *OrphanTransaction) AddedAtDAAScore() uint64 {
 	return ot.addedAtDAAScore
 }
+
+// SetAddedAtDAAScore overrides the virtual DAA score at which this OrphanTransaction was added to the mempool.
+// It's used when a transaction is loaded back into the mempool, so that it expires as if it never left it
+func (ot *OrphanTransaction) SetAddedAtDAAScore(addedAtDAAScore uint64) {
+	ot.addedAtDAAScore = addedAtDAAScore
+}
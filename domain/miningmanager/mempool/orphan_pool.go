@@ -13,6 +13,7 @@ import (
 
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
 	"github.com/pkg/errors"
 )
 
@@ -80,7 +81,7 @@ func (op *orphansPool) limitOrphanPoolSize() error {
 
 		// Don't remove redeemers in the case of a random eviction since the evicted transaction is
 		// not invalid, therefore it's redeemers are as good as any orphan that just arrived.
-		err := op.removeOrphan(orphanToRemove.TransactionID(), false)
+		err := op.removeOrphan(orphanToRemove.TransactionID(), false, miningmanagermodel.TransactionRemovalReasonEvicted)
 		if err != nil {
 			return err
 		}
@@ -130,6 +131,7 @@ func (op *orphansPool) addOrphan(transaction *externalapi.DomainTransaction, isH
 	for _, input := range transaction.Inputs {
 		op.orphansByPreviousOutpoint[input.PreviousOutpoint] = orphanTransaction
 	}
+	op.mempool.recordTransactionAdded(transaction, true)
 
 	return nil
 }
@@ -188,23 +190,19 @@ func countUnfilledInputs(orphan *model.OrphanTransaction) int {
 }
 
 func (op *orphansPool) unorphanTransaction(transaction *model.OrphanTransaction) error {
-	err := op.removeOrphan(transaction.TransactionID(), false)
+	err := op.validateUnorphanedTransaction(transaction)
 	if err != nil {
-		return err
-	}
-
-	err = op.mempool.consensusReference.Consensus().ValidateTransactionAndPopulateWithConsensusData(transaction.Transaction())
-	if err != nil {
-		if errors.Is(err, ruleerrors.ErrImmatureSpend) {
-			return transactionRuleError(RejectImmatureSpend, "one of the transaction inputs spends an immature UTXO")
-		}
-		if errors.As(err, &ruleerrors.RuleError{}) {
-			return newRuleError(err)
+		if errors.As(err, &RuleError{}) {
+			removeErr := op.removeOrphan(transaction.TransactionID(), false,
+				miningmanagermodel.TransactionRemovalReasonInvalid)
+			if removeErr != nil {
+				return removeErr
+			}
 		}
 		return err
 	}
 
-	err = op.mempool.validateTransactionInContext(transaction.Transaction())
+	err = op.removeOrphan(transaction.TransactionID(), false, miningmanagermodel.TransactionRemovalReasonOrphanResolved)
 	if err != nil {
 		return err
 	}
@@ -216,7 +214,9 @@ func (op *orphansPool) unorphanTransaction(transaction *model.OrphanTransaction)
 	mempoolTransaction := model.NewMempoolTransaction(
 		transaction.Transaction(),
 		op.mempool.transactionsPool.getParentTransactionsInPool(transaction.Transaction()),
-		false,
+		// Keep the priority the orphan was submitted with, so that a high-priority
+		// transaction doesn't become evictable once its parents arrive
+		transaction.IsHighPriority(),
 		virtualDAAScore,
 	)
 	err = op.mempool.transactionsPool.addMempoolTransaction(mempoolTransaction)
@@ -227,7 +227,33 @@ func (op *orphansPool) unorphanTransaction(transaction *model.OrphanTransaction)
 	return nil
 }
 
-func (op *orphansPool) removeOrphan(orphanTransactionID *externalapi.DomainTransactionID, removeRedeemers bool) error {
+// validateUnorphanedTransaction validates an orphan transaction all of whose inputs have
+// been filled, before it's moved from the orphan pool into the transaction pool
+func (op *orphansPool) validateUnorphanedTransaction(transaction *model.OrphanTransaction) error {
+	err := op.mempool.consensusReference.Consensus().ValidateTransactionAndPopulateWithConsensusData(transaction.Transaction())
+	if err != nil {
+		if errors.Is(err, ruleerrors.ErrImmatureSpend) {
+			return transactionRuleError(RejectImmatureSpend, "one of the transaction inputs spends an immature UTXO")
+		}
+		if errors.As(err, &ruleerrors.RuleError{}) {
+			return newRuleError(err)
+		}
+		return err
+	}
+
+	err = op.mempool.validateTransactionInContext(transaction.Transaction())
+	if err != nil {
+		return err
+	}
+	if !transaction.IsHighPriority() {
+		return op.mempool.checkMinimumFeeRate(transaction.Transaction())
+	}
+	return nil
+}
+
+func (op *orphansPool) removeOrphan(orphanTransactionID *externalapi.DomainTransactionID, removeRedeemers bool,
+	reason miningmanagermodel.TransactionRemovalReason) error {
+
 	orphanTransaction, ok := op.allOrphans[*orphanTransactionID]
 	if !ok {
 		return nil
@@ -242,9 +268,10 @@ func (op *orphansPool) removeOrphan(orphanTransactionID *externalapi.DomainTrans
 		}
 		delete(op.orphansByPreviousOutpoint, input.PreviousOutpoint)
 	}
+	op.mempool.recordTransactionRemoved(orphanTransaction.Transaction(), true, reason)
 
 	if removeRedeemers {
-		err := op.removeRedeemersOf(orphanTransaction)
+		err := op.removeRedeemersOf(orphanTransaction, reason)
 		if err != nil {
 			return err
 		}
@@ -253,13 +280,13 @@ func (op *orphansPool) removeOrphan(orphanTransactionID *externalapi.DomainTrans
 	return nil
 }
 
-func (op *orphansPool) removeRedeemersOf(transaction model.Transaction) error {
+func (op *orphansPool) removeRedeemersOf(transaction model.Transaction, reason miningmanagermodel.TransactionRemovalReason) error {
 	outpoint := externalapi.DomainOutpoint{TransactionID: *transaction.TransactionID()}
 	for i := range transaction.Transaction().Outputs {
 		outpoint.Index = uint32(i)
 		if orphan, ok := op.orphansByPreviousOutpoint[outpoint]; ok {
 			// Recursive call is bound by size of orphan pool (which is very small)
-			err := op.removeOrphan(orphan.TransactionID(), true)
+			err := op.removeOrphan(orphan.TransactionID(), true, reason)
 			if err != nil {
 				return err
 			}
@@ -286,7 +313,7 @@ func (op *orphansPool) expireOrphanTransactions() error {
 
 		// Remove all transactions whose addedAtDAAScore is older then TransactionExpireIntervalDAAScore
 		if virtualDAAScore-orphanTransaction.AddedAtDAAScore() > op.mempool.config.OrphanExpireIntervalDAAScore {
-			err = op.removeOrphan(orphanTransaction.TransactionID(), false)
+			err = op.removeOrphan(orphanTransaction.TransactionID(), false, miningmanagermodel.TransactionRemovalReasonExpired)
 			if err != nil {
 				return err
 			}
@@ -298,10 +325,11 @@ func (op *orphansPool) expireOrphanTransactions() error {
 }
 
 func (op *orphansPool) updateOrphansAfterTransactionRemoved(
-	removedTransaction *model.MempoolTransaction, removeRedeemers bool) error {
+	removedTransaction *model.MempoolTransaction, removeRedeemers bool,
+	reason miningmanagermodel.TransactionRemovalReason) error {
 
 	if removeRedeemers {
-		return op.removeRedeemersOf(removedTransaction)
+		return op.removeRedeemersOf(removedTransaction, reason)
 	}
 
 	outpoint := externalapi.DomainOutpoint{TransactionID: *removedTransaction.TransactionID()}
@@ -373,3 +401,11 @@ func (op *orphansPool) getAllOrphanTransactions() []*externalapi.DomainTransacti
 func (op *orphansPool) orphanTransactionCount() int {
 	return len(op.allOrphans)
 }
+
+func (op *orphansPool) orphanTransactionMass() uint64 {
+	orphanTransactionMass := uint64(0)
+	for _, orphanTransaction := range op.allOrphans {
+		orphanTransactionMass += orphanTransaction.Transaction().Mass
+	}
+	return orphanTransactionMass
+}
@@ -0,0 +1,297 @@
+package mempool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"github.com/kaspanet/kaspad/domain/consensus/database/serialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/miningmanager/mempool/model"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// The mempool is saved in the following format:
+//
+//   - The magic string
+//   - The format version, as a little-endian uint32
+//   - The amount of saved transactions, as a little-endian uint64
+//   - For every transaction:
+//     - Its flags byte (see entryFlagHighPriority and entryFlagOrphan)
+//     - The DAA score at which it was added to the mempool, as a little-endian uint64
+//     - Its length as a little-endian uint32, followed by the transaction, serialized as a DbTransaction
+//
+// The transactions of the transaction pool are saved before the orphans, with
+// every transaction saved after its parents in the pool, so that loading them
+// in order never turns a transaction into an orphan.
+
+// PersistenceVersion is the version of the format the mempool is saved in
+const PersistenceVersion uint32 = 1
+
+const (
+	persistenceMagic = "KASMEMPL"
+
+	entryFlagHighPriority byte = 1 << 0
+	entryFlagOrphan       byte = 1 << 1
+
+	// maxSerializedTransactionSize exists so that a corrupted length prefix
+	// won't make us allocate an arbitrary amount of memory
+	maxSerializedTransactionSize = 1 << 24 // 16 MiB
+)
+
+type persistedTransaction struct {
+	transaction     *externalapi.DomainTransaction
+	isHighPriority  bool
+	isOrphan        bool
+	addedAtDAAScore uint64
+}
+
+func (mp *mempool) Save(w io.Writer) (savedCount int, err error) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	transactions := mp.persistedTransactions()
+
+	bufferedWriter := bufio.NewWriter(w)
+	_, err = bufferedWriter.WriteString(persistenceMagic)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	err = binary.Write(bufferedWriter, binary.LittleEndian, PersistenceVersion)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	err = binary.Write(bufferedWriter, binary.LittleEndian, uint64(len(transactions)))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	for _, transaction := range transactions {
+		err := writePersistedTransaction(bufferedWriter, transaction)
+		if err != nil {
+			return 0, err
+		}
+	}
+	err = bufferedWriter.Flush()
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return len(transactions), nil
+}
+
+// persistedTransactions returns all the transactions of the mempool in the order they are saved in
+func (mp *mempool) persistedTransactions() []*persistedTransaction {
+	transactions := make([]*persistedTransaction, 0,
+		len(mp.transactionsPool.allTransactions)+len(mp.orphansPool.allOrphans))
+
+	visited := make(map[externalapi.DomainTransactionID]struct{}, len(mp.transactionsPool.allTransactions))
+	var visit func(transaction *model.MempoolTransaction)
+	visit = func(transaction *model.MempoolTransaction) {
+		if _, ok := visited[*transaction.TransactionID()]; ok {
+			return
+		}
+		visited[*transaction.TransactionID()] = struct{}{}
+		for _, parent := range transaction.ParentTransactionsInPool() {
+			visit(parent)
+		}
+		transactions = append(transactions, &persistedTransaction{
+			transaction:     transaction.Transaction(),
+			isHighPriority:  transaction.IsHighPriority(),
+			addedAtDAAScore: transaction.AddedAtDAAScore(),
+		})
+	}
+	for _, transaction := range mp.transactionsPool.allTransactions {
+		visit(transaction)
+	}
+
+	for _, orphan := range mp.orphansPool.allOrphans {
+		transactions = append(transactions, &persistedTransaction{
+			transaction:     orphan.Transaction(),
+			isHighPriority:  orphan.IsHighPriority(),
+			isOrphan:        true,
+			addedAtDAAScore: orphan.AddedAtDAAScore(),
+		})
+	}
+
+	return transactions
+}
+
+func writePersistedTransaction(w io.Writer, transaction *persistedTransaction) error {
+	flags := byte(0)
+	if transaction.isHighPriority {
+		flags |= entryFlagHighPriority
+	}
+	if transaction.isOrphan {
+		flags |= entryFlagOrphan
+	}
+	_, err := w.Write([]byte{flags})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = binary.Write(w, binary.LittleEndian, transaction.addedAtDAAScore)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	transactionBytes, err := proto.Marshal(serialization.DomainTransactionToDbTransaction(transaction.transaction))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = binary.Write(w, binary.LittleEndian, uint32(len(transactionBytes)))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = w.Write(transactionBytes)
+	return errors.WithStack(err)
+}
+
+func (mp *mempool) Load(r io.Reader) (loadedTransactions []*externalapi.DomainTransaction, err error) {
+	bufferedReader := bufio.NewReader(r)
+	transactionCount, err := readPersistenceHeader(bufferedReader)
+	if err != nil {
+		return nil, err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	defer mp.sendMempoolChangedEvent()
+
+	virtualDAAScore, err := mp.consensusReference.Consensus().GetVirtualDAAScore()
+	if err != nil {
+		return nil, err
+	}
+
+	expiredCount, rejectedCount := 0, 0
+	for i := uint64(0); i < transactionCount; i++ {
+		transaction, err := readPersistedTransaction(bufferedReader)
+		if err != nil {
+			return nil, err
+		}
+
+		// The virtual DAA score may be lower than the saved one if the node was
+		// reset since, in which case the transaction is treated as just added
+		if transaction.addedAtDAAScore > virtualDAAScore {
+			transaction.addedAtDAAScore = virtualDAAScore
+		}
+		if mp.isPersistedTransactionExpired(transaction, virtualDAAScore) {
+			expiredCount++
+			continue
+		}
+
+		acceptedTransactions, _, err := mp.validateAndInsertTransaction(
+			transaction.transaction, transaction.isHighPriority, transaction.isOrphan, false)
+		if err != nil {
+			// The transaction may have been included in a block or double spent since it was saved
+			if errors.As(err, &RuleError{}) {
+				log.Debugf("Skipping loading transaction %s: %s",
+					consensushashing.TransactionID(transaction.transaction), err)
+				rejectedCount++
+				continue
+			}
+			return nil, err
+		}
+		mp.restoreAddedAtDAAScore(consensushashing.TransactionID(transaction.transaction), transaction.addedAtDAAScore)
+		loadedTransactions = append(loadedTransactions, acceptedTransactions...)
+	}
+
+	log.Infof("Loaded %d transactions into the mempool. Skipped %d expired and %d invalid transactions",
+		transactionCount-uint64(expiredCount+rejectedCount), expiredCount, rejectedCount)
+	return loadedTransactions, nil
+}
+
+func readPersistenceHeader(r io.Reader) (transactionCount uint64, err error) {
+	magicBytes := make([]byte, len(persistenceMagic))
+	_, err = io.ReadFull(r, magicBytes)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed reading the saved mempool header")
+	}
+	if string(magicBytes) != persistenceMagic {
+		return 0, errors.Errorf("the given file is not a saved mempool")
+	}
+
+	var version uint32
+	err = binary.Read(r, binary.LittleEndian, &version)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed reading the saved mempool version")
+	}
+	if version != PersistenceVersion {
+		return 0, errors.Errorf("unsupported saved mempool version %d. Expected version: %d",
+			version, PersistenceVersion)
+	}
+
+	err = binary.Read(r, binary.LittleEndian, &transactionCount)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed reading the saved mempool transaction count")
+	}
+	return transactionCount, nil
+}
+
+func readPersistedTransaction(r io.Reader) (*persistedTransaction, error) {
+	var flags byte
+	err := binary.Read(r, binary.LittleEndian, &flags)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading a saved mempool transaction")
+	}
+	var addedAtDAAScore uint64
+	err = binary.Read(r, binary.LittleEndian, &addedAtDAAScore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading a saved mempool transaction")
+	}
+
+	var length uint32
+	err = binary.Read(r, binary.LittleEndian, &length)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading a saved mempool transaction")
+	}
+	if length > maxSerializedTransactionSize {
+		return nil, errors.Errorf("saved mempool transaction of size %d exceeds the maximum of %d",
+			length, maxSerializedTransactionSize)
+	}
+	transactionBytes := make([]byte, length)
+	_, err = io.ReadFull(r, transactionBytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading a saved mempool transaction")
+	}
+	dbTransaction := &serialization.DbTransaction{}
+	err = proto.Unmarshal(transactionBytes, dbTransaction)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed deserializing a saved mempool transaction")
+	}
+	transaction, err := serialization.DbTransactionToDomainTransaction(dbTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &persistedTransaction{
+		transaction:     transaction,
+		isHighPriority:  flags&entryFlagHighPriority != 0,
+		isOrphan:        flags&entryFlagOrphan != 0,
+		addedAtDAAScore: addedAtDAAScore,
+	}, nil
+}
+
+func (mp *mempool) isPersistedTransactionExpired(transaction *persistedTransaction, virtualDAAScore uint64) bool {
+	// High priority transactions never expire
+	if transaction.isHighPriority {
+		return false
+	}
+	expireInterval := mp.config.TransactionExpireIntervalDAAScore
+	if transaction.isOrphan {
+		expireInterval = mp.config.OrphanExpireIntervalDAAScore
+	}
+	return virtualDAAScore-transaction.addedAtDAAScore > expireInterval
+}
+
+// restoreAddedAtDAAScore sets the DAA score at which a loaded transaction was originally
+// added to the mempool, so that it doesn't live longer than it would have without a restart
+func (mp *mempool) restoreAddedAtDAAScore(transactionID *externalapi.DomainTransactionID, addedAtDAAScore uint64) {
+	if transaction, ok := mp.transactionsPool.allTransactions[*transactionID]; ok {
+		transaction.SetAddedAtDAAScore(addedAtDAAScore)
+		return
+	}
+	if orphan, ok := mp.orphansPool.allOrphans[*transactionID]; ok {
+		orphan.SetAddedAtDAAScore(addedAtDAAScore)
+	}
+}
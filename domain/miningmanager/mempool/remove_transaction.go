@@ -4,11 +4,14 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
 	"github.com/kaspanet/kaspad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
 )
 
-func (mp *mempool) removeTransactions(transactions []*externalapi.DomainTransaction, removeRedeemers bool) error {
+func (mp *mempool) removeTransactions(transactions []*externalapi.DomainTransaction, removeRedeemers bool,
+	reason miningmanagermodel.TransactionRemovalReason) error {
+
 	for _, transaction := range transactions {
-		err := mp.removeTransaction(consensushashing.TransactionID(transaction), removeRedeemers)
+		err := mp.removeTransaction(consensushashing.TransactionID(transaction), removeRedeemers, reason)
 		if err != nil {
 			return err
 		}
@@ -16,9 +19,13 @@ func (mp *mempool) removeTransactions(transactions []*externalapi.DomainTransact
 	return nil
 }
 
-func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool) error {
+// removeTransaction removes the given transaction from the mempool, alongside its redeemers if
+// removeRedeemers is set. The given reason is reported for every transaction that is removed
+func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool,
+	reason miningmanagermodel.TransactionRemovalReason) error {
+
 	if _, ok := mp.orphansPool.allOrphans[*transactionID]; ok {
-		return mp.orphansPool.removeOrphan(transactionID, true)
+		return mp.orphansPool.removeOrphan(transactionID, true, reason)
 	}
 
 	mempoolTransaction, ok := mp.transactionsPool.allTransactions[*transactionID]
@@ -33,18 +40,20 @@ func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactio
 	} else {
 		for _, redeemer := range redeemers {
 			redeemer.RemoveParentTransactionInPool(transactionID)
+			redeemer.RemoveAncestorTransactionInPool(transactionID)
 		}
 	}
 
 	for _, transactionToRemove := range transactionsToRemove {
-		err := mp.removeTransactionFromSets(transactionToRemove, removeRedeemers)
+		err := mp.removeTransactionFromSets(transactionToRemove, removeRedeemers, reason)
 		if err != nil {
 			return err
 		}
+		mp.recordTransactionRemoved(transactionToRemove.Transaction(), false, reason)
 	}
 
 	if removeRedeemers {
-		err := mp.orphansPool.removeRedeemersOf(mempoolTransaction)
+		err := mp.orphansPool.removeRedeemersOf(mempoolTransaction, reason)
 		if err != nil {
 			return err
 		}
@@ -53,7 +62,9 @@ func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactio
 	return nil
 }
 
-func (mp *mempool) removeTransactionFromSets(mempoolTransaction *model.MempoolTransaction, removeRedeemers bool) error {
+func (mp *mempool) removeTransactionFromSets(mempoolTransaction *model.MempoolTransaction, removeRedeemers bool,
+	reason miningmanagermodel.TransactionRemovalReason) error {
+
 	mp.mempoolUTXOSet.removeTransaction(mempoolTransaction)
 
 	err := mp.transactionsPool.removeTransaction(mempoolTransaction)
@@ -61,7 +72,7 @@ func (mp *mempool) removeTransactionFromSets(mempoolTransaction *model.MempoolTr
 		return err
 	}
 
-	err = mp.orphansPool.updateOrphansAfterTransactionRemoved(mempoolTransaction, removeRedeemers)
+	err = mp.orphansPool.updateOrphansAfterTransactionRemoved(mempoolTransaction, removeRedeemers, reason)
 	if err != nil {
 		return err
 	}
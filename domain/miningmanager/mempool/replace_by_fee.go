@@ -0,0 +1,132 @@
+package mempool
+
+import (
+	"fmt"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+)
+
+// maximumReplacedTransactionCount is the maximum amount of mempool transactions a single
+// replacement transaction is allowed to evict, including the redeemers of the transactions
+// it double spends
+const maximumReplacedTransactionCount = 100
+
+// replaceConflictingTransactions removes from the mempool all the transactions double spent by
+// the given transaction, alongside their redeemers, given that the replace-by-fee rules are met:
+//  1. The transaction double spends at least one mempool transaction
+//  2. The fee rate of the transaction is strictly higher than that of every transaction it double spends
+//  3. The transaction pays for all the fees of the transactions it evicts, and on top of that for
+//     its own relay
+//  4. The transaction doesn't spend outputs of any of the transactions it evicts
+//  5. No more than maximumReplacedTransactionCount transactions are evicted
+//  6. The transaction wouldn't be evicted right away because the transaction pool is full, in which case
+//     the replacement would only drop the transactions it double spends from the mempool
+//
+// Returns the transactions that were removed from the mempool.
+func (mp *mempool) replaceConflictingTransactions(transaction *externalapi.DomainTransaction,
+	parentsInPool model.IDToTransactionMap, isHighPriority bool) ([]*externalapi.DomainTransaction, error) {
+
+	conflictingTransactions := mp.mempoolUTXOSet.getConflictingTransactions(transaction)
+	transactionsToEvict, err := mp.validateReplacement(transaction, parentsInPool, conflictingTransactions,
+		isHighPriority)
+	if err != nil {
+		return nil, err
+	}
+
+	replacedTransactions := make([]*externalapi.DomainTransaction, len(transactionsToEvict))
+	for i, transactionToEvict := range transactionsToEvict {
+		replacedTransactions[i] = transactionToEvict.Transaction().Clone() //this pointer leaves the mempool, hence we clone.
+	}
+
+	for _, conflictingTransaction := range conflictingTransactions {
+		log.Debugf("Replacing transaction %s with transaction %s",
+			conflictingTransaction.TransactionID(), consensushashing.TransactionID(transaction))
+		err := mp.removeTransaction(conflictingTransaction.TransactionID(), true,
+			miningmanagermodel.TransactionRemovalReasonDoubleSpent)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return replacedTransactions, nil
+}
+
+// validateReplacement makes sure that the given transaction is allowed to replace the given
+// conflicting transactions, and returns all the transactions the replacement would evict
+func (mp *mempool) validateReplacement(transaction *externalapi.DomainTransaction,
+	parentsInPool model.IDToTransactionMap, conflictingTransactions []*model.MempoolTransaction,
+	isHighPriority bool) ([]*model.MempoolTransaction, error) {
+
+	transactionID := consensushashing.TransactionID(transaction)
+	if len(conflictingTransactions) == 0 {
+		str := fmt.Sprintf("transaction %s does not double spend any transaction in the mempool", transactionID)
+		return nil, transactionRuleError(RejectInvalid, str)
+	}
+
+	transactionFeeRate := feeRate(transaction)
+	transactionsToEvict := []*model.MempoolTransaction{}
+	transactionIDsToEvict := make(map[externalapi.DomainTransactionID]struct{})
+	addTransactionToEvict := func(transactionToEvict *model.MempoolTransaction) {
+		if _, ok := transactionIDsToEvict[*transactionToEvict.TransactionID()]; ok {
+			return
+		}
+		transactionIDsToEvict[*transactionToEvict.TransactionID()] = struct{}{}
+		transactionsToEvict = append(transactionsToEvict, transactionToEvict)
+	}
+
+	for _, conflictingTransaction := range conflictingTransactions {
+		conflictingTransactionFeeRate := feeRate(conflictingTransaction.Transaction())
+		if transactionFeeRate <= conflictingTransactionFeeRate {
+			str := fmt.Sprintf("transaction %s has a fee rate of %f sompi/gram which is not higher than "+
+				"the fee rate of %f sompi/gram of transaction %s which it double spends", transactionID,
+				transactionFeeRate, conflictingTransactionFeeRate, conflictingTransaction.TransactionID())
+			return nil, transactionRuleError(RejectInsufficientFee, str)
+		}
+
+		addTransactionToEvict(conflictingTransaction)
+		for _, redeemer := range mp.transactionsPool.getRedeemers(conflictingTransaction) {
+			addTransactionToEvict(redeemer)
+		}
+	}
+
+	if len(transactionsToEvict) > maximumReplacedTransactionCount {
+		str := fmt.Sprintf("transaction %s would evict %d transactions from the mempool, which is more than "+
+			"the allowed max amount of %d", transactionID, len(transactionsToEvict), maximumReplacedTransactionCount)
+		return nil, transactionRuleError(RejectNonstandard, str)
+	}
+
+	for parentID := range parentsInPool {
+		if _, ok := transactionIDsToEvict[parentID]; ok {
+			str := fmt.Sprintf("transaction %s spends an output of transaction %s which it evicts",
+				transactionID, parentID)
+			return nil, transactionRuleError(RejectInvalid, str)
+		}
+	}
+
+	evictedFees := uint64(0)
+	for _, transactionToEvict := range transactionsToEvict {
+		evictedFees += transactionToEvict.Transaction().Fee
+	}
+	minimumFee := evictedFees + mp.minimumRequiredTransactionRelayFee(transaction.Mass)
+	if transaction.Fee < minimumFee {
+		str := fmt.Sprintf("transaction %s has %d fees which is under the required amount of %d "+
+			"for replacing %d transactions", transactionID, transaction.Fee, minimumFee, len(transactionsToEvict))
+		return nil, transactionRuleError(RejectInsufficientFee, str)
+	}
+
+	// High-priority transactions are never evicted
+	if !isHighPriority && mp.transactionsPool.wouldBeEvictedRightAway(transaction, parentsInPool, transactionsToEvict) {
+		str := fmt.Sprintf("transaction %s would be evicted right away since the mempool is full and it pays "+
+			"one of the lowest fee rates in it", transactionID)
+		return nil, transactionRuleError(RejectInsufficientFee, str)
+	}
+
+	return transactionsToEvict, nil
+}
+
+func feeRate(transaction *externalapi.DomainTransaction) float64 {
+	return float64(transaction.Fee) / float64(transaction.Mass)
+}
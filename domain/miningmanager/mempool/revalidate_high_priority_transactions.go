@@ -3,6 +3,7 @@ package mempool
 import (
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
 )
 
@@ -10,13 +11,33 @@ func (mp *mempool) revalidateHighPriorityTransactions() ([]*externalapi.DomainTr
 	onEnd := logger.LogAndMeasureExecutionTime(log, "revalidateHighPriorityTransactions")
 	defer onEnd()
 
-	validTransactions := []*externalapi.DomainTransaction{}
+	// All the transactions are validated together, so that their input scripts are validated in parallel
+	transactions := make([]*model.MempoolTransaction, 0, len(mp.transactionsPool.highPriorityTransactions))
+	domainTransactions := make([]*externalapi.DomainTransaction, 0, len(mp.transactionsPool.highPriorityTransactions))
 	for _, transaction := range mp.transactionsPool.highPriorityTransactions {
-		isValid, err := mp.revalidateTransaction(transaction)
-		if err != nil {
-			return nil, err
+		clearInputs(transaction)
+		transactions = append(transactions, transaction)
+		domainTransactions = append(domainTransactions, transaction.Transaction())
+	}
+	missingOutpoints, errs := mp.fillInputsAndGetMissingOutpointsOfTransactions(domainTransactions)
+
+	validTransactions := []*externalapi.DomainTransaction{}
+	for i, transaction := range transactions {
+		// The transaction might have already been removed as the redeemer of
+		// a transaction that failed revalidation
+		if _, ok := mp.transactionsPool.highPriorityTransactions[*transaction.TransactionID()]; !ok {
+			continue
+		}
+
+		if errs[i] != nil {
+			return nil, errs[i]
 		}
-		if !isValid {
+		if len(missingOutpoints[i]) > 0 {
+			log.Debugf("Removing transaction %s, it failed revalidation", transaction.TransactionID())
+			err := mp.removeTransaction(transaction.TransactionID(), true, miningmanagermodel.TransactionRemovalReasonDoubleSpent)
+			if err != nil {
+				return nil, err
+			}
 			continue
 		}
 
@@ -26,25 +47,6 @@ func (mp *mempool) revalidateHighPriorityTransactions() ([]*externalapi.DomainTr
 	return validTransactions, nil
 }
 
-func (mp *mempool) revalidateTransaction(transaction *model.MempoolTransaction) (isValid bool, err error) {
-	clearInputs(transaction)
-
-	_, missingParents, err := mp.fillInputsAndGetMissingParents(transaction.Transaction())
-	if err != nil {
-		return false, err
-	}
-	if len(missingParents) > 0 {
-		log.Debugf("Removing transaction %s, it failed revalidation", transaction.TransactionID())
-		err := mp.removeTransaction(transaction.TransactionID(), true)
-		if err != nil {
-			return false, err
-		}
-		return false, nil
-	}
-
-	return true, nil
-}
-
 func clearInputs(transaction *model.MempoolTransaction) {
 	for _, input := range transaction.Transaction().Inputs {
 		input.UTXOEntry = nil
@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"math"
 	"time"
 
 	"github.com/pkg/errors"
@@ -8,6 +9,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
 	"github.com/kaspanet/kaspad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
 )
 
 type transactionsPool struct {
@@ -18,6 +20,8 @@ type transactionsPool struct {
 	transactionsOrderedByFeeRate  model.TransactionsOrderedByFeeRate
 	lastExpireScanDAAScore        uint64
 	lastExpireScanTime            time.Time
+	totalMass                     uint64
+	minimumFeeRate                *minimumFeeRate
 }
 
 func newTransactionsPool(mp *mempool) *transactionsPool {
@@ -29,6 +33,8 @@ func newTransactionsPool(mp *mempool) *transactionsPool {
 		transactionsOrderedByFeeRate:  model.TransactionsOrderedByFeeRate{},
 		lastExpireScanDAAScore:        0,
 		lastExpireScanTime:            time.Now(),
+		totalMass:                     0,
+		minimumFeeRate:                newMinimumFeeRate(float64(mp.config.MinimumRelayTransactionFee) / 1000),
 	}
 }
 
@@ -53,6 +59,8 @@ func (tp *transactionsPool) addTransaction(transaction *externalapi.DomainTransa
 
 func (tp *transactionsPool) addMempoolTransaction(transaction *model.MempoolTransaction) error {
 	tp.allTransactions[*transaction.TransactionID()] = transaction
+	tp.totalMass += transaction.Transaction().Mass
+	tp.mempool.recordTransactionAdded(transaction.Transaction(), false)
 
 	for _, parentTransactionInPool := range transaction.ParentTransactionsInPool() {
 		parentTransactionID := *parentTransactionInPool.TransactionID()
@@ -79,6 +87,7 @@ func (tp *transactionsPool) addMempoolTransaction(transaction *model.MempoolTran
 
 func (tp *transactionsPool) removeTransaction(transaction *model.MempoolTransaction) error {
 	delete(tp.allTransactions, *transaction.TransactionID())
+	tp.totalMass -= transaction.Transaction().Mass
 
 	err := tp.transactionsOrderedByFeeRate.Remove(transaction)
 	if err != nil {
@@ -119,7 +128,8 @@ func (tp *transactionsPool) expireOldTransactions() error {
 		if daaScoreSinceAdded > tp.mempool.config.TransactionExpireIntervalDAAScore {
 			log.Debugf("Removing transaction %s, because it expired. DAAScore moved by %d, expire interval: %d",
 				mempoolTransaction.TransactionID(), daaScoreSinceAdded, tp.mempool.config.TransactionExpireIntervalDAAScore)
-			err = tp.mempool.removeTransaction(mempoolTransaction.TransactionID(), true)
+			err = tp.mempool.removeTransaction(mempoolTransaction.TransactionID(), true,
+				miningmanagermodel.TransactionRemovalReasonExpired)
 			if err != nil {
 				return err
 			}
@@ -143,6 +153,41 @@ func (tp *transactionsPool) allReadyTransactions() []*externalapi.DomainTransact
 	return result
 }
 
+// blockCandidates returns the given ready transactions along with the fee and
+// mass of the package with the highest fee rate that each of them is a part of.
+// See miningmanagermodel.BlockCandidateTransaction for details
+func (tp *transactionsPool) blockCandidates(
+	readyTransactions []*externalapi.DomainTransaction) []*miningmanagermodel.BlockCandidateTransaction {
+
+	candidates := make([]*miningmanagermodel.BlockCandidateTransaction, len(readyTransactions))
+	candidatesByID := make(map[externalapi.DomainTransactionID]*miningmanagermodel.BlockCandidateTransaction,
+		len(readyTransactions))
+	for i, transaction := range readyTransactions {
+		candidates[i] = &miningmanagermodel.BlockCandidateTransaction{
+			Transaction: transaction,
+			PackageFee:  transaction.Fee,
+			PackageMass: transaction.Mass,
+		}
+		candidatesByID[*consensushashing.TransactionID(transaction)] = candidates[i]
+	}
+
+	for _, mempoolTransaction := range tp.allTransactions {
+		packageFeeRate := float64(mempoolTransaction.AncestorFee()) / float64(mempoolTransaction.AncestorMass())
+		for ancestorID := range mempoolTransaction.AncestorTransactionsInPool() {
+			candidate, ok := candidatesByID[ancestorID]
+			if !ok {
+				continue
+			}
+			if packageFeeRate > float64(candidate.PackageFee)/float64(candidate.PackageMass) {
+				candidate.PackageFee = mempoolTransaction.AncestorFee()
+				candidate.PackageMass = mempoolTransaction.AncestorMass()
+			}
+		}
+	}
+
+	return candidates
+}
+
 func (tp *transactionsPool) getParentTransactionsInPool(
 	transaction *externalapi.DomainTransaction) model.IDToTransactionMap {
 
@@ -173,36 +218,148 @@ func (tp *transactionsPool) getRedeemers(transaction *model.MempoolTransaction)
 	return redeemers
 }
 
-func (tp *transactionsPool) limitTransactionCount() error {
-	currentIndex := 0
+func (tp *transactionsPool) isFull() bool {
+	return uint64(len(tp.allTransactions)) > tp.mempool.config.MaximumTransactionCount ||
+		tp.totalMass > tp.mempool.config.MaximumTransactionsMass
+}
 
-	for uint64(len(tp.allTransactions)) > tp.mempool.config.MaximumTransactionCount {
-		var transactionToRemove *model.MempoolTransaction
-		for {
-			transactionToRemove = tp.transactionsOrderedByFeeRate.GetByIndex(currentIndex)
-			if !transactionToRemove.IsHighPriority() {
-				break
-			}
-			currentIndex++
-			if currentIndex >= len(tp.allTransactions) {
-				log.Warnf(
-					"Number of high-priority transactions in mempool (%d) is higher than maximum allowed (%d)",
-					len(tp.allTransactions), tp.mempool.config.MaximumTransactionCount)
-				return nil
-			}
+// limitTransactionsPoolSize evicts the packages with the lowest fee rates, along with
+// their redeemers, until the transaction pool is within its count and mass limits.
+// The minimum fee rate is raised above the fee rate of every evicted package, so that
+// transactions that would be evicted right away aren't accepted in the first place
+func (tp *transactionsPool) limitTransactionsPoolSize() error {
+	for tp.isFull() {
+		transactionToRemove, packageFeeRate, _ := tp.evictionCandidate(nil)
+		if transactionToRemove == nil {
+			log.Warnf("The transaction pool (%d transactions of total mass %d) is over its limits "+
+				"(%d transactions of total mass %d), but only high-priority transactions can be evicted",
+				len(tp.allTransactions), tp.totalMass,
+				tp.mempool.config.MaximumTransactionCount, tp.mempool.config.MaximumTransactionsMass)
+			return nil
 		}
 
-		log.Debugf("Removing transaction %s, because mempoolTransaction count (%d) exceeded the limit (%d)",
-			transactionToRemove.TransactionID(), len(tp.allTransactions), tp.mempool.config.MaximumTransactionCount)
-		err := tp.mempool.removeTransaction(transactionToRemove.TransactionID(), true)
+		log.Debugf("Removing transaction %s with package fee rate %f, because the transaction pool "+
+			"(%d transactions of total mass %d) exceeded its limits", transactionToRemove.TransactionID(),
+			packageFeeRate, len(tp.allTransactions), tp.totalMass)
+		err := tp.mempool.removeTransaction(transactionToRemove.TransactionID(), true,
+			miningmanagermodel.TransactionRemovalReasonEvicted)
 		if err != nil {
 			return err
 		}
-		if currentIndex >= len(tp.allTransactions) {
+		tp.minimumFeeRate.raise(packageFeeRate, tp.totalMass, tp.mempool.config.MaximumTransactionsMass)
+	}
+	return nil
+}
+
+// evictionCandidate returns the transaction that should be evicted first from the transaction
+// pool, along with the fee rate of the package made of it and its redeemers, which are evicted
+// with it. Transactions are scored by the higher of their own fee rate and the fee rate of their
+// package, so that a low fee transaction whose redeemers pay for it isn't evicted before them.
+// The given excluded transactions are treated as if they were already removed from the transaction pool.
+// Returns nil if every transaction either is high-priority or has a high-priority redeemer.
+func (tp *transactionsPool) evictionCandidate(excludedTransactions map[externalapi.DomainTransactionID]struct{}) (
+	transaction *model.MempoolTransaction, packageFeeRate float64, score float64) {
+
+	var candidate *model.MempoolTransaction
+	candidateScore := 0.0
+	candidatePackageFeeRate := 0.0
+	for i := 0; i < len(tp.allTransactions); i++ {
+		transaction := tp.transactionsOrderedByFeeRate.GetByIndex(i)
+		feeRate := float64(transaction.Transaction().Fee) / float64(transaction.Transaction().Mass)
+		// The score of a transaction is never lower than its own fee rate, and transactionsOrderedByFeeRate
+		// is ordered by it, so none of the remaining transactions can score lower than the candidate
+		if candidate != nil && feeRate >= candidateScore {
 			break
 		}
+		if transaction.IsHighPriority() {
+			continue
+		}
+		if _, ok := excludedTransactions[*transaction.TransactionID()]; ok {
+			continue
+		}
+
+		packageFee, packageMass := transaction.Transaction().Fee, transaction.Transaction().Mass
+		hasHighPriorityRedeemer := false
+		// A redeemer may be reached through more than one of its parents
+		visitedRedeemers := make(map[externalapi.DomainTransactionID]struct{})
+		for _, redeemer := range tp.getRedeemers(transaction) {
+			if _, ok := visitedRedeemers[*redeemer.TransactionID()]; ok {
+				continue
+			}
+			visitedRedeemers[*redeemer.TransactionID()] = struct{}{}
+			if _, ok := excludedTransactions[*redeemer.TransactionID()]; ok {
+				continue
+			}
+			if redeemer.IsHighPriority() {
+				hasHighPriorityRedeemer = true
+				break
+			}
+			packageFee += redeemer.Transaction().Fee
+			packageMass += redeemer.Transaction().Mass
+		}
+		if hasHighPriorityRedeemer {
+			continue
+		}
+
+		packageFeeRate := float64(packageFee) / float64(packageMass)
+		score := math.Max(feeRate, packageFeeRate)
+		if candidate == nil || score < candidateScore {
+			candidate, candidateScore, candidatePackageFeeRate = transaction, score, packageFeeRate
+		}
 	}
-	return nil
+	return candidate, candidatePackageFeeRate, candidateScore
+}
+
+// wouldBeEvictedRightAway returns whether the given transaction would be evicted by limitTransactionsPoolSize
+// if it were added to the transaction pool once the given transactions are removed from it. This is the case
+// if the transaction pool would be over its limits, and the transaction, or one of its ancestors in the
+// transaction pool, would be evicted before enough room is made.
+// Note that the transaction isn't taken into account in the packages of its ancestors. This doesn't change the
+// result, since adding it to a package that scores lower than it can't raise the package score above it.
+func (tp *transactionsPool) wouldBeEvictedRightAway(transaction *externalapi.DomainTransaction,
+	parentTransactionsInPool model.IDToTransactionMap,
+	removedTransactions []*model.MempoolTransaction) bool {
+
+	excludedTransactions := make(map[externalapi.DomainTransactionID]struct{}, len(removedTransactions))
+	transactionCount := uint64(len(tp.allTransactions)) + 1
+	totalMass := tp.totalMass + transaction.Mass
+	exclude := func(transactionToExclude *model.MempoolTransaction) {
+		if _, ok := excludedTransactions[*transactionToExclude.TransactionID()]; ok {
+			return
+		}
+		excludedTransactions[*transactionToExclude.TransactionID()] = struct{}{}
+		transactionCount--
+		totalMass -= transactionToExclude.Transaction().Mass
+	}
+	for _, removedTransaction := range removedTransactions {
+		exclude(removedTransaction)
+	}
+
+	ancestorIDs := make(map[externalapi.DomainTransactionID]struct{})
+	for _, parentTransactionInPool := range parentTransactionsInPool {
+		ancestorIDs[*parentTransactionInPool.TransactionID()] = struct{}{}
+		for ancestorID := range parentTransactionInPool.AncestorTransactionsInPool() {
+			ancestorIDs[ancestorID] = struct{}{}
+		}
+	}
+
+	transactionFeeRate := feeRate(transaction)
+	for transactionCount > tp.mempool.config.MaximumTransactionCount ||
+		totalMass > tp.mempool.config.MaximumTransactionsMass {
+
+		candidate, _, candidateScore := tp.evictionCandidate(excludedTransactions)
+		if candidate == nil || candidateScore >= transactionFeeRate {
+			return true
+		}
+		if _, ok := ancestorIDs[*candidate.TransactionID()]; ok {
+			return true
+		}
+		exclude(candidate)
+		for _, redeemer := range tp.getRedeemers(candidate) {
+			exclude(redeemer)
+		}
+	}
+	return false
 }
 
 func (tp *transactionsPool) getTransaction(transactionID *externalapi.DomainTransactionID, clone bool) (*externalapi.DomainTransaction, bool) {
@@ -247,6 +404,23 @@ func (tp *transactionsPool) getAllTransactions() []*externalapi.DomainTransactio
 	return allTransactions
 }
 
+func (tp *transactionsPool) transactionFeeRates() []*miningmanagermodel.TransactionFeeRate {
+	transactionFeeRates := make([]*miningmanagermodel.TransactionFeeRate, len(tp.allTransactions))
+	// tp.transactionsOrderedByFeeRate is ordered from the lowest fee rate to the highest
+	for i := range transactionFeeRates {
+		transaction := tp.transactionsOrderedByFeeRate.GetByIndex(len(transactionFeeRates) - 1 - i).Transaction()
+		transactionFeeRates[i] = &miningmanagermodel.TransactionFeeRate{
+			FeeRate: float64(transaction.Fee) / float64(transaction.Mass),
+			Mass:    transaction.Mass,
+		}
+	}
+	return transactionFeeRates
+}
+
 func (tp *transactionsPool) transactionCount() int {
 	return len(tp.allTransactions)
 }
+
+func (tp *transactionsPool) transactionMass() uint64 {
+	return tp.totalMass
+}
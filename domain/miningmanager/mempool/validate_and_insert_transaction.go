@@ -9,7 +9,8 @@ import (
 )
 
 func (mp *mempool) validateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool,
-	allowOrphan bool) (acceptedTransactions []*externalapi.DomainTransaction, err error) {
+	allowOrphan bool, allowReplacement bool) (acceptedTransactions []*externalapi.DomainTransaction,
+	replacedTransactions []*externalapi.DomainTransaction, err error) {
 
 	onEnd := logger.LogAndMeasureExecutionTime(log,
 		fmt.Sprintf("validateAndInsertTransaction %s", consensushashing.TransactionID(transaction)))
@@ -18,47 +19,76 @@ func (mp *mempool) validateAndInsertTransaction(transaction *externalapi.DomainT
 	// Populate mass in the beginning, it will be used in multiple places throughout the validation and insertion.
 	mp.consensusReference.Consensus().PopulateMass(transaction)
 
-	err = mp.validateTransactionPreUTXOEntry(transaction)
+	err = mp.validateTransactionPreUTXOEntry(transaction, allowReplacement)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	parentsInPool, missingOutpoints, err := mp.fillInputsAndGetMissingParents(transaction)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if len(missingOutpoints) > 0 {
 		if !allowOrphan {
 			str := fmt.Sprintf("Transaction %s is an orphan, where allowOrphan = false",
 				consensushashing.TransactionID(transaction))
-			return nil, transactionRuleError(RejectBadOrphan, str)
+			return nil, nil, transactionRuleError(RejectBadOrphan, str)
 		}
 
-		return nil, mp.orphansPool.maybeAddOrphan(transaction, isHighPriority)
+		return nil, nil, mp.orphansPool.maybeAddOrphan(transaction, isHighPriority)
 	}
 
 	err = mp.validateTransactionInContext(transaction)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// High-priority transactions are never evicted, so there's no point in
+	// requiring them to pay more because the transaction pool is full
+	if !isHighPriority {
+		err = mp.checkMinimumFeeRate(transaction)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if allowReplacement {
+		replacedTransactions, err = mp.replaceConflictingTransactions(transaction, parentsInPool, isHighPriority)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	mempoolTransaction, err := mp.transactionsPool.addTransaction(transaction, parentsInPool, isHighPriority)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	acceptedOrphans, err := mp.orphansPool.processOrphansAfterAcceptedTransaction(mempoolTransaction.Transaction())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	acceptedTransactions = append([]*externalapi.DomainTransaction{transaction.Clone()}, acceptedOrphans...) //these pointer leave the mempool, hence we clone.
-
-	err = mp.transactionsPool.limitTransactionCount()
+	err = mp.transactionsPool.limitTransactionsPoolSize()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// The transaction itself may have been evicted if it pays the lowest fee rate in the transaction pool
+	transactionID := consensushashing.TransactionID(transaction)
+	if _, ok := mp.transactionsPool.allTransactions[*transactionID]; !ok {
+		str := fmt.Sprintf("transaction %s was evicted right away since the mempool is full and it pays "+
+			"one of the lowest fee rates in it", transactionID)
+		return nil, replacedTransactions, transactionRuleError(RejectInsufficientFee, str)
+	}
+
+	acceptedTransactions = []*externalapi.DomainTransaction{transaction.Clone()} //these pointer leave the mempool, hence we clone.
+	for _, acceptedOrphan := range acceptedOrphans {
+		if _, ok := mp.transactionsPool.allTransactions[*consensushashing.TransactionID(acceptedOrphan)]; ok {
+			acceptedTransactions = append(acceptedTransactions, acceptedOrphan)
+		}
 	}
 
-	return acceptedTransactions, nil
+	return acceptedTransactions, replacedTransactions, nil
 }
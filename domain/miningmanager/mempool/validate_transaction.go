@@ -8,12 +8,19 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
 )
 
-func (mp *mempool) validateTransactionPreUTXOEntry(transaction *externalapi.DomainTransaction) error {
+func (mp *mempool) validateTransactionPreUTXOEntry(transaction *externalapi.DomainTransaction,
+	allowReplacement bool) error {
+
 	err := mp.validateTransactionInIsolation(transaction)
 	if err != nil {
 		return err
 	}
 
+	// Double spends are resolved later on, according to the replace-by-fee rules,
+	// if the transaction is allowed to replace the transactions it conflicts with
+	if allowReplacement {
+		return nil
+	}
 	if err := mp.mempoolUTXOSet.checkDoubleSpends(transaction); err != nil {
 		return err
 	}
@@ -1,12 +1,15 @@
 package miningmanager
 
 import (
+	"os"
 	"sync"
 	"time"
 
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensusreference"
+	"github.com/kaspanet/kaspad/domain/miningmanager/feeestimator"
 	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+	"github.com/pkg/errors"
 )
 
 // MiningManager creates block templates for mining as well as maintaining
@@ -29,16 +32,24 @@ type MiningManager interface {
 		transactionPoolTransactions []*externalapi.DomainTransaction,
 		orphanPoolTransactions []*externalapi.DomainTransaction)
 	TransactionCount(includeTransactionPool bool, includeOrphanPool bool) int
+	TransactionMass(includeTransactionPool bool, includeOrphanPool bool) uint64
 	HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateAndInsertTransactionReplacement(transaction *externalapi.DomainTransaction, isHighPriority bool) (
+		acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error)
 	RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error)
+	GetFeeEstimate() *feeestimator.FeeEstimate
+	MempoolMinimumFeeRate() float64
+	SaveMempool(path string) (savedCount int, err error)
+	LoadMempool(path string) (loadedTransactions []*externalapi.DomainTransaction, err error)
 }
 
 type miningManager struct {
 	consensusReference   consensusreference.ConsensusReference
 	mempool              miningmanagermodel.Mempool
 	blockTemplateBuilder miningmanagermodel.BlockTemplateBuilder
+	feeEstimator         *feeestimator.FeeEstimator
 	cachedBlockTemplate  *externalapi.DomainBlockTemplate
 	cachingTime          time.Time
 	cacheLock            *sync.Mutex
@@ -73,6 +84,7 @@ func (mm *miningManager) GetBlockTemplate(coinbaseData *externalapi.DomainCoinba
 	}
 	// Cache the built template
 	mm.setImmutableCachedTemplate(blockTemplate)
+	mm.feeEstimator.AddBlockTemplate(blockTemplate.Block)
 	return blockTemplate.Block, blockTemplate.IsNearlySynced, nil
 }
 
@@ -117,6 +129,17 @@ func (mm *miningManager) ValidateAndInsertTransaction(transaction *externalapi.D
 	return mm.mempool.ValidateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
 }
 
+// ValidateAndInsertTransactionReplacement validates the given transaction, and
+// adds it to the set of known transactions that have not yet been added to any
+// block, replacing the transactions it double spends according to the
+// replace-by-fee rules
+func (mm *miningManager) ValidateAndInsertTransactionReplacement(transaction *externalapi.DomainTransaction,
+	isHighPriority bool) (acceptedTransactions []*externalapi.DomainTransaction,
+	replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	return mm.mempool.ValidateAndInsertTransactionReplacement(transaction, isHighPriority)
+}
+
 func (mm *miningManager) GetTransaction(
 	transactionID *externalapi.DomainTransactionID,
 	includeTransactionPool bool,
@@ -149,8 +172,65 @@ func (mm *miningManager) TransactionCount(includeTransactionPool bool, includeOr
 	return mm.mempool.TransactionCount(includeTransactionPool, includeOrphanPool)
 }
 
+func (mm *miningManager) TransactionMass(includeTransactionPool bool, includeOrphanPool bool) uint64 {
+	return mm.mempool.TransactionMass(includeTransactionPool, includeOrphanPool)
+}
+
 func (mm *miningManager) RevalidateHighPriorityTransactions() (
 	validTransactions []*externalapi.DomainTransaction, err error) {
 
 	return mm.mempool.RevalidateHighPriorityTransactions()
 }
+
+// GetFeeEstimate returns the fee rates a transaction should pay in order to be included
+// in a block within varying amounts of time
+func (mm *miningManager) GetFeeEstimate() *feeestimator.FeeEstimate {
+	return mm.feeEstimator.Estimate(mm.mempool.TransactionFeeRates(), mm.mempool.MinimumFeeRate())
+}
+
+// MempoolMinimumFeeRate returns the lowest fee rate, in sompi per gram, a transaction
+// must currently pay in order to be accepted into the mempool
+func (mm *miningManager) MempoolMinimumFeeRate() float64 {
+	return mm.mempool.MinimumFeeRate()
+}
+
+// SaveMempool writes all the transactions of the mempool, including orphans, into
+// the file at the given path, replacing it if it already exists
+func (mm *miningManager) SaveMempool(path string) (savedCount int, err error) {
+	// Write into a temporary file first, so that a crash midway
+	// doesn't leave behind a truncated file in place of a good one
+	temporaryPath := path + ".tmp"
+	file, err := os.Create(temporaryPath)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	savedCount, err = mm.mempool.Save(file)
+	if err != nil {
+		file.Close()
+		os.Remove(temporaryPath)
+		return 0, err
+	}
+	err = file.Close()
+	if err != nil {
+		os.Remove(temporaryPath)
+		return 0, errors.WithStack(err)
+	}
+	err = os.Rename(temporaryPath, path)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return savedCount, nil
+}
+
+// LoadMempool loads the transactions saved by SaveMempool into the mempool. Every
+// transaction is validated again, and transactions that are no longer valid or that
+// would have already expired had they remained in the mempool are skipped
+func (mm *miningManager) LoadMempool(path string) (loadedTransactions []*externalapi.DomainTransaction, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	return mm.mempool.Load(file)
+}
@@ -1,6 +1,10 @@
 package miningmanager_test
 
 import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
 	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet"
 	"github.com/kaspanet/kaspad/domain/consensusreference"
 	"github.com/kaspanet/kaspad/domain/miningmanager/model"
@@ -41,7 +45,7 @@ func TestValidateAndInsertTransaction(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		transactionsToInsert := make([]*externalapi.DomainTransaction, 10)
 		for i := range transactionsToInsert {
 			transactionsToInsert[i] = createTransactionWithUTXOEntry(t, i, 0)
@@ -92,7 +96,7 @@ func TestImmatureSpend(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		tx := createTransactionWithUTXOEntry(t, 0, consensusConfig.GenesisBlock.Header.DAAScore())
 		_, err = miningManager.ValidateAndInsertTransaction(tx, false, false)
 		txRuleError := &mempool.TxRuleError{}
@@ -122,7 +126,7 @@ func TestInsertDoubleTransactionsToMempool(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		transaction := createTransactionWithUTXOEntry(t, 0, 0)
 		_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
 		if err != nil {
@@ -151,7 +155,7 @@ func TestDoubleSpendInMempool(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		transaction, err := createChildAndParentTxsAndAddParentToConsensus(tc)
 		if err != nil {
 			t.Fatalf("Error creating transaction: %+v", err)
@@ -172,6 +176,106 @@ func TestDoubleSpendInMempool(t *testing.T) {
 	})
 }
 
+// TestReplaceByFee verifies that a transaction double spending a mempool transaction replaces it,
+// alongside its redeemers, only when inserted as a replacement which satisfies the replace-by-fee rules.
+func TestReplaceByFee(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestReplaceByFee")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
+		transaction, err := createChildAndParentTxsAndAddParentToConsensus(tc)
+		if err != nil {
+			t.Fatalf("Error creating transaction: %+v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		redeemer, err := testutils.CreateTransaction(transaction, 1000)
+		if err != nil {
+			t.Fatalf("Error creating redeemer transaction: %+v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(redeemer, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+
+		createReplacement := func(extraFee uint64) *externalapi.DomainTransaction {
+			replacement := transaction.Clone()
+			replacement.ID = nil
+			replacement.Outputs[0].Value -= extraFee
+			return replacement
+		}
+
+		// A regular insertion should never replace anything
+		replacement := createReplacement(10000)
+		_, err = miningManager.ValidateAndInsertTransaction(replacement, false, true)
+		if err == nil || !strings.Contains(err.Error(), "already spent by transaction") {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+
+		// A replacement with a lower fee rate should be rejected
+		replacement = transaction.Clone()
+		replacement.ID = nil
+		replacement.Outputs[0].Value++
+		_, _, err = miningManager.ValidateAndInsertTransactionReplacement(replacement, false)
+		if err == nil || !strings.Contains(err.Error(), "which is not higher than the fee rate") {
+			t.Fatalf("ValidateAndInsertTransactionReplacement: %v", err)
+		}
+
+		// A replacement with a higher fee rate that doesn't pay for the evicted transactions should be rejected
+		replacement = createReplacement(1)
+		_, _, err = miningManager.ValidateAndInsertTransactionReplacement(replacement, false)
+		if err == nil || !strings.Contains(err.Error(), "for replacing 2 transactions") {
+			t.Fatalf("ValidateAndInsertTransactionReplacement: %v", err)
+		}
+
+		replacement = createReplacement(10000)
+		acceptedTransactions, replacedTransactions, err :=
+			miningManager.ValidateAndInsertTransactionReplacement(replacement, false)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransactionReplacement: %v", err)
+		}
+		if len(acceptedTransactions) != 1 || !acceptedTransactions[0].Equal(replacement) {
+			t.Fatalf("Expected the replacement to be the only accepted transaction, got: %v",
+				domainBlocksToBlockIds(acceptedTransactions))
+		}
+		if len(replacedTransactions) != 2 || !contains(transaction, replacedTransactions) ||
+			!contains(redeemer, replacedTransactions) {
+
+			t.Fatalf("Expected the transaction and its redeemer to be replaced, got: %v",
+				domainBlocksToBlockIds(replacedTransactions))
+		}
+		for _, replacedTransaction := range replacedTransactions {
+			_, _, found := miningManager.GetTransaction(consensushashing.TransactionID(replacedTransaction), true, true)
+			if found {
+				t.Fatalf("Replaced transaction %s is still in the mempool", consensushashing.TransactionID(replacedTransaction))
+			}
+		}
+		_, _, found := miningManager.GetTransaction(consensushashing.TransactionID(replacement), true, false)
+		if !found {
+			t.Fatalf("Replacement transaction is missing from the mempool")
+		}
+
+		// A replacement that doesn't double spend anything should be rejected
+		nonConflictingTransaction := createTransactionWithUTXOEntry(t, 0, 0)
+		_, _, err = miningManager.ValidateAndInsertTransactionReplacement(nonConflictingTransaction, false)
+		if err == nil || !strings.Contains(err.Error(), "does not double spend any transaction") {
+			t.Fatalf("ValidateAndInsertTransactionReplacement: %v", err)
+		}
+	})
+}
+
 // TestHandleNewBlockTransactions verifies that all the transactions in the block were successfully removed from the mempool.
 func TestHandleNewBlockTransactions(t *testing.T) {
 	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
@@ -187,7 +291,7 @@ func TestHandleNewBlockTransactions(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		transactionsToInsert := make([]*externalapi.DomainTransaction, 10)
 		for i := range transactionsToInsert {
 			transaction := createTransactionWithUTXOEntry(t, i, 0)
@@ -257,7 +361,7 @@ func TestDoubleSpendWithBlock(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		transactionInTheMempool := createTransactionWithUTXOEntry(t, 0, 0)
 		_, err = miningManager.ValidateAndInsertTransaction(transactionInTheMempool, false, true)
 		if err != nil {
@@ -293,7 +397,7 @@ func TestOrphanTransactions(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 		// Before each parent transaction, We will add two blocks by consensus in order to fund the parent transactions.
 		parentTransactions, childTransactions, err := createArraysOfParentAndChildrenTransactions(tc)
 		if err != nil {
@@ -379,6 +483,117 @@ func TestOrphanTransactions(t *testing.T) {
 	})
 }
 
+// TestMempoolChangedEvents verifies that the mempool reports the transactions added to and removed from it,
+// along with the reasons for their removal, in the order those changes happened in.
+func TestMempoolChangedEvents(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestMempoolChangedEvents")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		mempoolEventsChan := make(chan *model.MempoolChangeSet, 100)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params,
+			mempool.DefaultConfig(&consensusConfig.Params), mempoolEventsChan)
+
+		type expectedChange struct {
+			transaction   *externalapi.DomainTransaction
+			isOrphan      bool
+			isRemoved     bool
+			removalReason model.TransactionRemovalReason
+		}
+		checkChangeSet := func(expectedChanges []expectedChange) {
+			var changeSet *model.MempoolChangeSet
+			select {
+			case changeSet = <-mempoolEventsChan:
+			default:
+				t.Fatalf("Expected a mempool change set but got none")
+			}
+			if len(changeSet.Changes) != len(expectedChanges) {
+				t.Fatalf("Expected %d changes but got %d", len(expectedChanges), len(changeSet.Changes))
+			}
+			for i, change := range changeSet.Changes {
+				expected := expectedChanges[i]
+				if !consensushashing.TransactionID(change.Transaction).Equal(consensushashing.TransactionID(expected.transaction)) ||
+					change.IsOrphan != expected.isOrphan || change.IsRemoved != expected.isRemoved ||
+					change.RemovalReason != expected.removalReason {
+
+					t.Fatalf("Unexpected change %d: got {%s, isOrphan: %t, isRemoved: %t, reason: %s}, "+
+						"expected {%s, isOrphan: %t, isRemoved: %t, reason: %s}", i,
+						consensushashing.TransactionID(change.Transaction), change.IsOrphan, change.IsRemoved, change.RemovalReason,
+						consensushashing.TransactionID(expected.transaction), expected.isOrphan, expected.isRemoved, expected.removalReason)
+				}
+			}
+		}
+
+		parentTransactions, childTransactions, err := createArraysOfParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error in createArraysOfParentAndChildrenTransactions: %v", err)
+		}
+		for _, orphanTransaction := range childTransactions {
+			_, err = miningManager.ValidateAndInsertTransaction(orphanTransaction, false, true)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %v", err)
+			}
+			checkChangeSet([]expectedChange{{transaction: orphanTransaction, isOrphan: true}})
+		}
+
+		tips, err := tc.Tips()
+		if err != nil {
+			t.Fatalf("Tips: %v.", err)
+		}
+		blockParentsTransactionsHash, _, err := tc.AddBlock(tips, nil, parentTransactions)
+		if err != nil {
+			t.Fatalf("AddBlock: %v", err)
+		}
+		_, _, err = tc.AddBlock([]*externalapi.DomainHash{blockParentsTransactionsHash}, nil, nil)
+		if err != nil {
+			t.Fatalf("AddBlock: %v", err)
+		}
+		blockParentsTransactions, _, err := tc.GetBlock(blockParentsTransactionsHash)
+		if err != nil {
+			t.Fatalf("GetBlock: %v", err)
+		}
+		_, err = miningManager.HandleNewBlockTransactions(blockParentsTransactions.Transactions)
+		if err != nil {
+			t.Fatalf("HandleNewBlockTransactions: %+v", err)
+		}
+		expectedUnorphanChanges := make([]expectedChange, 0, 2*len(childTransactions))
+		for _, childTransaction := range childTransactions {
+			expectedUnorphanChanges = append(expectedUnorphanChanges,
+				expectedChange{transaction: childTransaction, isOrphan: true, isRemoved: true,
+					removalReason: model.TransactionRemovalReasonOrphanResolved},
+				expectedChange{transaction: childTransaction})
+		}
+		checkChangeSet(expectedUnorphanChanges)
+
+		blockWithChildTransactions := append([]*externalapi.DomainTransaction{nil}, childTransactions...)
+		_, err = miningManager.HandleNewBlockTransactions(blockWithChildTransactions)
+		if err != nil {
+			t.Fatalf("HandleNewBlockTransactions: %+v", err)
+		}
+		expectedInclusionChanges := make([]expectedChange, len(childTransactions))
+		for i, childTransaction := range childTransactions {
+			expectedInclusionChanges[i] = expectedChange{transaction: childTransaction, isRemoved: true,
+				removalReason: model.TransactionRemovalReasonIncludedInBlock}
+		}
+		checkChangeSet(expectedInclusionChanges)
+
+		select {
+		case changeSet := <-mempoolEventsChan:
+			t.Fatalf("Unexpected mempool change set with %d changes", len(changeSet.Changes))
+		default:
+		}
+	})
+}
+
 func TestHighPriorityTransactions(t *testing.T) {
 	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
 		consensusConfig.BlockCoinbaseMaturity = 0
@@ -396,7 +611,7 @@ func TestHighPriorityTransactions(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig, nil)
 
 		// Create 3 pairs of transaction parent-and-child pairs: 1 low priority and 2 high priority
 		lowPriorityParentTransaction, lowPriorityChildTransaction, err := createParentAndChildrenTransactions(tc)
@@ -489,7 +704,7 @@ func TestRevalidateHighPriorityTransactions(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig, nil)
 
 		// Create two valid transactions that double-spend each other (childTransaction1, childTransaction2)
 		parentTransaction, childTransaction1, err := createParentAndChildrenTransactions(tc)
@@ -577,6 +792,468 @@ func TestRevalidateHighPriorityTransactions(t *testing.T) {
 	})
 }
 
+// TestUnorphanedTransactionPriority verifies that an unorphaned transaction keeps the priority
+// it was submitted with, so that a full transaction pool evicts it only if it isn't high-priority.
+func TestUnorphanedTransactionPriority(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestUnorphanedTransactionPriority")
+		if err != nil {
+			t.Fatalf("Failed setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+		mempoolConfig.MaximumTransactionCount = 1
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningmanager.NewFactory().NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig, nil)
+
+		lowPriorityParentTransaction, lowPriorityChildTransaction, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error creating low-priority transaction pair: %+v", err)
+		}
+		highPriorityParentTransaction, highPriorityChildTransaction, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error creating high-priority transaction pair: %+v", err)
+		}
+
+		// Both children are orphans, and only the second one is high-priority. Their parents are both
+		// high-priority, so only the children can be evicted from the full transaction pool.
+		_, err = miningManager.ValidateAndInsertTransaction(lowPriorityChildTransaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(highPriorityChildTransaction, true, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+		}
+		for _, parentTransaction := range []*externalapi.DomainTransaction{
+			lowPriorityParentTransaction, highPriorityParentTransaction} {
+
+			_, err = miningManager.ValidateAndInsertTransaction(parentTransaction, true, false)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+			}
+		}
+
+		mempoolTransactions, _ := miningManager.AllTransactions(true, false)
+		if len(mempoolTransactions) != 3 || contains(lowPriorityChildTransaction, mempoolTransactions) ||
+			!contains(highPriorityChildTransaction, mempoolTransactions) {
+
+			t.Fatalf("Expected only the low-priority child to be evicted from the mempool, but got %v",
+				consensushashing.TransactionIDs(mempoolTransactions))
+		}
+	})
+}
+
+// TestBlockCandidatePackages verifies that a ready transaction is prioritized by the fee
+// rate of the best package of its descendants, and that it's prioritized by its own
+// fee rate once its descendants are gone
+func TestBlockCandidatePackages(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestBlockCandidatePackages")
+		if err != nil {
+			t.Fatalf("Failed setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		mempoolInstance := mempool.New(mempool.DefaultConfig(&consensusConfig.Params), consensusReference, nil)
+
+		parentTransaction, _, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error creating parentTransaction: %+v", err)
+		}
+		unrelatedTransaction, _, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error creating unrelatedTransaction: %+v", err)
+		}
+		// A high-fee child pays for its low-fee parent, while a low-fee grandchild
+		// only lowers the fee rate of its package
+		childTransaction, err := testutils.CreateTransaction(parentTransaction, 100_000)
+		if err != nil {
+			t.Fatalf("Error creating childTransaction: %+v", err)
+		}
+		grandchildTransaction, err := testutils.CreateTransaction(childTransaction, 1000)
+		if err != nil {
+			t.Fatalf("Error creating grandchildTransaction: %+v", err)
+		}
+
+		for _, transaction := range []*externalapi.DomainTransaction{
+			parentTransaction, unrelatedTransaction, childTransaction, grandchildTransaction} {
+
+			_, err := mempoolInstance.ValidateAndInsertTransaction(transaction, false, false)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+			}
+		}
+
+		expectPackage := func(candidates []*model.BlockCandidateTransaction,
+			transaction *externalapi.DomainTransaction, packageTransactions ...*externalapi.DomainTransaction) {
+
+			expectedFee, expectedMass := uint64(0), uint64(0)
+			for _, packageTransaction := range packageTransactions {
+				expectedFee += packageTransaction.Fee
+				expectedMass += packageTransaction.Mass
+			}
+			for _, candidate := range candidates {
+				if !candidate.Transaction.Equal(transaction) {
+					continue
+				}
+				if candidate.PackageFee != expectedFee || candidate.PackageMass != expectedMass {
+					t.Fatalf("Expected transaction %s to have package fee %d and mass %d, but got %d and %d",
+						consensushashing.TransactionID(transaction), expectedFee, expectedMass,
+						candidate.PackageFee, candidate.PackageMass)
+				}
+				return
+			}
+			t.Fatalf("Transaction %s is not a block candidate", consensushashing.TransactionID(transaction))
+		}
+
+		candidates := mempoolInstance.BlockCandidateTransactions()
+		if len(candidates) != 2 {
+			t.Fatalf("Expected 2 block candidates, but got %d", len(candidates))
+		}
+		expectPackage(candidates, parentTransaction, parentTransaction, childTransaction)
+		expectPackage(candidates, unrelatedTransaction, unrelatedTransaction)
+
+		// Once the parent is mined, the child is prioritized by its own fee rate
+		_, err = mempoolInstance.HandleNewBlockTransactions(
+			[]*externalapi.DomainTransaction{&externalapi.DomainTransaction{}, parentTransaction})
+		if err != nil {
+			t.Fatalf("HandleNewBlockTransactions: %+v", err)
+		}
+		candidates = mempoolInstance.BlockCandidateTransactions()
+		if len(candidates) != 2 {
+			t.Fatalf("Expected 2 block candidates, but got %d", len(candidates))
+		}
+		expectPackage(candidates, childTransaction, childTransaction)
+		expectPackage(candidates, unrelatedTransaction, unrelatedTransaction)
+	})
+}
+
+// TestMempoolEviction verifies that a mempool over its maximum mass evicts the package with the lowest
+// fee rate as a whole, and that the minimum fee rate rises above the fee rate of the evicted package.
+func TestMempoolEviction(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestMempoolEviction")
+		if err != nil {
+			t.Fatalf("Failed setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		lowFeeParentTransaction, _, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error creating lowFeeParentTransaction: %+v", err)
+		}
+		highFeeParentTransaction, _, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error creating highFeeParentTransaction: %+v", err)
+		}
+		unrelatedTransaction, _, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error creating unrelatedTransaction: %+v", err)
+		}
+		// The low-fee child pays more than its parent, but their package still pays
+		// less than the package of the high-fee child
+		lowFeeChildTransaction, err := testutils.CreateTransaction(lowFeeParentTransaction, 1500)
+		if err != nil {
+			t.Fatalf("Error creating lowFeeChildTransaction: %+v", err)
+		}
+		highFeeChildTransaction, err := testutils.CreateTransaction(highFeeParentTransaction, 100_000)
+		if err != nil {
+			t.Fatalf("Error creating highFeeChildTransaction: %+v", err)
+		}
+
+		tc.PopulateMass(lowFeeParentTransaction)
+		transactionMass := lowFeeParentTransaction.Mass
+
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+		// Room for 3 transactions, but not for 4
+		mempoolConfig.MaximumTransactionsMass = 3*transactionMass + transactionMass/2
+		miningManager := miningmanager.NewFactory().NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig, nil)
+
+		minimumRelayFeeRate := float64(mempoolConfig.MinimumRelayTransactionFee) / 1000
+		if miningManager.MempoolMinimumFeeRate() != minimumRelayFeeRate {
+			t.Fatalf("Expected the minimum fee rate of an empty mempool to be %f, but got %f",
+				minimumRelayFeeRate, miningManager.MempoolMinimumFeeRate())
+		}
+
+		for _, transaction := range []*externalapi.DomainTransaction{
+			lowFeeParentTransaction, lowFeeChildTransaction, highFeeParentTransaction, highFeeChildTransaction} {
+
+			_, err := miningManager.ValidateAndInsertTransaction(transaction, false, false)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+			}
+		}
+
+		// The low-fee package was evicted as a whole, even though the high-fee parent pays less than the low-fee child
+		mempoolTransactions, _ := miningManager.AllTransactions(true, false)
+		if len(mempoolTransactions) != 2 ||
+			!contains(highFeeParentTransaction, mempoolTransactions) || !contains(highFeeChildTransaction, mempoolTransactions) {
+
+			t.Fatalf("Expected only the high-fee package to remain in the mempool, but got %v",
+				consensushashing.TransactionIDs(mempoolTransactions))
+		}
+		if miningManager.TransactionMass(true, false) > mempoolConfig.MaximumTransactionsMass {
+			t.Fatalf("The mempool mass %d exceeds its maximum of %d",
+				miningManager.TransactionMass(true, false), mempoolConfig.MaximumTransactionsMass)
+		}
+
+		// Transactions paying no more than the evicted package are now rejected
+		lowFeePackageFeeRate := float64(lowFeeParentTransaction.Fee+lowFeeChildTransaction.Fee) /
+			float64(lowFeeParentTransaction.Mass+lowFeeChildTransaction.Mass)
+		if miningManager.MempoolMinimumFeeRate() <= lowFeePackageFeeRate {
+			t.Fatalf("Expected the minimum fee rate to rise above %f, but got %f",
+				lowFeePackageFeeRate, miningManager.MempoolMinimumFeeRate())
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(unrelatedTransaction, false, false)
+		txRuleError := &mempool.TxRuleError{}
+		if !errors.As(err, txRuleError) || txRuleError.RejectCode != mempool.RejectInsufficientFee {
+			t.Fatalf("Expected a RejectInsufficientFee error, but got: %+v", err)
+		}
+
+		// High-priority transactions don't have to pay the raised minimum fee rate
+		_, err = miningManager.ValidateAndInsertTransaction(unrelatedTransaction, true, false)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+		}
+	})
+}
+
+// TestReplaceByFeeInFullMempool verifies that a replacement that would be evicted right away because
+// the mempool is full is rejected without removing the transactions it double spends
+func TestReplaceByFeeInFullMempool(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestReplaceByFeeInFullMempool")
+		if err != nil {
+			t.Fatalf("Failed setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		originalTransaction, _, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error creating originalTransaction: %+v", err)
+		}
+		highFeeTransactions := make([]*externalapi.DomainTransaction, 2)
+		for i := range highFeeTransactions {
+			highFeeTransactions[i], _, err = createParentAndChildrenTransactions(tc)
+			if err != nil {
+				t.Fatalf("Error creating highFeeTransaction: %+v", err)
+			}
+			highFeeTransactions[i].ID = nil
+			highFeeTransactions[i].Outputs[0].Value -= 100_000
+		}
+
+		tc.PopulateMass(originalTransaction)
+		transactionMass := originalTransaction.Mass
+
+		// The replacement pays more than the original transaction and its relay, but it's
+		// heavy enough to push the mempool over its limit, and pays the lowest fee rate in it
+		replacement := originalTransaction.Clone()
+		replacement.ID = nil
+		replacement.Outputs[0].Value -= 10_000
+		for replacement.Mass <= 2*transactionMass {
+			replacement.Outputs[0].Value -= 1_000_000
+			replacement.Outputs = append(replacement.Outputs, &externalapi.DomainTransactionOutput{
+				Value:           1_000_000,
+				ScriptPublicKey: replacement.Outputs[0].ScriptPublicKey,
+			})
+			replacement.Mass = 0
+			tc.PopulateMass(replacement)
+		}
+
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+		// Room for 3 transactions, but not for the replacement instead of the original transaction
+		mempoolConfig.MaximumTransactionsMass = 3*transactionMass + transactionMass/2
+		miningManager := miningmanager.NewFactory().NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig, nil)
+
+		for _, transaction := range append([]*externalapi.DomainTransaction{originalTransaction}, highFeeTransactions...) {
+			_, err := miningManager.ValidateAndInsertTransaction(transaction, false, false)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+			}
+		}
+
+		_, replacedTransactions, err := miningManager.ValidateAndInsertTransactionReplacement(replacement, false)
+		txRuleError := &mempool.TxRuleError{}
+		if !errors.As(err, txRuleError) || txRuleError.RejectCode != mempool.RejectInsufficientFee ||
+			!strings.Contains(err.Error(), "would be evicted right away") {
+
+			t.Fatalf("Expected a RejectInsufficientFee error, but got: %+v", err)
+		}
+		if len(replacedTransactions) != 0 {
+			t.Fatalf("Expected no replaced transactions, but got %v", domainBlocksToBlockIds(replacedTransactions))
+		}
+
+		mempoolTransactions, _ := miningManager.AllTransactions(true, false)
+		if len(mempoolTransactions) != 3 || !contains(originalTransaction, mempoolTransactions) {
+			t.Fatalf("Expected the original transaction to remain in the mempool, but got %v",
+				consensushashing.TransactionIDs(mempoolTransactions))
+		}
+		_, _, found := miningManager.GetTransaction(consensushashing.TransactionID(replacement), true, false)
+		if found {
+			t.Fatalf("Rejected replacement transaction is in the mempool")
+		}
+	})
+}
+
+// TestSaveAndLoadMempool verifies that a saved mempool is restored into a new mempool along with its orphans,
+// that expired transactions aren't loaded, and that a saved mempool in an unknown version is rejected.
+func TestSaveAndLoadMempool(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestSaveAndLoadMempool")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
+
+		parentTransaction, _, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error creating parentTransaction: %+v", err)
+		}
+		highPriorityTransaction, _, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error creating highPriorityTransaction: %+v", err)
+		}
+		childTransaction, err := testutils.CreateTransaction(parentTransaction, 1000)
+		if err != nil {
+			t.Fatalf("Error creating childTransaction: %+v", err)
+		}
+		_, orphanTransaction, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error creating orphanTransaction: %+v", err)
+		}
+
+		// The child is inserted first, as an orphan, so that it's saved before
+		// its parent unless the saved transactions are ordered
+		for _, transaction := range []*externalapi.DomainTransaction{childTransaction, parentTransaction, orphanTransaction} {
+			_, err := miningManager.ValidateAndInsertTransaction(transaction, false, true)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+			}
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(highPriorityTransaction, true, false)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+		}
+
+		mempoolFile := filepath.Join(t.TempDir(), "mempool.dat")
+		savedCount, err := miningManager.SaveMempool(mempoolFile)
+		if err != nil {
+			t.Fatalf("SaveMempool: %+v", err)
+		}
+		if savedCount != 4 {
+			t.Fatalf("Expected 4 transactions to be saved, but got %d", savedCount)
+		}
+
+		expectMempool := func(miningManager miningmanager.MiningManager,
+			expectedTransactions []*externalapi.DomainTransaction, expectedOrphans []*externalapi.DomainTransaction) {
+
+			transactions, orphans := miningManager.AllTransactions(true, true)
+			if len(transactions) != len(expectedTransactions) || len(orphans) != len(expectedOrphans) {
+				t.Fatalf("Expected %d transactions and %d orphans in the mempool, but got %d and %d",
+					len(expectedTransactions), len(expectedOrphans), len(transactions), len(orphans))
+			}
+			for _, transaction := range expectedTransactions {
+				if !contains(transaction, transactions) {
+					t.Fatalf("Transaction %s is missing from the mempool", consensushashing.TransactionID(transaction))
+				}
+			}
+			for _, orphan := range expectedOrphans {
+				if !contains(orphan, orphans) {
+					t.Fatalf("Orphan %s is missing from the mempool", consensushashing.TransactionID(orphan))
+				}
+			}
+		}
+
+		loadingMiningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params,
+			mempool.DefaultConfig(&consensusConfig.Params), nil)
+		loadedTransactions, err := loadingMiningManager.LoadMempool(mempoolFile)
+		if err != nil {
+			t.Fatalf("LoadMempool: %+v", err)
+		}
+		if len(loadedTransactions) != 3 {
+			t.Fatalf("Expected 3 transactions to be loaded, but got %d", len(loadedTransactions))
+		}
+		expectMempool(loadingMiningManager,
+			[]*externalapi.DomainTransaction{parentTransaction, childTransaction, highPriorityTransaction},
+			[]*externalapi.DomainTransaction{orphanTransaction})
+
+		// Loading the same transactions again shouldn't change anything
+		_, err = loadingMiningManager.LoadMempool(mempoolFile)
+		if err != nil {
+			t.Fatalf("LoadMempool: %+v", err)
+		}
+		expectMempool(loadingMiningManager,
+			[]*externalapi.DomainTransaction{parentTransaction, childTransaction, highPriorityTransaction},
+			[]*externalapi.DomainTransaction{orphanTransaction})
+
+		// Once the virtual DAA score advances past the expiry intervals,
+		// only the high priority transaction should be loaded
+		tips, err := tc.Tips()
+		if err != nil {
+			t.Fatalf("Tips: %+v", err)
+		}
+		_, _, err = tc.AddBlock(tips, nil, nil)
+		if err != nil {
+			t.Fatalf("AddBlock: %+v", err)
+		}
+		expiringMempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+		expiringMempoolConfig.TransactionExpireIntervalDAAScore = 0
+		expiringMempoolConfig.OrphanExpireIntervalDAAScore = 0
+		expiringMiningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params,
+			expiringMempoolConfig, nil)
+		_, err = expiringMiningManager.LoadMempool(mempoolFile)
+		if err != nil {
+			t.Fatalf("LoadMempool: %+v", err)
+		}
+		expectMempool(expiringMiningManager, []*externalapi.DomainTransaction{highPriorityTransaction}, nil)
+
+		// A file in an unknown version should be rejected
+		mempoolFileBytes, err := os.ReadFile(mempoolFile)
+		if err != nil {
+			t.Fatalf("ReadFile: %+v", err)
+		}
+		binary.LittleEndian.PutUint32(mempoolFileBytes[len("KASMEMPL"):], mempool.PersistenceVersion+1)
+		err = os.WriteFile(mempoolFile, mempoolFileBytes, 0600)
+		if err != nil {
+			t.Fatalf("WriteFile: %+v", err)
+		}
+		_, err = miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params,
+			mempool.DefaultConfig(&consensusConfig.Params), nil).LoadMempool(mempoolFile)
+		if err == nil || !strings.Contains(err.Error(), "unsupported saved mempool version") {
+			t.Fatalf("Expected an unsupported version error, but got: %v", err)
+		}
+	})
+}
+
 // TestModifyBlockTemplate verifies that modifying a block template changes coinbase data correctly.
 func TestModifyBlockTemplate(t *testing.T) {
 	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
@@ -592,7 +1269,7 @@ func TestModifyBlockTemplate(t *testing.T) {
 		tcAsConsensus := tc.(externalapi.Consensus)
 		tcAsConsensusPointer := &tcAsConsensus
 		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
-		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params), nil)
 
 		// Create some complex transactions. Logic taken from TestOrphanTransactions
 
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+)
+
+// BlockCandidateTransaction is a transaction that may be included in the next
+// block template, alongside the fee and mass it should be prioritized by.
+//
+// A block may not contain a transaction together with the transaction whose
+// outputs it spends, so a transaction whose parents are still in the mempool
+// is never a candidate itself. Instead, it raises the priority of its
+// ancestors that are candidates: PackageFee and PackageMass are the total fee
+// and mass of the package with the highest fee rate that the transaction is a
+// part of. A package is either the transaction alone, or one of its
+// descendants in the mempool along with all of that descendant's ancestors.
+// This lets the high fee of a child pay for its low-fee parents (CPFP)
+type BlockCandidateTransaction struct {
+	Transaction *externalapi.DomainTransaction
+	PackageFee  uint64
+	PackageMass uint64
+}
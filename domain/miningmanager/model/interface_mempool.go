@@ -1,6 +1,8 @@
 package model
 
 import (
+	"io"
+
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 )
 
@@ -8,9 +10,11 @@ import (
 // are intended to be mined into new blocks
 type Mempool interface {
 	HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
-	BlockCandidateTransactions() []*externalapi.DomainTransaction
+	BlockCandidateTransactions() []*BlockCandidateTransaction
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateAndInsertTransactionReplacement(transaction *externalapi.DomainTransaction, isHighPriority bool) (
+		acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error)
 	RemoveTransactions(txs []*externalapi.DomainTransaction, removeRedeemers bool) error
 	GetTransaction(
 		transactionID *externalapi.DomainTransactionID,
@@ -37,6 +41,13 @@ type Mempool interface {
 	TransactionCount(
 		includeTransactionPool bool,
 		includeOrphanPool bool) int
+	TransactionMass(
+		includeTransactionPool bool,
+		includeOrphanPool bool) uint64
 	RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error)
 	IsTransactionOutputDust(output *externalapi.DomainTransactionOutput) bool
+	TransactionFeeRates() []*TransactionFeeRate
+	MinimumFeeRate() float64
+	Save(w io.Writer) (savedCount int, err error)
+	Load(r io.Reader) (loadedTransactions []*externalapi.DomainTransaction, err error)
 }
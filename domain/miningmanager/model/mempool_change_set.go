@@ -0,0 +1,63 @@
+package model
+
+import "github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+
+// MempoolChangeSet is an event raised by the mempool whenever transactions were
+// added to or removed from it. The changes are ordered by the order they happened in
+type MempoolChangeSet struct {
+	Changes []*MempoolChange
+}
+
+// MempoolChange describes a single transaction that was either added to or removed
+// from the mempool
+type MempoolChange struct {
+	Transaction   *externalapi.DomainTransaction
+	IsOrphan      bool
+	IsRemoved     bool
+	RemovalReason TransactionRemovalReason
+}
+
+// TransactionRemovalReason describes why a transaction was removed from the mempool
+type TransactionRemovalReason byte
+
+// TransactionRemovalReason constants
+const (
+	// TransactionRemovalReasonNone is the removal reason of transactions that were added to the mempool
+	TransactionRemovalReasonNone TransactionRemovalReason = iota
+
+	// TransactionRemovalReasonIncludedInBlock means that the transaction was included in a block
+	TransactionRemovalReasonIncludedInBlock
+
+	// TransactionRemovalReasonExpired means that the transaction stayed in the mempool for too long
+	TransactionRemovalReasonExpired
+
+	// TransactionRemovalReasonDoubleSpent means that another transaction, either in a block or
+	// replacing it in the mempool, spends one of its inputs or the inputs of one of its ancestors
+	TransactionRemovalReasonDoubleSpent
+
+	// TransactionRemovalReasonEvicted means that the transaction was evicted to make room for
+	// transactions paying higher fees
+	TransactionRemovalReasonEvicted
+
+	// TransactionRemovalReasonOrphanResolved means that all the parents of an orphan transaction
+	// became available, so it was moved from the orphan pool into the transaction pool
+	TransactionRemovalReasonOrphanResolved
+
+	// TransactionRemovalReasonInvalid means that the transaction turned out to be invalid
+	// while revalidating it
+	TransactionRemovalReasonInvalid
+)
+
+var transactionRemovalReasonToString = map[TransactionRemovalReason]string{
+	TransactionRemovalReasonNone:            "None",
+	TransactionRemovalReasonIncludedInBlock: "IncludedInBlock",
+	TransactionRemovalReasonExpired:         "Expired",
+	TransactionRemovalReasonDoubleSpent:     "DoubleSpent",
+	TransactionRemovalReasonEvicted:         "Evicted",
+	TransactionRemovalReasonOrphanResolved:  "OrphanResolved",
+	TransactionRemovalReasonInvalid:         "Invalid",
+}
+
+func (reason TransactionRemovalReason) String() string {
+	return transactionRemovalReasonToString[reason]
+}
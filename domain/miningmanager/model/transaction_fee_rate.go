@@ -0,0 +1,8 @@
+package model
+
+// TransactionFeeRate is the fee rate of a transaction, in sompi per gram of
+// mass, alongside the mass of the transaction
+type TransactionFeeRate struct {
+	FeeRate float64
+	Mass    uint64
+}
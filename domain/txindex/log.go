@@ -0,0 +1,7 @@
+package txindex
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("TXIN")
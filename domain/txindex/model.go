@@ -0,0 +1,20 @@
+package txindex
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+)
+
+// TXAcceptanceData is the acceptance data of a single transaction
+// as recorded in the TX index
+type TXAcceptanceData struct {
+	// AcceptingBlockHash is the hash of the selected parent chain block
+	// that accepted the transaction
+	AcceptingBlockHash *externalapi.DomainHash
+
+	// IncludingBlockHash is the hash of the block within the accepting
+	// block's mergeset from which the transaction was accepted
+	IncludingBlockHash *externalapi.DomainHash
+}
+
+// TXContainingBlockHashes is a set of block hashes that contain a transaction
+type TXContainingBlockHashes map[externalapi.DomainHash]struct{}
@@ -0,0 +1,76 @@
+package txindex
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+func serializeTXAcceptanceData(txAcceptanceData *TXAcceptanceData) []byte {
+	serializedTXAcceptanceData := make([]byte, 2*externalapi.DomainHashSize)
+	copy(serializedTXAcceptanceData[:externalapi.DomainHashSize], txAcceptanceData.AcceptingBlockHash.ByteSlice())
+	copy(serializedTXAcceptanceData[externalapi.DomainHashSize:], txAcceptanceData.IncludingBlockHash.ByteSlice())
+	return serializedTXAcceptanceData
+}
+
+func deserializeTXAcceptanceData(serializedTXAcceptanceData []byte) (*TXAcceptanceData, error) {
+	if len(serializedTXAcceptanceData) != 2*externalapi.DomainHashSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected length %d while deserializing "+
+			"transaction acceptance data", len(serializedTXAcceptanceData))
+	}
+
+	acceptingBlockHash, err := externalapi.NewDomainHashFromByteSlice(
+		serializedTXAcceptanceData[:externalapi.DomainHashSize])
+	if err != nil {
+		return nil, err
+	}
+	includingBlockHash, err := externalapi.NewDomainHashFromByteSlice(
+		serializedTXAcceptanceData[externalapi.DomainHashSize:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &TXAcceptanceData{
+		AcceptingBlockHash: acceptingBlockHash,
+		IncludingBlockHash: includingBlockHash,
+	}, nil
+}
+
+const hashesLengthSize = 8
+
+func serializeHashes(hashes []*externalapi.DomainHash) []byte {
+	serializedHashes := make([]byte, hashesLengthSize+externalapi.DomainHashSize*len(hashes))
+	binary.LittleEndian.PutUint64(serializedHashes[:hashesLengthSize], uint64(len(hashes)))
+	for i, hash := range hashes {
+		start := hashesLengthSize + externalapi.DomainHashSize*i
+		end := start + externalapi.DomainHashSize
+		copy(serializedHashes[start:end], hash.ByteSlice())
+	}
+	return serializedHashes
+}
+
+func deserializeHashes(serializedHashes []byte) ([]*externalapi.DomainHash, error) {
+	if len(serializedHashes) < hashesLengthSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected EOF while deserializing hashes")
+	}
+	length := binary.LittleEndian.Uint64(serializedHashes[:hashesLengthSize])
+	hashes := make([]*externalapi.DomainHash, length)
+	for i := uint64(0); i < length; i++ {
+		start := hashesLengthSize + externalapi.DomainHashSize*i
+		end := start + externalapi.DomainHashSize
+
+		if end > uint64(len(serializedHashes)) {
+			return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected EOF while deserializing hashes")
+		}
+
+		var err error
+		hashes[i], err = externalapi.NewDomainHashFromByteSlice(serializedHashes[start:end])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return hashes, nil
+}
@@ -0,0 +1,81 @@
+package txindex
+
+import (
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+func randomHash(r *rand.Rand) *externalapi.DomainHash {
+	var hashBytes [externalapi.DomainHashSize]byte
+	r.Read(hashBytes[:])
+	return externalapi.NewDomainHashFromByteArray(&hashBytes)
+}
+
+func Test_serializeTXAcceptanceData(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 32; i++ {
+		txAcceptanceData := &TXAcceptanceData{
+			AcceptingBlockHash: randomHash(r),
+			IncludingBlockHash: randomHash(r),
+		}
+		result, err := deserializeTXAcceptanceData(serializeTXAcceptanceData(txAcceptanceData))
+		if err != nil {
+			t.Fatalf("Failed deserializing transaction acceptance data: %v", err)
+		}
+		if !result.AcceptingBlockHash.Equal(txAcceptanceData.AcceptingBlockHash) {
+			t.Fatalf("Expected accepting block hash %s, got %s",
+				txAcceptanceData.AcceptingBlockHash, result.AcceptingBlockHash)
+		}
+		if !result.IncludingBlockHash.Equal(txAcceptanceData.IncludingBlockHash) {
+			t.Fatalf("Expected including block hash %s, got %s",
+				txAcceptanceData.IncludingBlockHash, result.IncludingBlockHash)
+		}
+	}
+}
+
+func Test_deserializeTXAcceptanceDataFailure(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	serialized := serializeTXAcceptanceData(&TXAcceptanceData{
+		AcceptingBlockHash: randomHash(r),
+		IncludingBlockHash: randomHash(r),
+	})
+	_, err := deserializeTXAcceptanceData(serialized[:len(serialized)-1])
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+}
+
+func Test_serializeHashes(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for length := 0; length < 32; length++ {
+		hashes := make([]*externalapi.DomainHash, length)
+		for i := range hashes {
+			hashes[i] = randomHash(r)
+		}
+		result, err := deserializeHashes(serializeHashes(hashes))
+		if err != nil {
+			t.Fatalf("Failed deserializing hashes: %v", err)
+		}
+		if !externalapi.HashesEqual(hashes, result) {
+			t.Fatalf("Expected \n %s \n==\n %s\n", hashes, result)
+		}
+	}
+}
+
+func Test_deserializeHashesFailure(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	hashes := []*externalapi.DomainHash{randomHash(r), randomHash(r), randomHash(r)}
+	serialized := serializeHashes(hashes)
+	binary.LittleEndian.PutUint64(serialized[:8], uint64(len(hashes)+1))
+	_, err := deserializeHashes(serialized)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+}
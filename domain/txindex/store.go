@@ -0,0 +1,295 @@
+package txindex
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+var txIndexBucket = database.MakeBucket([]byte("tx-index"))
+var txAcceptanceDataBucket = txIndexBucket.Bucket([]byte("acceptance-data"))
+var txContainingBlocksBucket = txIndexBucket.Bucket([]byte("containing-blocks"))
+var virtualParentsKey = database.MakeBucket([]byte("")).Key([]byte("tx-index-virtual-parents"))
+
+type txIndexStore struct {
+	database database.Database
+
+	toAddAcceptanceData    map[externalapi.DomainTransactionID]*TXAcceptanceData
+	toRemoveAcceptanceData map[externalapi.DomainTransactionID]struct{}
+
+	toAddContainingBlocks    map[externalapi.DomainTransactionID]TXContainingBlockHashes
+	toRemoveContainingBlocks map[externalapi.DomainTransactionID]TXContainingBlockHashes
+
+	virtualParents []*externalapi.DomainHash
+}
+
+func newTXIndexStore(database database.Database) *txIndexStore {
+	return &txIndexStore{
+		database:                 database,
+		toAddAcceptanceData:      make(map[externalapi.DomainTransactionID]*TXAcceptanceData),
+		toRemoveAcceptanceData:   make(map[externalapi.DomainTransactionID]struct{}),
+		toAddContainingBlocks:    make(map[externalapi.DomainTransactionID]TXContainingBlockHashes),
+		toRemoveContainingBlocks: make(map[externalapi.DomainTransactionID]TXContainingBlockHashes),
+	}
+}
+
+func (tis *txIndexStore) addAcceptanceData(transactionID *externalapi.DomainTransactionID,
+	txAcceptanceData *TXAcceptanceData) {
+
+	log.Tracef("Adding acceptance data of transaction %s: accepted by %s from %s",
+		transactionID, txAcceptanceData.AcceptingBlockHash, txAcceptanceData.IncludingBlockHash)
+
+	delete(tis.toRemoveAcceptanceData, *transactionID)
+	tis.toAddAcceptanceData[*transactionID] = txAcceptanceData
+}
+
+func (tis *txIndexStore) removeAcceptanceData(transactionID *externalapi.DomainTransactionID) {
+	log.Tracef("Removing acceptance data of transaction %s", transactionID)
+
+	// If the acceptance data was staged for addition simply remove it from there,
+	// otherwise it's already in the database and must be deleted on commit
+	if _, ok := tis.toAddAcceptanceData[*transactionID]; ok {
+		delete(tis.toAddAcceptanceData, *transactionID)
+		return
+	}
+	tis.toRemoveAcceptanceData[*transactionID] = struct{}{}
+}
+
+func (tis *txIndexStore) addContainingBlock(transactionID *externalapi.DomainTransactionID,
+	blockHash *externalapi.DomainHash) {
+
+	log.Tracef("Adding block %s to the containing blocks of transaction %s", blockHash, transactionID)
+
+	// If the block exists in `toRemoveContainingBlocks` simply remove it from there and return
+	if toRemoveBlocksOfTransaction, ok := tis.toRemoveContainingBlocks[*transactionID]; ok {
+		if _, ok := toRemoveBlocksOfTransaction[*blockHash]; ok {
+			delete(toRemoveBlocksOfTransaction, *blockHash)
+			return
+		}
+	}
+
+	if _, ok := tis.toAddContainingBlocks[*transactionID]; !ok {
+		tis.toAddContainingBlocks[*transactionID] = make(TXContainingBlockHashes)
+	}
+	tis.toAddContainingBlocks[*transactionID][*blockHash] = struct{}{}
+}
+
+func (tis *txIndexStore) removeContainingBlock(transactionID *externalapi.DomainTransactionID,
+	blockHash *externalapi.DomainHash) {
+
+	log.Tracef("Removing block %s from the containing blocks of transaction %s", blockHash, transactionID)
+
+	// If the block exists in `toAddContainingBlocks` simply remove it from there and return
+	if toAddBlocksOfTransaction, ok := tis.toAddContainingBlocks[*transactionID]; ok {
+		if _, ok := toAddBlocksOfTransaction[*blockHash]; ok {
+			delete(toAddBlocksOfTransaction, *blockHash)
+			return
+		}
+	}
+
+	if _, ok := tis.toRemoveContainingBlocks[*transactionID]; !ok {
+		tis.toRemoveContainingBlocks[*transactionID] = make(TXContainingBlockHashes)
+	}
+	tis.toRemoveContainingBlocks[*transactionID][*blockHash] = struct{}{}
+}
+
+func (tis *txIndexStore) updateVirtualParents(virtualParents []*externalapi.DomainHash) {
+	tis.virtualParents = virtualParents
+}
+
+func (tis *txIndexStore) discard() {
+	tis.toAddAcceptanceData = make(map[externalapi.DomainTransactionID]*TXAcceptanceData)
+	tis.toRemoveAcceptanceData = make(map[externalapi.DomainTransactionID]struct{})
+	tis.toAddContainingBlocks = make(map[externalapi.DomainTransactionID]TXContainingBlockHashes)
+	tis.toRemoveContainingBlocks = make(map[externalapi.DomainTransactionID]TXContainingBlockHashes)
+	tis.virtualParents = nil
+}
+
+func (tis *txIndexStore) commit() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "txIndexStore.commit")
+	defer onEnd()
+
+	dbTransaction, err := tis.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	err = tis.commitStagedData(dbTransaction)
+	if err != nil {
+		return err
+	}
+
+	if tis.virtualParents != nil {
+		err = dbTransaction.Put(virtualParentsKey, serializeHashes(tis.virtualParents))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = dbTransaction.Commit()
+	if err != nil {
+		return err
+	}
+
+	tis.discard()
+	return nil
+}
+
+func (tis *txIndexStore) commitStagedData(dataAccessor database.DataAccessor) error {
+	for transactionID := range tis.toRemoveAcceptanceData {
+		err := dataAccessor.Delete(tis.acceptanceDataKey(&transactionID))
+		if err != nil {
+			return err
+		}
+	}
+
+	for transactionID, txAcceptanceData := range tis.toAddAcceptanceData {
+		err := dataAccessor.Put(tis.acceptanceDataKey(&transactionID), serializeTXAcceptanceData(txAcceptanceData))
+		if err != nil {
+			return err
+		}
+	}
+
+	for transactionID, blockHashes := range tis.toRemoveContainingBlocks {
+		bucket := tis.containingBlocksBucket(&transactionID)
+		for blockHash := range blockHashes {
+			err := dataAccessor.Delete(bucket.Key(blockHash.ByteSlice()))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for transactionID, blockHashes := range tis.toAddContainingBlocks {
+		bucket := tis.containingBlocksBucket(&transactionID)
+		for blockHash := range blockHashes {
+			err := dataAccessor.Put(bucket.Key(blockHash.ByteSlice()), []byte{})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// commitWithoutTransaction writes the staged data directly to the database. It's
+// meant to be used while resetting the index, where the virtual parents are only
+// written once all the data is in place.
+func (tis *txIndexStore) commitWithoutTransaction() error {
+	err := tis.commitStagedData(tis.database)
+	if err != nil {
+		return err
+	}
+
+	tis.discard()
+	return nil
+}
+
+func (tis *txIndexStore) updateAndCommitVirtualParentsWithoutTransaction(virtualParents []*externalapi.DomainHash) error {
+	return tis.database.Put(virtualParentsKey, serializeHashes(virtualParents))
+}
+
+func (tis *txIndexStore) acceptanceDataKey(transactionID *externalapi.DomainTransactionID) *database.Key {
+	return txAcceptanceDataBucket.Key(transactionID.ByteSlice())
+}
+
+func (tis *txIndexStore) containingBlocksBucket(transactionID *externalapi.DomainTransactionID) *database.Bucket {
+	return txContainingBlocksBucket.Bucket(transactionID.ByteSlice())
+}
+
+func (tis *txIndexStore) isAnythingStaged() bool {
+	return len(tis.toAddAcceptanceData) > 0 || len(tis.toRemoveAcceptanceData) > 0 ||
+		len(tis.toAddContainingBlocks) > 0 || len(tis.toRemoveContainingBlocks) > 0
+}
+
+func (tis *txIndexStore) getAcceptanceData(transactionID *externalapi.DomainTransactionID) (*TXAcceptanceData, bool, error) {
+	if tis.isAnythingStaged() {
+		return nil, false, errors.Errorf("cannot get transaction acceptance data while staging isn't empty")
+	}
+
+	serializedTXAcceptanceData, err := tis.database.Get(tis.acceptanceDataKey(transactionID))
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	txAcceptanceData, err := deserializeTXAcceptanceData(serializedTXAcceptanceData)
+	if err != nil {
+		return nil, false, err
+	}
+	return txAcceptanceData, true, nil
+}
+
+func (tis *txIndexStore) getContainingBlockHashes(transactionID *externalapi.DomainTransactionID) (
+	[]*externalapi.DomainHash, error) {
+
+	if tis.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get transaction containing blocks while staging isn't empty")
+	}
+
+	cursor, err := tis.database.Cursor(tis.containingBlocksBucket(transactionID))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var blockHashes []*externalapi.DomainHash
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, err
+		}
+		blockHash, err := externalapi.NewDomainHashFromByteSlice(key.Suffix())
+		if err != nil {
+			return nil, err
+		}
+		blockHashes = append(blockHashes, blockHash)
+	}
+	return blockHashes, nil
+}
+
+func (tis *txIndexStore) getVirtualParents() ([]*externalapi.DomainHash, error) {
+	if tis.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get the virtual parents while staging isn't empty")
+	}
+
+	serializedHashes, err := tis.database.Get(virtualParentsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return deserializeHashes(serializedHashes)
+}
+
+func (tis *txIndexStore) deleteAll() error {
+	// First we delete the virtual parents, so if anything goes wrong, the TX index will be marked as "not synced"
+	// and will be reset.
+	err := tis.database.Delete(virtualParentsKey)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := tis.database.Cursor(txIndexBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+
+		err = tis.database.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
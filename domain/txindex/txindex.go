@@ -0,0 +1,203 @@
+package txindex
+
+import (
+	"sync"
+
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+)
+
+// TXIndex maintains an index between transaction IDs
+// and the blocks that contain and accept them
+type TXIndex struct {
+	domain domain.Domain
+	store  *txIndexStore
+
+	mutex sync.Mutex
+}
+
+// New creates a new TX index.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func New(domain domain.Domain, database database.Database) (*TXIndex, error) {
+	txIndex := &TXIndex{
+		domain: domain,
+		store:  newTXIndexStore(database),
+	}
+	isSynced, err := txIndex.isSynced()
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSynced {
+		err := txIndex.Reset()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return txIndex, nil
+}
+
+// Reset deletes the whole TX index and resyncs it from consensus.
+func (ti *TXIndex) Reset() error {
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	err := ti.store.deleteAll()
+	if err != nil {
+		return err
+	}
+
+	virtualInfo, err := ti.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return err
+	}
+
+	pruningPoint, err := ti.domain.Consensus().PruningPoint()
+	if err != nil {
+		return err
+	}
+
+	chainPath, err := ti.domain.Consensus().GetVirtualSelectedParentChainFromBlock(pruningPoint)
+	if err != nil {
+		return err
+	}
+
+	const chunk = 1000
+	for position := 0; position < len(chainPath.Added); position += chunk {
+		end := position + chunk
+		if end > len(chainPath.Added) {
+			end = len(chainPath.Added)
+		}
+
+		// We use chunks in order to avoid blocking consensus for too long
+		chainBlocksChunk := chainPath.Added[position:end]
+		chainBlocksAcceptanceData, err := ti.domain.Consensus().GetBlocksAcceptanceData(chainBlocksChunk)
+		if err != nil {
+			return err
+		}
+
+		for i, chainBlockHash := range chainBlocksChunk {
+			ti.addChainBlockAcceptanceData(chainBlockHash, chainBlocksAcceptanceData[i])
+		}
+
+		err = ti.store.commitWithoutTransaction()
+		if err != nil {
+			return err
+		}
+	}
+
+	// This has to be done last to mark that the reset went smoothly and no reset has to be called next time.
+	return ti.store.updateAndCommitVirtualParentsWithoutTransaction(virtualInfo.ParentHashes)
+}
+
+func (ti *TXIndex) isSynced() (bool, error) {
+	txIndexVirtualParents, err := ti.store.getVirtualParents()
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	virtualInfo, err := ti.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return false, err
+	}
+
+	return externalapi.HashesEqual(virtualInfo.ParentHashes, txIndexVirtualParents), nil
+}
+
+// Update updates the TX index with the given DAG selected parent chain changes
+func (ti *TXIndex) Update(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.Update")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	chainChanges := virtualChangeSet.VirtualSelectedParentChainChanges
+	log.Tracef("Updating TX index with VirtualSelectedParentChainChanges: %+v", chainChanges)
+
+	if chainChanges != nil {
+		removedChainBlocksAcceptanceData, err := ti.domain.Consensus().GetBlocksAcceptanceData(chainChanges.Removed)
+		if err != nil {
+			return err
+		}
+		for i, removedChainBlockHash := range chainChanges.Removed {
+			ti.removeChainBlockAcceptanceData(removedChainBlockHash, removedChainBlocksAcceptanceData[i])
+		}
+
+		addedChainBlocksAcceptanceData, err := ti.domain.Consensus().GetBlocksAcceptanceData(chainChanges.Added)
+		if err != nil {
+			return err
+		}
+		for i, addedChainBlockHash := range chainChanges.Added {
+			ti.addChainBlockAcceptanceData(addedChainBlockHash, addedChainBlocksAcceptanceData[i])
+		}
+	}
+
+	ti.store.updateVirtualParents(virtualChangeSet.VirtualParents)
+
+	return ti.store.commit()
+}
+
+func (ti *TXIndex) addChainBlockAcceptanceData(chainBlockHash *externalapi.DomainHash,
+	chainBlockAcceptanceData externalapi.AcceptanceData) {
+
+	for _, blockAcceptanceData := range chainBlockAcceptanceData {
+		for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+			transactionID := consensushashing.TransactionID(transactionAcceptanceData.Transaction)
+			ti.store.addContainingBlock(transactionID, blockAcceptanceData.BlockHash)
+			if transactionAcceptanceData.IsAccepted {
+				ti.store.addAcceptanceData(transactionID, &TXAcceptanceData{
+					AcceptingBlockHash: chainBlockHash,
+					IncludingBlockHash: blockAcceptanceData.BlockHash,
+				})
+			}
+		}
+	}
+}
+
+func (ti *TXIndex) removeChainBlockAcceptanceData(chainBlockHash *externalapi.DomainHash,
+	chainBlockAcceptanceData externalapi.AcceptanceData) {
+
+	log.Tracef("Removing the acceptance data of chain block %s from the TX index", chainBlockHash)
+	for _, blockAcceptanceData := range chainBlockAcceptanceData {
+		for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+			transactionID := consensushashing.TransactionID(transactionAcceptanceData.Transaction)
+			ti.store.removeContainingBlock(transactionID, blockAcceptanceData.BlockHash)
+			if transactionAcceptanceData.IsAccepted {
+				ti.store.removeAcceptanceData(transactionID)
+			}
+		}
+	}
+}
+
+// TXAcceptanceData returns the acceptance data of the given transaction. The
+// returned bool is false if the transaction was not accepted by any chain block.
+func (ti *TXIndex) TXAcceptanceData(transactionID *externalapi.DomainTransactionID) (*TXAcceptanceData, bool, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.TXAcceptanceData")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	return ti.store.getAcceptanceData(transactionID)
+}
+
+// TXContainingBlockHashes returns the hashes of all the blocks known to contain the
+// given transaction. Only blocks merged by the virtual selected parent chain are indexed.
+func (ti *TXIndex) TXContainingBlockHashes(transactionID *externalapi.DomainTransactionID) ([]*externalapi.DomainHash, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.TXContainingBlockHashes")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	return ti.store.getContainingBlockHashes(transactionID)
+}
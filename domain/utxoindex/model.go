@@ -21,3 +21,36 @@ type UTXOChanges struct {
 	Added   map[ScriptPublicKeyString]UTXOOutpointEntryPairs
 	Removed map[ScriptPublicKeyString]UTXOOutpointEntryPairs
 }
+
+// UTXOFilter filters the UTXOs returned by UTXOIndex.UTXOsPage.
+// Zero-valued fields don't filter anything out.
+type UTXOFilter struct {
+	MinAmount        uint64
+	MaxAmount        uint64
+	MinBlockDAAScore uint64
+	MaxBlockDAAScore uint64
+
+	// When ExcludeImmatureCoinbase is set, coinbase UTXOs that are not
+	// yet spendable at VirtualDAAScore are filtered out
+	ExcludeImmatureCoinbase bool
+	VirtualDAAScore         uint64
+	CoinbaseMaturity        uint64
+}
+
+func (uf *UTXOFilter) matches(utxoEntry externalapi.UTXOEntry) bool {
+	if uf == nil {
+		return true
+	}
+	if utxoEntry.Amount() < uf.MinAmount || (uf.MaxAmount != 0 && utxoEntry.Amount() > uf.MaxAmount) {
+		return false
+	}
+	if utxoEntry.BlockDAAScore() < uf.MinBlockDAAScore ||
+		(uf.MaxBlockDAAScore != 0 && utxoEntry.BlockDAAScore() > uf.MaxBlockDAAScore) {
+		return false
+	}
+	if uf.ExcludeImmatureCoinbase && utxoEntry.IsCoinbase() &&
+		utxoEntry.BlockDAAScore()+uf.CoinbaseMaturity > uf.VirtualDAAScore {
+		return false
+	}
+	return true
+}
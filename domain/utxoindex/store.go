@@ -297,6 +297,78 @@ func (uis *utxoIndexStore) getUTXOOutpointEntryPairs(scriptPublicKey *externalap
 	return utxoOutpointEntryPairs, nil
 }
 
+// getUTXOOutpointEntryPairsPage returns up to limit UTXOs of the given script public key that match
+// the given filter, ordered by their serialized outpoints. Only UTXOs ordered after startOutpoint are
+// returned, or all of them if it's nil, and a limit of 0 means no limit. The returned bool is true if
+// there are more matching UTXOs after the returned ones.
+func (uis *utxoIndexStore) getUTXOOutpointEntryPairsPage(scriptPublicKey *externalapi.ScriptPublicKey,
+	startOutpoint *externalapi.DomainOutpoint, limit int, filter *UTXOFilter) (
+	[]*externalapi.OutpointAndUTXOEntryPair, bool, error) {
+
+	if uis.isAnythingStaged() {
+		return nil, false, errors.Errorf("cannot get utxo outpoint entry pairs while staging isn't empty")
+	}
+
+	var serializedStartOutpoint []byte
+	if startOutpoint != nil {
+		var err error
+		serializedStartOutpoint, err = serializeOutpoint(startOutpoint)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	bucket := uis.bucketForScriptPublicKey(scriptPublicKey)
+	cursor, err := uis.database.Cursor(bucket)
+	if err != nil {
+		return nil, false, err
+	}
+	defer cursor.Close()
+
+	// Seeking to the start outpoint skips the outpoints before it without reading them. If the start
+	// outpoint was spent since it was returned, the cursor is already at the outpoint that follows it.
+	isAtFirstEntry := false
+	if serializedStartOutpoint != nil {
+		err := cursor.Seek(bucket.Key(serializedStartOutpoint))
+		if err != nil {
+			if !database.IsNotFoundError(err) {
+				return nil, false, err
+			}
+			_, err := cursor.Key()
+			isAtFirstEntry = err == nil
+		}
+	}
+
+	var pairs []*externalapi.OutpointAndUTXOEntryPair
+	for isAtFirstEntry || cursor.Next() {
+		isAtFirstEntry = false
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, false, err
+		}
+		serializedUTXOEntry, err := cursor.Value()
+		if err != nil {
+			return nil, false, err
+		}
+		utxoEntry, err := deserializeUTXOEntry(serializedUTXOEntry)
+		if err != nil {
+			return nil, false, err
+		}
+		if !filter.matches(utxoEntry) {
+			continue
+		}
+		if limit > 0 && len(pairs) == limit {
+			return pairs, true, nil
+		}
+		outpoint, err := uis.convertKeyToOutpoint(key)
+		if err != nil {
+			return nil, false, err
+		}
+		pairs = append(pairs, &externalapi.OutpointAndUTXOEntryPair{Outpoint: outpoint, UTXOEntry: utxoEntry})
+	}
+	return pairs, false, nil
+}
+
 func (uis *utxoIndexStore) getVirtualParents() ([]*externalapi.DomainHash, error) {
 	if uis.isAnythingStaged() {
 		return nil, errors.Errorf("cannot get the virtual parents while staging isn't empty")
@@ -0,0 +1,184 @@
+package utxoindex
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/memorydb"
+)
+
+func TestGetUTXOOutpointEntryPairsPage(t *testing.T) {
+	store := newUTXOIndexStore(memorydb.NewMemoryDB())
+	err := store.initializeCirculatingSompiSupply()
+	if err != nil {
+		t.Fatalf("initializeCirculatingSompiSupply: %+v", err)
+	}
+
+	scriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}, Version: 0}
+	otherScriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{4, 5, 6}, Version: 0}
+
+	// UTXO i has an amount of i+1 and a block DAA score of 10*i, and every
+	// third UTXO is a coinbase UTXO
+	const utxoAmount = 20
+	for i := 0; i < utxoAmount; i++ {
+		var transactionIDBytes [externalapi.DomainHashSize]byte
+		transactionIDBytes[0] = byte(utxoAmount - i)
+		outpoint := externalapi.NewDomainOutpoint(externalapi.NewDomainTransactionIDFromByteArray(&transactionIDBytes), 0)
+		err = store.add(scriptPublicKey, outpoint, utxo.NewUTXOEntry(uint64(i+1), scriptPublicKey, i%3 == 0, uint64(10*i)))
+		if err != nil {
+			t.Fatalf("add: %+v", err)
+		}
+		err = store.add(otherScriptPublicKey, outpoint, utxo.NewUTXOEntry(uint64(i+1), otherScriptPublicKey, false, 0))
+		if err != nil {
+			t.Fatalf("add: %+v", err)
+		}
+	}
+	err = store.commit()
+	if err != nil {
+		t.Fatalf("commit: %+v", err)
+	}
+
+	getAllPages := func(filter *UTXOFilter, pageSize int) []*externalapi.OutpointAndUTXOEntryPair {
+		var pairs []*externalapi.OutpointAndUTXOEntryPair
+		var startOutpoint *externalapi.DomainOutpoint
+		for {
+			page, hasMore, err := store.getUTXOOutpointEntryPairsPage(scriptPublicKey, startOutpoint, pageSize, filter)
+			if err != nil {
+				t.Fatalf("getUTXOOutpointEntryPairsPage: %+v", err)
+			}
+			if pageSize > 0 && len(page) > pageSize {
+				t.Fatalf("Got a page of %d UTXOs while the page size is %d", len(page), pageSize)
+			}
+			pairs = append(pairs, page...)
+			if !hasMore {
+				return pairs
+			}
+			startOutpoint = page[len(page)-1].Outpoint
+		}
+	}
+
+	allPairs := getAllPages(nil, 0)
+	if len(allPairs) != utxoAmount {
+		t.Fatalf("Expected %d UTXOs, got %d", utxoAmount, len(allPairs))
+	}
+	for i := 1; i < len(allPairs); i++ {
+		if !allPairs[i-1].Outpoint.TransactionID.Less(&allPairs[i].Outpoint.TransactionID) {
+			t.Fatalf("UTXOs are not ordered by outpoint: %s came before %s",
+				allPairs[i-1].Outpoint, allPairs[i].Outpoint)
+		}
+	}
+
+	for _, pageSize := range []int{1, 3, utxoAmount, utxoAmount + 1} {
+		pairs := getAllPages(nil, pageSize)
+		if len(pairs) != len(allPairs) {
+			t.Fatalf("Expected %d UTXOs with page size %d, got %d", len(allPairs), pageSize, len(pairs))
+		}
+		for i := range pairs {
+			if !pairs[i].Outpoint.Equal(allPairs[i].Outpoint) {
+				t.Fatalf("Unexpected outpoint at position %d with page size %d. Want: %s, got: %s",
+					i, pageSize, allPairs[i].Outpoint, pairs[i].Outpoint)
+			}
+		}
+	}
+
+	// Each page starts right after the last outpoint of the previous page, so
+	// paging in small pages must still go through every UTXO once
+	const pageSize = 3
+	pageCount := 0
+	var startOutpoint *externalapi.DomainOutpoint
+	var pagedPairs []*externalapi.OutpointAndUTXOEntryPair
+	for {
+		page, hasMore, err := store.getUTXOOutpointEntryPairsPage(scriptPublicKey, startOutpoint, pageSize, nil)
+		if err != nil {
+			t.Fatalf("getUTXOOutpointEntryPairsPage: %+v", err)
+		}
+		pageCount++
+		pagedPairs = append(pagedPairs, page...)
+		if !hasMore {
+			break
+		}
+		startOutpoint = page[len(page)-1].Outpoint
+	}
+	expectedPageCount := (utxoAmount + pageSize - 1) / pageSize
+	if pageCount != expectedPageCount {
+		t.Fatalf("Expected %d pages of %d UTXOs, got %d", expectedPageCount, pageSize, pageCount)
+	}
+	if len(pagedPairs) != utxoAmount {
+		t.Fatalf("Expected %d UTXOs over %d pages, got %d", utxoAmount, pageCount, len(pagedPairs))
+	}
+
+	// A page may start at an outpoint that was spent after the previous page was
+	// returned. The next page should then start at the outpoint that followed it.
+	firstPage, _, err := store.getUTXOOutpointEntryPairsPage(scriptPublicKey, nil, pageSize, nil)
+	if err != nil {
+		t.Fatalf("getUTXOOutpointEntryPairsPage: %+v", err)
+	}
+	spentPair := firstPage[len(firstPage)-1]
+	err = store.remove(scriptPublicKey, spentPair.Outpoint, spentPair.UTXOEntry)
+	if err != nil {
+		t.Fatalf("remove: %+v", err)
+	}
+	err = store.commit()
+	if err != nil {
+		t.Fatalf("commit: %+v", err)
+	}
+	secondPage, _, err := store.getUTXOOutpointEntryPairsPage(scriptPublicKey, spentPair.Outpoint, pageSize, nil)
+	if err != nil {
+		t.Fatalf("getUTXOOutpointEntryPairsPage: %+v", err)
+	}
+	if len(secondPage) != pageSize || !secondPage[0].Outpoint.Equal(allPairs[pageSize].Outpoint) {
+		t.Fatalf("Expected the page after the spent outpoint %s to start at %s",
+			spentPair.Outpoint, allPairs[pageSize].Outpoint)
+	}
+	err = store.add(scriptPublicKey, spentPair.Outpoint, spentPair.UTXOEntry)
+	if err != nil {
+		t.Fatalf("add: %+v", err)
+	}
+	err = store.commit()
+	if err != nil {
+		t.Fatalf("commit: %+v", err)
+	}
+
+	tests := []struct {
+		name          string
+		filter        *UTXOFilter
+		expectedCount int
+	}{
+		{name: "min amount", filter: &UTXOFilter{MinAmount: 6}, expectedCount: 15},
+		{name: "max amount", filter: &UTXOFilter{MaxAmount: 5}, expectedCount: 5},
+		{name: "amount range", filter: &UTXOFilter{MinAmount: 6, MaxAmount: 10}, expectedCount: 5},
+		{name: "min block DAA score", filter: &UTXOFilter{MinBlockDAAScore: 100}, expectedCount: 10},
+		{name: "max block DAA score", filter: &UTXOFilter{MaxBlockDAAScore: 95}, expectedCount: 10},
+		{
+			// Coinbase UTXOs are at DAA scores 0, 30, ..., 180, of which only
+			// those at 0, 30, 60 and 90 are mature at 191
+			name:          "immature coinbase",
+			filter:        &UTXOFilter{ExcludeImmatureCoinbase: true, VirtualDAAScore: 191, CoinbaseMaturity: 100},
+			expectedCount: 17,
+		},
+		{
+			// Consensus lets the coinbase UTXO at 90 be spent once the virtual
+			// DAA score reaches 90 + 100, but not a block earlier
+			name:          "coinbase at maturity",
+			filter:        &UTXOFilter{ExcludeImmatureCoinbase: true, VirtualDAAScore: 190, CoinbaseMaturity: 100},
+			expectedCount: 17,
+		},
+		{
+			name:          "coinbase right before maturity",
+			filter:        &UTXOFilter{ExcludeImmatureCoinbase: true, VirtualDAAScore: 189, CoinbaseMaturity: 100},
+			expectedCount: 16,
+		},
+	}
+	for _, test := range tests {
+		pairs := getAllPages(test.filter, 2)
+		if len(pairs) != test.expectedCount {
+			t.Fatalf("%s: expected %d UTXOs, got %d", test.name, test.expectedCount, len(pairs))
+		}
+		for _, pair := range pairs {
+			if !test.filter.matches(pair.UTXOEntry) {
+				t.Fatalf("%s: UTXO %s doesn't match the filter", test.name, pair.Outpoint)
+			}
+		}
+	}
+}
@@ -191,6 +191,23 @@ func (ui *UTXOIndex) UTXOs(scriptPublicKey *externalapi.ScriptPublicKey) (UTXOOu
 	return ui.store.getUTXOOutpointEntryPairs(scriptPublicKey)
 }
 
+// UTXOsPage returns up to limit UTXOs of the given scriptPublicKey that match the given filter,
+// ordered by their outpoints. Only UTXOs ordered after startOutpoint are returned, so the outpoint
+// of the last UTXO of a page can be passed to get the next page. A nil startOutpoint starts from
+// the first UTXO, and a limit of 0 means no limit. The returned bool is true if there are more
+// matching UTXOs after the returned ones.
+func (ui *UTXOIndex) UTXOsPage(scriptPublicKey *externalapi.ScriptPublicKey, startOutpoint *externalapi.DomainOutpoint,
+	limit int, filter *UTXOFilter) ([]*externalapi.OutpointAndUTXOEntryPair, bool, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "UTXOIndex.UTXOsPage")
+	defer onEnd()
+
+	ui.mutex.Lock()
+	defer ui.mutex.Unlock()
+
+	return ui.store.getUTXOOutpointEntryPairsPage(scriptPublicKey, startOutpoint, limit, filter)
+}
+
 // GetCirculatingSompiSupply returns the current circulating supply of sompis in the network
 func (ui *UTXOIndex) GetCirculatingSompiSupply() (uint64, error) {
 
@@ -18,6 +18,7 @@ require (
 	github.com/tyler-smith/go-bip39 v1.1.0
 	golang.org/x/crypto v0.1.0
 	golang.org/x/exp v0.0.0-20220414153411-bcd21879b8fd
+	golang.org/x/net v0.7.0
 	golang.org/x/term v0.5.0
 	google.golang.org/grpc v1.38.0
 	google.golang.org/protobuf v1.28.1
@@ -25,7 +26,6 @@ require (
 
 require (
 	github.com/golang/snappy v0.0.1 // indirect
-	golang.org/x/net v0.7.0 // indirect
 	golang.org/x/sys v0.5.0 // indirect
 	golang.org/x/text v0.7.0 // indirect
 	google.golang.org/genproto v0.0.0-20210604141403-392c879c8b08 // indirect
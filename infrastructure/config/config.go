@@ -21,6 +21,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/dagconfig"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
 	"github.com/kaspanet/kaspad/util"
 	"github.com/kaspanet/kaspad/util/network"
 	"github.com/kaspanet/kaspad/version"
@@ -33,6 +34,7 @@ const (
 	defaultLogDirname          = "logs"
 	defaultLogFilename         = "kaspad.log"
 	defaultErrLogFilename      = "kaspad_err.log"
+	mempoolFilename            = "mempool.dat"
 	defaultTargetOutboundPeers = 8
 	defaultMaxInboundPeers     = 117
 	defaultBanDuration         = time.Hour * 24
@@ -48,12 +50,24 @@ const (
 	blockMaxMassMax              = 10_000_000
 	defaultMinRelayTxFee         = 1e-5 // 1 sompi per byte
 	defaultMaxOrphanTransactions = 100
+	defaultMaxMempoolMass        = 1_000_000_000
+	defaultStratumDifficulty     = 1
 	//DefaultMaxOrphanTxSize is the default maximum size for an orphan transaction
 	DefaultMaxOrphanTxSize  = 100_000
 	defaultSigCacheMaxSize  = 100_000
 	sampleConfigFilename    = "sample-kaspad.conf"
 	defaultMaxUTXOCacheSize = 5_000_000_000
 	defaultProtocolVersion  = 5
+	defaultDbType           = DbTypeLevelDB
+)
+
+const (
+	// DbTypeLevelDB is the database type of the on-disk LevelDB backend
+	DbTypeLevelDB = "leveldb"
+
+	// DbTypeMemory is the database type of the in-memory backend. Its data
+	// is lost once kaspad shuts down
+	DbTypeMemory = "memory"
 )
 
 var (
@@ -92,13 +106,22 @@ type Flags struct {
 	BanThreshold                    uint32        `long:"banthreshold" description:"Maximum allowed ban score before disconnecting and banning misbehaving peers."`
 	Whitelists                      []string      `long:"whitelist" description:"Add an IP network or IP that will not be banned. (eg. 192.168.1.0/24 or ::1)"`
 	RPCListeners                    []string      `long:"rpclisten" description:"Add an interface/port to listen for RPC connections (default port: 16110, testnet: 16210)"`
+	RPCTLS                          bool          `long:"rpctls" description:"Serve RPC and JSON-RPC connections over TLS. A self-signed certificate is generated into --rpccert and --rpckey if neither of them exists"`
 	RPCCert                         string        `long:"rpccert" description:"File containing the certificate file"`
 	RPCKey                          string        `long:"rpckey" description:"File containing the certificate key"`
+	RPCClientCA                     string        `long:"rpcclientca" description:"File containing the PEM certificates of the authorities that sign RPC client certificates. Once set, RPC clients must present a certificate signed by one of them (requires --rpctls)"`
 	RPCMaxClients                   int           `long:"rpcmaxclients" description:"Max number of RPC clients for standard connections"`
 	RPCMaxWebsockets                int           `long:"rpcmaxwebsockets" description:"Max number of RPC websocket connections"`
 	RPCMaxConcurrentReqs            int           `long:"rpcmaxconcurrentreqs" description:"Max number of concurrent RPC requests that may be processed concurrently"`
+	JSONRPCListeners                []string      `long:"jsonrpclisten" description:"Add an interface/port to listen for JSON-RPC connections over HTTP and WebSocket (disabled by default, eg. 127.0.0.1:16120)"`
+	JSONRPCAllowedOrigins           []string      `long:"jsonrpcallowedorigin" description:"Allow web pages from the given origin to access the JSON-RPC server (eg. https://example.com). Use * to allow any origin"`
+	StratumListeners                []string      `long:"stratumlisten" description:"Add an interface/port to listen for Stratum miner connections (disabled by default, eg. 0.0.0.0:5555)"`
+	StratumPayAddress               string        `long:"stratumpayaddress" description:"Address that blocks mined by Stratum miners pay to. If omitted, every miner is paid to the address it authorizes with, as in <address>.<worker name>"`
+	StratumDifficulty               float64       `long:"stratumdifficulty" description:"Initial share difficulty of Stratum miners. It is then adjusted to about 20 shares per minute for every miner"`
 	DisableRPC                      bool          `long:"norpc" description:"Disable built-in RPC server"`
 	SafeRPC                         bool          `long:"saferpc" description:"Disable RPC commands which affect the state of the node"`
+	RPCUsers                        []string      `long:"rpcuser" description:"Add an RPC user in the form <username>:<password>:<roles>, where <roles> is a comma separated list of read-only, mining, wallet-submit and admin. Once any user or token is added, RPC clients must authenticate"`
+	RPCTokens                       []string      `long:"rpctoken" description:"Add an RPC bearer token in the form <token>:<roles>, where <roles> is a comma separated list of read-only, mining, wallet-submit and admin"`
 	DisableDNSSeed                  bool          `long:"nodnsseed" description:"Disable DNS seeding for peers"`
 	DNSSeed                         string        `long:"dnsseed" description:"Override DNS seeds with specified hostname (Only 1 hostname allowed)"`
 	GRPCSeed                        string        `long:"grpcseed" description:"Hostname of gRPC server for seeding peers"`
@@ -106,12 +129,15 @@ type Flags struct {
 	Proxy                           string        `long:"proxy" description:"Connect via SOCKS5 proxy (eg. 127.0.0.1:9050)"`
 	ProxyUser                       string        `long:"proxyuser" description:"Username for proxy server"`
 	ProxyPass                       string        `long:"proxypass" default-mask:"-" description:"Password for proxy server"`
-	DbType                          string        `long:"dbtype" description:"Database backend to use for the Block DAG"`
+	DbType                          string        `long:"dbtype" description:"Database backend to use for the Block DAG {leveldb, memory}"`
 	Profile                         string        `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	MetricsListen                   string        `long:"metricslisten" description:"Enable the Prometheus metrics endpoint at /metrics on the given interface/port (e.g. 127.0.0.1:9101)"`
 	LogLevel                        string        `short:"d" long:"loglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
 	Upnp                            bool          `long:"upnp" description:"Use UPnP to map our listening port outside of NAT"`
 	MinRelayTxFee                   float64       `long:"minrelaytxfee" description:"The minimum transaction fee in KAS/kB to be considered a non-zero fee."`
 	MaxOrphanTxs                    uint64        `long:"maxorphantx" description:"Max number of orphan transactions to keep in memory"`
+	MaxMempoolMass                  uint64        `long:"maxmempoolmass" description:"Max total mass of the transactions to keep in the mempool. Transactions with the lowest fee rates are evicted once it is exceeded"`
+	NoPersistMempool                bool          `long:"nopersistmempool" description:"Do not save the mempool on shutdown and load it back on startup"`
 	BlockMaxMass                    uint64        `long:"blockmaxmass" description:"Maximum transaction mass to be used when creating a block"`
 	UserAgentComments               []string      `long:"uacomment" description:"Comment to add to the user agent -- See BIP 14 for more information."`
 	NoPeerBloomFilters              bool          `long:"nopeerbloomfilters" description:"Disable bloom filtering support"`
@@ -120,9 +146,19 @@ type Flags struct {
 	RelayNonStd                     bool          `long:"relaynonstd" description:"Relay non-standard transactions regardless of the default settings for the active network."`
 	RejectNonStd                    bool          `long:"rejectnonstd" description:"Reject non-standard transactions regardless of the default settings for the active network."`
 	ResetDatabase                   bool          `long:"reset-db" description:"Reset database before starting node. It's needed when switching between subnetworks."`
+	ExportSnapshot                  string        `long:"exportsnapshot" description:"Export a snapshot of the consensus state into the given file and exit"`
+	ImportSnapshot                  string        `long:"importsnapshot" description:"Import a snapshot of the consensus state from the given file, validating it the same way as IBD does, and exit"`
+	VerifyDB                        bool          `long:"verify-db" description:"Verify the consistency of the consensus database, write a report and exit"`
+	VerifyDBReport                  string        `long:"verify-db-report" description:"Write the report of verify-db as JSON into the given file instead of the standard output"`
+	VerifyDBRepair                  bool          `long:"verify-db-repair" description:"Have verify-db rewrite inconsistent GHOSTDAG data. No other data is repaired, including data that was derived from the inconsistent GHOSTDAG data"`
 	MaxUTXOCacheSize                uint64        `long:"maxutxocachesize" description:"Max size of loaded UTXO into ram from the disk in bytes"`
 	UTXOIndex                       bool          `long:"utxoindex" description:"Enable the UTXO index"`
+	TXIndex                         bool          `long:"txindex" description:"Enable the transaction index"`
+	AddressIndex                    bool          `long:"addressindex" description:"Enable the address index, which maps addresses to the transactions that pay to or spend from them"`
+	NotificationJournalSize         uint64        `long:"notificationjournalsize" description:"Keep the given amount of the latest chain and UTXO notifications on disk, so that RPC clients can resume their notifications after reconnecting (0 to disable)"`
 	IsArchivalNode                  bool          `long:"archival" description:"Run as an archival node: don't delete old block data when moving the pruning point (Warning: heavy disk usage)'"`
+	RetentionPruningPeriods         uint64        `long:"retention-pruning-periods" description:"Keep the block data of the given amount of the latest pruning periods, and delete older block data in the background (0 to disable)"`
+	RetentionDAAScoreWindow         uint64        `long:"retention-daa-score-window" description:"Keep the block data of at least the given DAA score window below the virtual, and delete older block data in the background (0 to disable)"`
 	AllowSubmitBlockWhenNotSynced   bool          `long:"allow-submit-block-when-not-synced" hidden:"true" description:"Allow the node to accept blocks from RPC while not synced (this flag is mainly used for testing)"`
 	EnableSanityCheckPruningUTXOSet bool          `long:"enable-sanity-check-pruning-utxo" hidden:"true" description:"When moving the pruning point - check that the utxo set matches the utxo commitment"`
 	ProtocolVersion                 uint32        `long:"protocol-version" description:"Use non default p2p protocol version"`
@@ -141,6 +177,11 @@ type Config struct {
 	MinRelayTxFee util.Amount
 	Whitelists    []*net.IPNet
 	SubnetworkID  *externalapi.DomainSubnetworkID // nil in full nodes
+
+	RPCAuthenticator *rpcauth.Authenticator
+
+	// StratumPayAddr is the decoded --stratumpayaddress, or nil if it's not set
+	StratumPayAddr util.Address
 }
 
 // ServiceOptions defines the configuration options for the daemon as a service on
@@ -188,11 +229,14 @@ func defaultFlags() *Flags {
 		RPCCert:              defaultRPCCertFile,
 		BlockMaxMass:         defaultBlockMaxMass,
 		MaxOrphanTxs:         defaultMaxOrphanTransactions,
+		MaxMempoolMass:       defaultMaxMempoolMass,
 		SigCacheMaxSize:      defaultSigCacheMaxSize,
 		MinRelayTxFee:        defaultMinRelayTxFee,
 		MaxUTXOCacheSize:     defaultMaxUTXOCacheSize,
 		ServiceOptions:       &ServiceOptions{},
 		ProtocolVersion:      defaultProtocolVersion,
+		DbType:               defaultDbType,
+		StratumDifficulty:    defaultStratumDifficulty,
 	}
 }
 
@@ -203,6 +247,11 @@ func DefaultConfig() *Config {
 	return config
 }
 
+// MempoolFile returns the path of the file the mempool is saved into
+func (cfg *Config) MempoolFile() string {
+	return filepath.Join(cfg.AppDir, mempoolFilename)
+}
+
 // LoadConfig initializes and parses the config using a config file and command
 // line options.
 //
@@ -364,6 +413,68 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	// Exporting and importing a snapshot are mutually exclusive
+	if cfg.ExportSnapshot != "" && cfg.ImportSnapshot != "" {
+		str := "%s: exportsnapshot and importsnapshot cannot be used together"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// The verify-db options only make sense along with verify-db, which is a command of its own
+	if (cfg.VerifyDBReport != "" || cfg.VerifyDBRepair) && !cfg.VerifyDB {
+		str := "%s: verify-db-report and verify-db-repair can only be used together with verify-db"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+	if cfg.VerifyDB && (cfg.ExportSnapshot != "" || cfg.ImportSnapshot != "") {
+		str := "%s: verify-db cannot be used together with exportsnapshot or importsnapshot"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// Block data retention is a middle ground between an archival node and a pruned node
+	if cfg.RetentionPruningPeriods > 0 && cfg.RetentionDAAScoreWindow > 0 {
+		str := "%s: retention-pruning-periods and retention-daa-score-window cannot be used together"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+	if cfg.IsArchivalNode && (cfg.RetentionPruningPeriods > 0 || cfg.RetentionDAAScoreWindow > 0) {
+		str := "%s: archival cannot be used together with a block data retention option"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// Validate the database type
+	if cfg.DbType != DbTypeLevelDB && cfg.DbType != DbTypeMemory {
+		str := "%s: The dbtype option must be either %s or %s -- parsed [%s]"
+		err := errors.Errorf(str, funcName, DbTypeLevelDB, DbTypeMemory, cfg.DbType)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// Validate the metrics listen address
+	if cfg.MetricsListen != "" {
+		_, _, err := net.SplitHostPort(cfg.MetricsListen)
+		if err != nil {
+			str := "%s: The metricslisten option must be in the form of <interface>:<port> -- parsed [%s]"
+			err := errors.Errorf(str, funcName, cfg.MetricsListen)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+	}
+
 	// Don't allow ban durations that are too short.
 	if cfg.BanDuration < time.Second {
 		str := "%s: The banduration option may not be less than 1s -- parsed [%s]"
@@ -438,6 +549,70 @@ func LoadConfig() (*Config, error) {
 
 	if cfg.DisableRPC {
 		log.Infof("RPC service is disabled")
+		cfg.JSONRPCListeners = nil
+	}
+
+	// Validate the JSON-RPC listen addresses
+	for _, jsonRPCListener := range cfg.JSONRPCListeners {
+		_, _, err := net.SplitHostPort(jsonRPCListener)
+		if err != nil {
+			str := "%s: The jsonrpclisten option must be in the form of <interface>:<port> -- parsed [%s]"
+			err := errors.Errorf(str, funcName, jsonRPCListener)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+	}
+
+	// Validate the Stratum listen addresses, pay address and difficulty
+	for _, stratumListener := range cfg.StratumListeners {
+		_, _, err := net.SplitHostPort(stratumListener)
+		if err != nil {
+			str := "%s: The stratumlisten option must be in the form of <interface>:<port> -- parsed [%s]"
+			err := errors.Errorf(str, funcName, stratumListener)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+	}
+	if cfg.StratumPayAddress != "" {
+		cfg.StratumPayAddr, err = util.DecodeAddress(cfg.StratumPayAddress, cfg.ActiveNetParams.Prefix)
+		if err != nil {
+			str := "%s: The stratumpayaddress option is not a valid address for the active network: %s"
+			err := errors.Errorf(str, funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+	}
+	if cfg.StratumDifficulty <= 0 {
+		str := "%s: The stratumdifficulty option must be positive -- parsed [%g]"
+		err := errors.Errorf(str, funcName, cfg.StratumDifficulty)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// --rpcclientca requires --rpctls
+	if cfg.RPCClientCA != "" && !cfg.RPCTLS {
+		str := "%s: the --rpcclientca option requires --rpctls"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+	cfg.RPCCert = cleanAndExpandPath(cfg.RPCCert)
+	cfg.RPCKey = cleanAndExpandPath(cfg.RPCKey)
+	if cfg.RPCClientCA != "" {
+		cfg.RPCClientCA = cleanAndExpandPath(cfg.RPCClientCA)
+	}
+
+	cfg.RPCAuthenticator, err = rpcauth.NewAuthenticator(cfg.RPCUsers, cfg.RPCTokens)
+	if err != nil {
+		err := errors.Wrapf(err, "%s: invalid RPC credentials", funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
 	}
 
 	// Add the default RPC listener if none were specified. The default
@@ -490,6 +665,15 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	// The mempool must be able to hold at least a full block's worth of transactions
+	if cfg.MaxMempoolMass < blockMaxMassMax {
+		str := "%s: The maxmempoolmass option must be at least %d -- parsed [%d]"
+		err := errors.Errorf(str, funcName, blockMaxMassMax, cfg.MaxMempoolMass)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// Look for illegal characters in the user agent comments.
 	for _, uaComment := range cfg.UserAgentComments {
 		if strings.ContainsAny(uaComment, "/:()") {
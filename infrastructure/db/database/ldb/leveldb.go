@@ -48,6 +48,25 @@ func NewLevelDB(path string, cacheSizeMiB int) (*LevelDB, error) {
 	return db, nil
 }
 
+// NewReadOnlyLevelDB opens an existing leveldb instance defined by the given path,
+// such that any attempt to write into it fails. Unlike NewLevelDB, it doesn't
+// attempt to recover a corrupted database, since that would modify it.
+func NewReadOnlyLevelDB(path string, cacheSizeMiB int) (*LevelDB, error) {
+	options := Options()
+	options.BlockCacheCapacity = cacheSizeMiB * opt.MiB
+	options.ErrorIfMissing = true
+	options.ReadOnly = true
+	ldb, err := leveldb.OpenFile(path, &options)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	db := &LevelDB{
+		ldb: ldb,
+	}
+	return db, nil
+}
+
 // Compact compacts the leveldb instance.
 func (db *LevelDB) Compact() error {
 	err := db.ldb.CompactRange(util.Range{Start: nil, Limit: nil})
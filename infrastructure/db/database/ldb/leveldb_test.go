@@ -2,6 +2,7 @@ package ldb
 
 import (
 	"io/ioutil"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -151,3 +152,84 @@ func TestLevelDBTransactionSanity(t *testing.T) {
 			"returned unexpected error: %s", err)
 	}
 }
+
+func TestReadOnlyLevelDB(t *testing.T) {
+	path, err := ioutil.TempDir("", "TestReadOnlyLevelDB")
+	if err != nil {
+		t.Fatalf("TestReadOnlyLevelDB: TempDir unexpectedly "+
+			"failed: %s", err)
+	}
+
+	_, err = NewReadOnlyLevelDB(filepath.Join(path, "missing"), 8)
+	if err == nil {
+		t.Fatalf("TestReadOnlyLevelDB: NewReadOnlyLevelDB " +
+			"unexpectedly succeeded for a missing database")
+	}
+
+	// Put something into the db and reopen it as read-only
+	ldb, err := NewLevelDB(path, 8)
+	if err != nil {
+		t.Fatalf("TestReadOnlyLevelDB: NewLevelDB unexpectedly "+
+			"failed: %s", err)
+	}
+	key := database.MakeBucket(nil).Key([]byte("key"))
+	putData := []byte("Hello world!")
+	err = ldb.Put(key, putData)
+	if err != nil {
+		t.Fatalf("TestReadOnlyLevelDB: Put returned "+
+			"unexpected error: %s", err)
+	}
+	err = ldb.Close()
+	if err != nil {
+		t.Fatalf("TestReadOnlyLevelDB: Close unexpectedly "+
+			"failed: %s", err)
+	}
+
+	ldb, err = NewReadOnlyLevelDB(path, 8)
+	if err != nil {
+		t.Fatalf("TestReadOnlyLevelDB: NewReadOnlyLevelDB unexpectedly "+
+			"failed: %s", err)
+	}
+	defer func() {
+		err := ldb.Close()
+		if err != nil {
+			t.Fatalf("TestReadOnlyLevelDB: Close unexpectedly "+
+				"failed: %s", err)
+		}
+	}()
+
+	getData, err := ldb.Get(key)
+	if err != nil {
+		t.Fatalf("TestReadOnlyLevelDB: Get returned "+
+			"unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(getData, putData) {
+		t.Fatalf("TestReadOnlyLevelDB: get data and "+
+			"put data are not equal. Put: %s, got: %s",
+			string(putData), string(getData))
+	}
+
+	// Make sure that neither direct nor transactional writes go through
+	err = ldb.Put(key, []byte("Goodbye world!"))
+	if err == nil {
+		t.Fatalf("TestReadOnlyLevelDB: Put unexpectedly " +
+			"succeeded on a read-only database")
+	}
+	err = ldb.Delete(key)
+	if err == nil {
+		t.Fatalf("TestReadOnlyLevelDB: Delete unexpectedly " +
+			"succeeded on a read-only database")
+	}
+	dbTx, err := ldb.Begin()
+	if err == nil {
+		err = dbTx.Put(key, []byte("Goodbye world!"))
+		if err == nil {
+			err = dbTx.Commit()
+		}
+		if err == nil {
+			t.Fatalf("TestReadOnlyLevelDB: a transaction unexpectedly " +
+				"wrote into a read-only database")
+		}
+		_ = dbTx.RollbackUnlessClosed()
+	}
+}
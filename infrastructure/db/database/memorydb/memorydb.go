@@ -0,0 +1,155 @@
+package memorydb
+
+import (
+	"sync"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+)
+
+const (
+	// initialCapacity is the initial size of the buffer that holds the keys and values
+	initialCapacity = 4 * 1024 * 1024 // 4 MB
+
+	// minCompactionGarbageSize is the least amount of bytes taken by overwritten and
+	// deleted entries that triggers an automatic compaction
+	minCompactionGarbageSize = 64 * 1024 * 1024 // 64 MB
+)
+
+var errClosed = errors.New("database is closed")
+
+// MemoryDB is a database that keeps all of its data in memory, and
+// loses it once closed. It is meant to be used by tests that don't
+// need their data to outlive them.
+type MemoryDB struct {
+	// lock makes committing a transaction atomic for readers. Note
+	// that cursors don't take it, so they might observe the changes
+	// of a transaction that is being committed.
+	lock sync.RWMutex
+	db   *memdb.DB
+}
+
+// NewMemoryDB creates a new, empty, MemoryDB.
+func NewMemoryDB() *MemoryDB {
+	return &MemoryDB{
+		db: memdb.New(comparer.DefaultComparer, initialCapacity),
+	}
+}
+
+// Compact compacts the database instance. Overwritten and deleted entries
+// keep taking memory until the database is compacted.
+func (db *MemoryDB) Compact() error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.db == nil {
+		return errClosed
+	}
+	db.compact()
+	return nil
+}
+
+func (db *MemoryDB) compact() {
+	compacted := memdb.New(comparer.DefaultComparer, db.db.Size())
+	iterator := db.db.NewIterator(nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		// memdb.Put never fails
+		_ = compacted.Put(iterator.Key(), iterator.Value())
+	}
+	db.db = compacted
+}
+
+// compactIfRequired compacts the database once the overwritten and deleted
+// entries take more memory than both the live entries and minCompactionGarbageSize.
+// This must be called with the lock held for writing.
+func (db *MemoryDB) compactIfRequired() {
+	usedSize := db.db.Capacity() - db.db.Free()
+	garbageSize := usedSize - db.db.Size()
+	if garbageSize > minCompactionGarbageSize && garbageSize > db.db.Size() {
+		db.compact()
+	}
+}
+
+// Close closes the database and releases all of its data.
+func (db *MemoryDB) Close() error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.db == nil {
+		return errClosed
+	}
+	db.db = nil
+	return nil
+}
+
+// Put sets the value for the given key. It overwrites
+// any previous value for that key.
+func (db *MemoryDB) Put(key *database.Key, value []byte) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.db == nil {
+		return errClosed
+	}
+	return errors.WithStack(db.db.Put(key.Bytes(), value))
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (db *MemoryDB) Get(key *database.Key) ([]byte, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.db == nil {
+		return nil, errClosed
+	}
+	value, err := db.db.Get(key.Bytes())
+	if err != nil {
+		if errors.Is(err, memdb.ErrNotFound) {
+			return nil, errors.Wrapf(database.ErrNotFound,
+				"key %s not found", key)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	// The returned value must be safe for the caller to modify,
+	// same as it is in LevelDB
+	valueCopy := make([]byte, len(value))
+	copy(valueCopy, value)
+	return valueCopy, nil
+}
+
+// Has returns true if the database does contains the
+// given key.
+func (db *MemoryDB) Has(key *database.Key) (bool, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.db == nil {
+		return false, errClosed
+	}
+	return db.db.Contains(key.Bytes()), nil
+}
+
+// Delete deletes the value for the given key. Will not
+// return an error if the key doesn't exist.
+func (db *MemoryDB) Delete(key *database.Key) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.db == nil {
+		return errClosed
+	}
+	return db.delete(key.Bytes())
+}
+
+func (db *MemoryDB) delete(key []byte) error {
+	err := db.db.Delete(key)
+	if err != nil && !errors.Is(err, memdb.ErrNotFound) {
+		return errors.WithStack(err)
+	}
+	return nil
+}
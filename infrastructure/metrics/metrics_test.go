@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	registry := NewRegistry()
+
+	counter := registry.NewCounter("test_counter_total", "A counter")
+	counter.Inc()
+	counter.Add(2)
+
+	gaugeVec := registry.NewGaugeVec("test_gauge", "A gauge\nwith a newline", "kind")
+	gaugeVec.WithLabelValue(`b"c`).Set(-1.5)
+	gaugeVec.WithLabelValue("a").Set(2)
+
+	registry.NewGaugeFunc("test_gauge_func", "A gauge func", func() float64 { return 7 })
+
+	histogramVec := registry.NewHistogramVec("test_histogram_seconds", "A histogram", "method", []float64{1, 0.1})
+	histogram := histogramVec.WithLabelValue("get")
+	histogram.Observe(0.05)
+	histogram.Observe(0.1)
+	histogram.Observe(0.5)
+	histogram.Observe(3)
+
+	builder := &strings.Builder{}
+	err := Write(builder, registry)
+	if err != nil {
+		t.Fatalf("Write: %s", err)
+	}
+
+	expected := `# HELP test_counter_total A counter
+# TYPE test_counter_total counter
+test_counter_total 3
+# HELP test_gauge A gauge\nwith a newline
+# TYPE test_gauge gauge
+test_gauge{kind="a"} 2
+test_gauge{kind="b\"c"} -1.5
+# HELP test_gauge_func A gauge func
+# TYPE test_gauge_func gauge
+test_gauge_func 7
+# HELP test_histogram_seconds A histogram
+# TYPE test_histogram_seconds histogram
+test_histogram_seconds_bucket{method="get",le="0.1"} 2
+test_histogram_seconds_bucket{method="get",le="1"} 3
+test_histogram_seconds_bucket{method="get",le="+Inf"} 4
+test_histogram_seconds_sum{method="get"} 3.65
+test_histogram_seconds_count{method="get"} 4
+`
+	if builder.String() != expected {
+		t.Fatalf("Unexpected output. Want:\n%s\nGot:\n%s", expected, builder.String())
+	}
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	registry := NewRegistry()
+	registry.NewCounter("test_counter_total", "A counter")
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Registering a duplicate metric did not panic")
+		}
+	}()
+	registry.NewGauge("test_counter_total", "A gauge")
+}
@@ -0,0 +1,190 @@
+// Package metrics implements a minimal set of metric types which can be
+// exported in the Prometheus text exposition format, without depending on
+// the Prometheus client library.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Metric is a single metric family that can be written in the
+// Prometheus text exposition format
+type Metric interface {
+	Name() string
+	writeSamples(writer io.Writer) error
+	help() string
+	metricType() string
+}
+
+// Registry is a set of metrics that are exported together
+type Registry struct {
+	metrics map[string]Metric
+	mutex   sync.RWMutex
+}
+
+// NewRegistry creates a new empty Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		metrics: make(map[string]Metric),
+	}
+}
+
+// DefaultRegistry is the registry process-wide metrics are registered in
+var DefaultRegistry = NewRegistry()
+
+// Register adds the given metric to the registry.
+// It panics if a metric with the same name is already registered.
+func (r *Registry) Register(metric Metric) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.metrics[metric.Name()]; ok {
+		panic(fmt.Sprintf("metric %s is already registered", metric.Name()))
+	}
+	r.metrics[metric.Name()] = metric
+}
+
+// NewCounter creates a new Counter and registers it in the registry
+func (r *Registry) NewCounter(name string, help string) *Counter {
+	counter := &Counter{name: name, helpText: help}
+	r.Register(counter)
+	return counter
+}
+
+// NewGauge creates a new Gauge and registers it in the registry
+func (r *Registry) NewGauge(name string, help string) *Gauge {
+	gauge := &Gauge{name: name, helpText: help}
+	r.Register(gauge)
+	return gauge
+}
+
+// NewGaugeVec creates a new GaugeVec and registers it in the registry
+func (r *Registry) NewGaugeVec(name string, help string, labelName string) *GaugeVec {
+	gaugeVec := &GaugeVec{name: name, helpText: help, labelName: labelName, gauges: make(map[string]*Gauge)}
+	r.Register(gaugeVec)
+	return gaugeVec
+}
+
+// NewGaugeFunc creates a new GaugeFunc and registers it in the registry
+func (r *Registry) NewGaugeFunc(name string, help string, valueFunc func() float64) *GaugeFunc {
+	gaugeFunc := &GaugeFunc{name: name, helpText: help, valueFunc: valueFunc}
+	r.Register(gaugeFunc)
+	return gaugeFunc
+}
+
+// NewHistogram creates a new Histogram and registers it in the registry
+func (r *Registry) NewHistogram(name string, help string, buckets []float64) *Histogram {
+	histogram := newHistogram(name, help, buckets)
+	r.Register(histogram)
+	return histogram
+}
+
+// NewHistogramVec creates a new HistogramVec and registers it in the registry
+func (r *Registry) NewHistogramVec(name string, help string, labelName string, buckets []float64) *HistogramVec {
+	histogramVec := &HistogramVec{
+		name:       name,
+		helpText:   help,
+		labelName:  labelName,
+		buckets:    buckets,
+		histograms: make(map[string]*Histogram),
+	}
+	r.Register(histogramVec)
+	return histogramVec
+}
+
+// NewCounter creates a new Counter and registers it in DefaultRegistry
+func NewCounter(name string, help string) *Counter {
+	return DefaultRegistry.NewCounter(name, help)
+}
+
+// NewGauge creates a new Gauge and registers it in DefaultRegistry
+func NewGauge(name string, help string) *Gauge {
+	return DefaultRegistry.NewGauge(name, help)
+}
+
+// NewGaugeVec creates a new GaugeVec and registers it in DefaultRegistry
+func NewGaugeVec(name string, help string, labelName string) *GaugeVec {
+	return DefaultRegistry.NewGaugeVec(name, help, labelName)
+}
+
+// NewHistogram creates a new Histogram and registers it in DefaultRegistry
+func NewHistogram(name string, help string, buckets []float64) *Histogram {
+	return DefaultRegistry.NewHistogram(name, help, buckets)
+}
+
+// NewHistogramVec creates a new HistogramVec and registers it in DefaultRegistry
+func NewHistogramVec(name string, help string, labelName string, buckets []float64) *HistogramVec {
+	return DefaultRegistry.NewHistogramVec(name, help, labelName, buckets)
+}
+
+// Write writes all the metrics in the given registries, ordered by name,
+// in the Prometheus text exposition format
+func Write(writer io.Writer, registries ...*Registry) error {
+	var metrics []Metric
+	for _, registry := range registries {
+		registry.mutex.RLock()
+		for _, metric := range registry.metrics {
+			metrics = append(metrics, metric)
+		}
+		registry.mutex.RUnlock()
+	}
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].Name() < metrics[j].Name()
+	})
+
+	bufferedWriter := bufio.NewWriter(writer)
+	for _, metric := range metrics {
+		_, err := fmt.Fprintf(bufferedWriter, "# HELP %s %s\n# TYPE %s %s\n",
+			metric.Name(), escapeHelp(metric.help()), metric.Name(), metric.metricType())
+		if err != nil {
+			return err
+		}
+		err = metric.writeSamples(bufferedWriter)
+		if err != nil {
+			return err
+		}
+	}
+	return bufferedWriter.Flush()
+}
+
+func writeSample(writer io.Writer, name string, labels string, value float64) error {
+	if labels != "" {
+		labels = "{" + labels + "}"
+	}
+	_, err := fmt.Fprintf(writer, "%s%s %s\n", name, labels, formatFloat(value))
+	return err
+}
+
+func formatLabel(name string, value string) string {
+	return name + `="` + escapeLabelValue(value) + `"`
+}
+
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+var helpReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func escapeHelp(help string) string {
+	return helpReplacer.Replace(help)
+}
+
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/util/panics"
+)
+
+// contentType is the content type of the Prometheus text exposition format
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Server serves the metrics of a set of registries over HTTP at /metrics
+type Server struct {
+	listenAddress string
+	httpServer    *http.Server
+	log           *logger.Logger
+}
+
+// NewServer creates a new metrics Server listening on the given address. The metrics
+// of DefaultRegistry are always served, alongside those of the given registries.
+func NewServer(listenAddress string, log *logger.Logger, registries ...*Registry) *Server {
+	allRegistries := append([]*Registry{DefaultRegistry}, registries...)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", contentType)
+		err := Write(writer, allRegistries...)
+		if err != nil {
+			log.Warnf("Error writing metrics to %s: %s", request.RemoteAddr, err)
+		}
+	})
+
+	return &Server{
+		listenAddress: listenAddress,
+		httpServer: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		log: log,
+	}
+}
+
+// Start starts listening on the server's address and serving metrics
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.listenAddress)
+	if err != nil {
+		return err
+	}
+	s.log.Infof("Metrics server listening on %s", listener.Addr())
+
+	spawn := panics.GoroutineWrapperFunc(s.log)
+	spawn("metrics.Server.Start", func() {
+		err := s.httpServer.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			s.log.Errorf("Metrics server stopped: %s", err)
+		}
+	})
+	return nil
+}
+
+// Stop stops the server
+func (s *Server) Stop() error {
+	return s.httpServer.Close()
+}
@@ -0,0 +1,324 @@
+package metrics
+
+import (
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultLatencyBuckets are histogram buckets, in seconds, suitable for
+// measuring the latency of operations ranging from a millisecond to several seconds
+var DefaultLatencyBuckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Counter is a metric whose value only ever goes up
+type Counter struct {
+	name     string
+	helpText string
+	value    uint64
+}
+
+// Inc increments the counter by one
+func (c *Counter) Inc() {
+	atomic.AddUint64(&c.value, 1)
+}
+
+// Add increments the counter by the given delta
+func (c *Counter) Add(delta uint64) {
+	atomic.AddUint64(&c.value, delta)
+}
+
+// Value returns the current value of the counter
+func (c *Counter) Value() uint64 {
+	return atomic.LoadUint64(&c.value)
+}
+
+// Name returns the name of the metric
+func (c *Counter) Name() string {
+	return c.name
+}
+
+func (c *Counter) help() string {
+	return c.helpText
+}
+
+func (c *Counter) metricType() string {
+	return "counter"
+}
+
+func (c *Counter) writeSamples(writer io.Writer) error {
+	return writeSample(writer, c.name, "", float64(c.Value()))
+}
+
+// Gauge is a metric whose value may arbitrarily go up and down
+type Gauge struct {
+	name      string
+	helpText  string
+	valueBits uint64
+}
+
+// Set sets the value of the gauge
+func (g *Gauge) Set(value float64) {
+	atomic.StoreUint64(&g.valueBits, math.Float64bits(value))
+}
+
+// Value returns the current value of the gauge
+func (g *Gauge) Value() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&g.valueBits))
+}
+
+// Name returns the name of the metric
+func (g *Gauge) Name() string {
+	return g.name
+}
+
+func (g *Gauge) help() string {
+	return g.helpText
+}
+
+func (g *Gauge) metricType() string {
+	return "gauge"
+}
+
+func (g *Gauge) writeSamples(writer io.Writer) error {
+	return writeSample(writer, g.name, "", g.Value())
+}
+
+// GaugeVec is a set of gauges partitioned by the value of a single label
+type GaugeVec struct {
+	name      string
+	helpText  string
+	labelName string
+	gauges    map[string]*Gauge
+	mutex     sync.RWMutex
+}
+
+// WithLabelValue returns the gauge for the given label value, creating it if required
+func (gv *GaugeVec) WithLabelValue(labelValue string) *Gauge {
+	gv.mutex.RLock()
+	gauge, ok := gv.gauges[labelValue]
+	gv.mutex.RUnlock()
+	if ok {
+		return gauge
+	}
+
+	gv.mutex.Lock()
+	defer gv.mutex.Unlock()
+	gauge, ok = gv.gauges[labelValue]
+	if !ok {
+		gauge = &Gauge{name: gv.name}
+		gv.gauges[labelValue] = gauge
+	}
+	return gauge
+}
+
+// Name returns the name of the metric
+func (gv *GaugeVec) Name() string {
+	return gv.name
+}
+
+func (gv *GaugeVec) help() string {
+	return gv.helpText
+}
+
+func (gv *GaugeVec) metricType() string {
+	return "gauge"
+}
+
+func (gv *GaugeVec) writeSamples(writer io.Writer) error {
+	gv.mutex.RLock()
+	defer gv.mutex.RUnlock()
+
+	labelValues := make([]string, 0, len(gv.gauges))
+	for labelValue := range gv.gauges {
+		labelValues = append(labelValues, labelValue)
+	}
+	sort.Strings(labelValues)
+
+	for _, labelValue := range labelValues {
+		err := writeSample(writer, gv.name, formatLabel(gv.labelName, labelValue), gv.gauges[labelValue].Value())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GaugeFunc is a gauge whose value is computed by calling a function whenever it is exported
+type GaugeFunc struct {
+	name      string
+	helpText  string
+	valueFunc func() float64
+}
+
+// Name returns the name of the metric
+func (gf *GaugeFunc) Name() string {
+	return gf.name
+}
+
+func (gf *GaugeFunc) help() string {
+	return gf.helpText
+}
+
+func (gf *GaugeFunc) metricType() string {
+	return "gauge"
+}
+
+func (gf *GaugeFunc) writeSamples(writer io.Writer) error {
+	return writeSample(writer, gf.name, "", gf.valueFunc())
+}
+
+// Histogram counts observations in configurable buckets, and keeps track
+// of their count and sum
+type Histogram struct {
+	name     string
+	helpText string
+
+	// buckets are the upper bounds of the buckets, in increasing order
+	buckets      []float64
+	bucketCounts []uint64
+	count        uint64
+	sum          float64
+	mutex        sync.Mutex
+}
+
+func newHistogram(name string, help string, buckets []float64) *Histogram {
+	sortedBuckets := make([]float64, len(buckets))
+	copy(sortedBuckets, buckets)
+	sort.Float64s(sortedBuckets)
+
+	return &Histogram{
+		name:         name,
+		helpText:     help,
+		buckets:      sortedBuckets,
+		bucketCounts: make([]uint64, len(sortedBuckets)),
+	}
+}
+
+// Observe adds a single observation to the histogram
+func (h *Histogram) Observe(value float64) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	// Buckets are cumulative, so only the first one containing the value is
+	// incremented here, and the counts are accumulated when written
+	index := sort.SearchFloat64s(h.buckets, value)
+	if index < len(h.buckets) {
+		h.bucketCounts[index]++
+	}
+	h.count++
+	h.sum += value
+}
+
+// ObserveDuration adds the time elapsed since the given start time, in seconds, to the histogram
+func (h *Histogram) ObserveDuration(start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+// Name returns the name of the metric
+func (h *Histogram) Name() string {
+	return h.name
+}
+
+func (h *Histogram) help() string {
+	return h.helpText
+}
+
+func (h *Histogram) metricType() string {
+	return "histogram"
+}
+
+func (h *Histogram) writeSamples(writer io.Writer) error {
+	return h.writeSamplesWithLabels(writer, "")
+}
+
+func (h *Histogram) writeSamplesWithLabels(writer io.Writer, labels string) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	labelsPrefix := ""
+	if labels != "" {
+		labelsPrefix = labels + ","
+	}
+	cumulativeCount := uint64(0)
+	for i, upperBound := range h.buckets {
+		cumulativeCount += h.bucketCounts[i]
+		err := writeSample(writer, h.name+"_bucket", labelsPrefix+formatLabel("le", formatFloat(upperBound)),
+			float64(cumulativeCount))
+		if err != nil {
+			return err
+		}
+	}
+	err := writeSample(writer, h.name+"_bucket", labelsPrefix+formatLabel("le", "+Inf"), float64(h.count))
+	if err != nil {
+		return err
+	}
+	err = writeSample(writer, h.name+"_sum", labels, h.sum)
+	if err != nil {
+		return err
+	}
+	return writeSample(writer, h.name+"_count", labels, float64(h.count))
+}
+
+// HistogramVec is a set of histograms partitioned by the value of a single label
+type HistogramVec struct {
+	name       string
+	helpText   string
+	labelName  string
+	buckets    []float64
+	histograms map[string]*Histogram
+	mutex      sync.RWMutex
+}
+
+// WithLabelValue returns the histogram for the given label value, creating it if required
+func (hv *HistogramVec) WithLabelValue(labelValue string) *Histogram {
+	hv.mutex.RLock()
+	histogram, ok := hv.histograms[labelValue]
+	hv.mutex.RUnlock()
+	if ok {
+		return histogram
+	}
+
+	hv.mutex.Lock()
+	defer hv.mutex.Unlock()
+	histogram, ok = hv.histograms[labelValue]
+	if !ok {
+		histogram = newHistogram(hv.name, hv.helpText, hv.buckets)
+		hv.histograms[labelValue] = histogram
+	}
+	return histogram
+}
+
+// Name returns the name of the metric
+func (hv *HistogramVec) Name() string {
+	return hv.name
+}
+
+func (hv *HistogramVec) help() string {
+	return hv.helpText
+}
+
+func (hv *HistogramVec) metricType() string {
+	return "histogram"
+}
+
+func (hv *HistogramVec) writeSamples(writer io.Writer) error {
+	hv.mutex.RLock()
+	defer hv.mutex.RUnlock()
+
+	labelValues := make([]string, 0, len(hv.histograms))
+	for labelValue := range hv.histograms {
+		labelValues = append(labelValues, labelValue)
+	}
+	sort.Strings(labelValues)
+
+	for _, labelValue := range labelValues {
+		err := hv.histograms[labelValue].writeSamplesWithLabels(writer, formatLabel(hv.labelName, labelValue))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -133,6 +133,18 @@ func (c *ConnectionManager) ConnectionCount() int {
 	return c.netAdapter.P2PConnectionCount()
 }
 
+// PeerCounts returns the counts of the connected inbound and outbound peers
+func (c *ConnectionManager) PeerCounts() (inbound int, outbound int) {
+	for _, connection := range c.netAdapter.P2PConnections() {
+		if connection.IsOutbound() {
+			outbound++
+		} else {
+			inbound++
+		}
+	}
+	return inbound, outbound
+}
+
 // ErrCannotBanPermanent is the error returned when trying to ban a permanent peer.
 var ErrCannotBanPermanent = errors.New("ErrCannotBanPermanent")
 
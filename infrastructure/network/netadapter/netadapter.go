@@ -1,6 +1,8 @@
 package netadapter
 
 import (
+	"crypto/tls"
+	"net"
 	"sync"
 	"sync/atomic"
 
@@ -10,6 +12,8 @@ import (
 	routerpkg "github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/jsonrpcserver"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpctls"
 	"github.com/pkg/errors"
 )
 
@@ -28,6 +32,7 @@ type NetAdapter struct {
 	p2pServer            server.P2PServer
 	p2pRouterInitializer RouterInitializer
 	rpcServer            server.Server
+	jsonRPCServer        server.Server
 	rpcRouterInitializer RouterInitializer
 	stop                 uint32
 
@@ -46,7 +51,14 @@ func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
 	if err != nil {
 		return nil, err
 	}
-	rpcServer, err := grpcserver.NewRPCServer(cfg.RPCListeners, cfg.RPCMaxClients)
+	var rpcTLSConfig *tls.Config
+	if cfg.RPCTLS {
+		rpcTLSConfig, err = rpctls.ServerConfig(cfg.RPCCert, cfg.RPCKey, cfg.RPCClientCA, rpcTLSExtraHosts(cfg))
+		if err != nil {
+			return nil, err
+		}
+	}
+	rpcServer, err := grpcserver.NewRPCServer(cfg.RPCListeners, cfg.RPCMaxClients, cfg.RPCAuthenticator, rpcTLSConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -62,9 +74,36 @@ func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
 	adapter.p2pServer.SetOnConnectedHandler(adapter.onP2PConnectedHandler)
 	adapter.rpcServer.SetOnConnectedHandler(adapter.onRPCConnectedHandler)
 
+	if len(cfg.JSONRPCListeners) > 0 {
+		adapter.jsonRPCServer = jsonrpcserver.NewJSONRPCServer(cfg.JSONRPCListeners, cfg.JSONRPCAllowedOrigins,
+			cfg.RPCMaxWebsockets, cfg.RPCAuthenticator, rpcTLSConfig)
+		adapter.jsonRPCServer.SetOnConnectedHandler(adapter.onRPCConnectedHandler)
+	}
+
 	return &adapter, nil
 }
 
+// rpcTLSExtraHosts returns the hosts, other than the local addresses
+// of the machine, that a generated RPC certificate should be valid for
+func rpcTLSExtraHosts(cfg *config.Config) []string {
+	addresses := make([]string, 0, len(cfg.RPCListeners)+len(cfg.JSONRPCListeners)+len(cfg.ExternalIPs))
+	addresses = append(addresses, cfg.RPCListeners...)
+	addresses = append(addresses, cfg.JSONRPCListeners...)
+	addresses = append(addresses, cfg.ExternalIPs...)
+
+	extraHosts := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			host = address
+		}
+		if host != "" {
+			extraHosts = append(extraHosts, host)
+		}
+	}
+	return extraHosts
+}
+
 // Start begins the operation of the NetAdapter
 func (na *NetAdapter) Start() error {
 	if na.p2pRouterInitializer == nil {
@@ -82,6 +121,12 @@ func (na *NetAdapter) Start() error {
 	if err != nil {
 		return err
 	}
+	if na.jsonRPCServer != nil {
+		err = na.jsonRPCServer.Start()
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -95,6 +140,12 @@ func (na *NetAdapter) Stop() error {
 	if err != nil {
 		return err
 	}
+	if na.jsonRPCServer != nil {
+		err = na.jsonRPCServer.Stop()
+		if err != nil {
+			return err
+		}
+	}
 	return na.rpcServer.Stop()
 }
 
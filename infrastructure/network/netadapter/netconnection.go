@@ -9,6 +9,7 @@ import (
 
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/id"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
 )
 
 // NetConnection is a wrapper to a server connection for use by services external to NetAdapter
@@ -70,6 +71,11 @@ func (c *NetConnection) Address() string {
 	return c.connection.Address().String()
 }
 
+// RPCRoles returns the roles granted to the RPC client on the other side of this connection
+func (c *NetConnection) RPCRoles() rpcauth.Roles {
+	return c.connection.RPCRoles()
+}
+
 // IsOutbound returns whether the connection is outbound
 func (c *NetConnection) IsOutbound() bool {
 	return c.connection.IsOutbound()
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,6 +23,10 @@ type gRPCConnection struct {
 	router                   *router.Router
 	lowLevelClientConnection *grpc.ClientConn
 
+	// rpcRoles are the roles granted to an authenticated RPC client.
+	// It is always empty for P2P connections.
+	rpcRoles rpcauth.Roles
+
 	// streamLock protects concurrent access to stream.
 	// Note that it's an RWMutex. Despite what the name
 	// implies, we use it to RLock() send() and receive() because
@@ -42,7 +47,7 @@ type grpcStream interface {
 }
 
 func newConnection(server *gRPCServer, address *net.TCPAddr, stream grpcStream,
-	lowLevelClientConnection *grpc.ClientConn) *gRPCConnection {
+	lowLevelClientConnection *grpc.ClientConn, rpcRoles rpcauth.Roles) *gRPCConnection {
 	connection := &gRPCConnection{
 		server:                   server,
 		address:                  address,
@@ -50,6 +55,7 @@ func newConnection(server *gRPCServer, address *net.TCPAddr, stream grpcStream,
 		stopChan:                 make(chan struct{}),
 		isConnected:              1,
 		lowLevelClientConnection: lowLevelClientConnection,
+		rpcRoles:                 rpcRoles,
 	}
 
 	return connection
@@ -127,6 +133,13 @@ func (c *gRPCConnection) Address() *net.TCPAddr {
 	return c.address
 }
 
+// RPCRoles returns the roles granted to the RPC client on the other side of this connection
+//
+// This is part of the Connection interface
+func (c *gRPCConnection) RPCRoles() rpcauth.Roles {
+	return c.rpcRoles
+}
+
 func (c *gRPCConnection) receive() (*protowire.KaspadMessage, error) {
 	// We use RLock here and in send() because they can work
 	// in parallel. closeSend(), however, must not have either
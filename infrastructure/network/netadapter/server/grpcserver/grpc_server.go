@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
 	"github.com/kaspanet/kaspad/util/panics"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -25,10 +26,14 @@ type gRPCServer struct {
 }
 
 // newGRPCServer creates a gRPC server
-func newGRPCServer(listeningAddresses []string, maxMessageSize int, maxInboundConnections int, name string) *gRPCServer {
+func newGRPCServer(listeningAddresses []string, maxMessageSize int, maxInboundConnections int, name string,
+	extraServerOptions ...grpc.ServerOption) *gRPCServer {
+
 	log.Debugf("Created new %s GRPC server with maxMessageSize %d and maxInboundConnections %d", name, maxMessageSize, maxInboundConnections)
+	serverOptions := append([]grpc.ServerOption{grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize)},
+		extraServerOptions...)
 	return &gRPCServer{
-		server:                     grpc.NewServer(grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize)),
+		server:                     grpc.NewServer(serverOptions...),
 		listeningAddresses:         listeningAddresses,
 		name:                       name,
 		maxInboundConnections:      maxInboundConnections,
@@ -93,7 +98,7 @@ func (s *gRPCServer) SetOnConnectedHandler(onConnectedHandler server.OnConnected
 	s.onConnectedHandler = onConnectedHandler
 }
 
-func (s *gRPCServer) handleInboundConnection(ctx context.Context, stream grpcStream) error {
+func (s *gRPCServer) handleInboundConnection(ctx context.Context, stream grpcStream, rpcRoles rpcauth.Roles) error {
 	connectionCount, err := s.incrementInboundConnectionCountAndLimitIfRequired()
 	if err != nil {
 		return err
@@ -109,7 +114,7 @@ func (s *gRPCServer) handleInboundConnection(ctx context.Context, stream grpcStr
 		return errors.Errorf("non-tcp connections are not supported")
 	}
 
-	connection := newConnection(s, tcpAddress, stream, nil)
+	connection := newConnection(s, tcpAddress, stream, nil, rpcRoles)
 
 	err = s.onConnectedHandler(connection)
 	if err != nil {
@@ -37,7 +37,7 @@ func NewP2PServer(listeningAddresses []string) (server.P2PServer, error) {
 func (p *p2pServer) MessageStream(stream protowire.P2P_MessageStreamServer) error {
 	defer panics.HandlePanic(log, "p2pServer.MessageStream", nil)
 
-	return p.handleInboundConnection(stream.Context(), stream)
+	return p.handleInboundConnection(stream.Context(), stream, 0)
 }
 
 // Connect connects to the given address
@@ -70,7 +70,7 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 		return nil, errors.Errorf("non-tcp addresses are not supported")
 	}
 
-	connection := newConnection(&p.gRPCServer, tcpAddress, stream, gRPCClientConnection)
+	connection := newConnection(&p.gRPCServer, tcpAddress, stream, gRPCClientConnection, 0)
 
 	err = p.onConnectedHandler(connection)
 	if err != nil {
@@ -0,0 +1,46 @@
+package protowire
+
+import (
+	"strings"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	requestFieldSuffix  = "Request"
+	responseFieldSuffix = "Response"
+	errorFieldName      = "error"
+)
+
+// NewRPCErrorResponse creates the response message of the given RPC request,
+// with only its error set to the given message. This allows responding with
+// an error to any request without knowing its concrete type.
+func NewRPCErrorResponse(request appmessage.Message, errorMessage string) (appmessage.Message, error) {
+	requestMessage, err := FromAppMessage(request)
+	if err != nil {
+		return nil, err
+	}
+	payloadOneof := requestMessage.ProtoReflect().Descriptor().Oneofs().ByName("payload")
+	requestField := requestMessage.ProtoReflect().WhichOneof(payloadOneof)
+	if requestField == nil || !strings.HasSuffix(string(requestField.Name()), requestFieldSuffix) {
+		return nil, errors.Errorf("%s is not an RPC request", request.Command())
+	}
+
+	responseFieldName := strings.TrimSuffix(string(requestField.Name()), requestFieldSuffix) + responseFieldSuffix
+	responseField := payloadOneof.Fields().ByName(protoreflect.Name(responseFieldName))
+	if responseField == nil {
+		return nil, errors.Errorf("%s has no response message", request.Command())
+	}
+	response := requestMessage.ProtoReflect().NewField(responseField).Message()
+	errorField := response.Descriptor().Fields().ByName(errorFieldName)
+	if errorField == nil {
+		return nil, errors.Errorf("the response of %s has no error field", request.Command())
+	}
+	response.Set(errorField, protoreflect.ValueOfMessage((&RPCError{Message: errorMessage}).ProtoReflect()))
+
+	responseMessage := &KaspadMessage{}
+	responseMessage.ProtoReflect().Set(responseField, protoreflect.ValueOfMessage(response))
+	return responseMessage.ToAppMessage()
+}
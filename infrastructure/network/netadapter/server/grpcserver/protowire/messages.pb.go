@@ -156,6 +156,26 @@ type KaspadMessage struct {
 	//	*KaspadMessage_GetMempoolEntriesByAddressesResponse
 	//	*KaspadMessage_GetCoinSupplyRequest
 	//	*KaspadMessage_GetCoinSupplyResponse
+	//	*KaspadMessage_GetTransactionRequest
+	//	*KaspadMessage_GetTransactionResponse
+	//	*KaspadMessage_GetTransactionAcceptanceInfoRequest
+	//	*KaspadMessage_GetTransactionAcceptanceInfoResponse
+	//	*KaspadMessage_SubmitTransactionReplacementRequest
+	//	*KaspadMessage_SubmitTransactionReplacementResponse
+	//	*KaspadMessage_GetFeeEstimateRequest
+	//	*KaspadMessage_GetFeeEstimateResponse
+	//	*KaspadMessage_SaveMempoolRequest
+	//	*KaspadMessage_SaveMempoolResponse
+	//	*KaspadMessage_LoadMempoolRequest
+	//	*KaspadMessage_LoadMempoolResponse
+	//	*KaspadMessage_NotifyMempoolChangedRequest
+	//	*KaspadMessage_NotifyMempoolChangedResponse
+	//	*KaspadMessage_MempoolChangedNotification
+	//	*KaspadMessage_GetTransactionsByAddressRequest
+	//	*KaspadMessage_GetTransactionsByAddressResponse
+	//	*KaspadMessage_StreamUtxosByAddressesRequest
+	//	*KaspadMessage_StreamUtxosByAddressesResponse
+	//	*KaspadMessage_UtxosByAddressesChunkNotification
 	Payload isKaspadMessage_Payload `protobuf_oneof:"payload"`
 }
 
@@ -1108,6 +1128,146 @@ func (x *KaspadMessage) GetGetCoinSupplyResponse() *GetCoinSupplyResponseMessage
 	return nil
 }
 
+func (x *KaspadMessage) GetGetTransactionRequest() *GetTransactionRequestMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_GetTransactionRequest); ok {
+		return x.GetTransactionRequest
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetGetTransactionResponse() *GetTransactionResponseMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_GetTransactionResponse); ok {
+		return x.GetTransactionResponse
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetGetTransactionAcceptanceInfoRequest() *GetTransactionAcceptanceInfoRequestMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_GetTransactionAcceptanceInfoRequest); ok {
+		return x.GetTransactionAcceptanceInfoRequest
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetGetTransactionAcceptanceInfoResponse() *GetTransactionAcceptanceInfoResponseMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_GetTransactionAcceptanceInfoResponse); ok {
+		return x.GetTransactionAcceptanceInfoResponse
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetSubmitTransactionReplacementRequest() *SubmitTransactionReplacementRequestMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_SubmitTransactionReplacementRequest); ok {
+		return x.SubmitTransactionReplacementRequest
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetSubmitTransactionReplacementResponse() *SubmitTransactionReplacementResponseMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_SubmitTransactionReplacementResponse); ok {
+		return x.SubmitTransactionReplacementResponse
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetGetFeeEstimateRequest() *GetFeeEstimateRequestMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_GetFeeEstimateRequest); ok {
+		return x.GetFeeEstimateRequest
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetGetFeeEstimateResponse() *GetFeeEstimateResponseMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_GetFeeEstimateResponse); ok {
+		return x.GetFeeEstimateResponse
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetSaveMempoolRequest() *SaveMempoolRequestMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_SaveMempoolRequest); ok {
+		return x.SaveMempoolRequest
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetSaveMempoolResponse() *SaveMempoolResponseMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_SaveMempoolResponse); ok {
+		return x.SaveMempoolResponse
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetLoadMempoolRequest() *LoadMempoolRequestMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_LoadMempoolRequest); ok {
+		return x.LoadMempoolRequest
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetLoadMempoolResponse() *LoadMempoolResponseMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_LoadMempoolResponse); ok {
+		return x.LoadMempoolResponse
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetNotifyMempoolChangedRequest() *NotifyMempoolChangedRequestMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_NotifyMempoolChangedRequest); ok {
+		return x.NotifyMempoolChangedRequest
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetNotifyMempoolChangedResponse() *NotifyMempoolChangedResponseMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_NotifyMempoolChangedResponse); ok {
+		return x.NotifyMempoolChangedResponse
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetMempoolChangedNotification() *MempoolChangedNotificationMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_MempoolChangedNotification); ok {
+		return x.MempoolChangedNotification
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetGetTransactionsByAddressRequest() *GetTransactionsByAddressRequestMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_GetTransactionsByAddressRequest); ok {
+		return x.GetTransactionsByAddressRequest
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetGetTransactionsByAddressResponse() *GetTransactionsByAddressResponseMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_GetTransactionsByAddressResponse); ok {
+		return x.GetTransactionsByAddressResponse
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetStreamUtxosByAddressesRequest() *StreamUtxosByAddressesRequestMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_StreamUtxosByAddressesRequest); ok {
+		return x.StreamUtxosByAddressesRequest
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetStreamUtxosByAddressesResponse() *StreamUtxosByAddressesResponseMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_StreamUtxosByAddressesResponse); ok {
+		return x.StreamUtxosByAddressesResponse
+	}
+	return nil
+}
+
+func (x *KaspadMessage) GetUtxosByAddressesChunkNotification() *UtxosByAddressesChunkNotificationMessage {
+	if x, ok := x.GetPayload().(*KaspadMessage_UtxosByAddressesChunkNotification); ok {
+		return x.UtxosByAddressesChunkNotification
+	}
+	return nil
+}
+
 type isKaspadMessage_Payload interface {
 	isKaspadMessage_Payload()
 }
@@ -1632,6 +1792,86 @@ type KaspadMessage_GetCoinSupplyResponse struct {
 	GetCoinSupplyResponse *GetCoinSupplyResponseMessage `protobuf:"bytes,1087,opt,name=getCoinSupplyResponse,proto3,oneof"`
 }
 
+type KaspadMessage_GetTransactionRequest struct {
+	GetTransactionRequest *GetTransactionRequestMessage `protobuf:"bytes,1088,opt,name=getTransactionRequest,proto3,oneof"`
+}
+
+type KaspadMessage_GetTransactionResponse struct {
+	GetTransactionResponse *GetTransactionResponseMessage `protobuf:"bytes,1089,opt,name=getTransactionResponse,proto3,oneof"`
+}
+
+type KaspadMessage_GetTransactionAcceptanceInfoRequest struct {
+	GetTransactionAcceptanceInfoRequest *GetTransactionAcceptanceInfoRequestMessage `protobuf:"bytes,1090,opt,name=getTransactionAcceptanceInfoRequest,proto3,oneof"`
+}
+
+type KaspadMessage_GetTransactionAcceptanceInfoResponse struct {
+	GetTransactionAcceptanceInfoResponse *GetTransactionAcceptanceInfoResponseMessage `protobuf:"bytes,1091,opt,name=getTransactionAcceptanceInfoResponse,proto3,oneof"`
+}
+
+type KaspadMessage_SubmitTransactionReplacementRequest struct {
+	SubmitTransactionReplacementRequest *SubmitTransactionReplacementRequestMessage `protobuf:"bytes,1092,opt,name=submitTransactionReplacementRequest,proto3,oneof"`
+}
+
+type KaspadMessage_SubmitTransactionReplacementResponse struct {
+	SubmitTransactionReplacementResponse *SubmitTransactionReplacementResponseMessage `protobuf:"bytes,1093,opt,name=submitTransactionReplacementResponse,proto3,oneof"`
+}
+
+type KaspadMessage_GetFeeEstimateRequest struct {
+	GetFeeEstimateRequest *GetFeeEstimateRequestMessage `protobuf:"bytes,1094,opt,name=getFeeEstimateRequest,proto3,oneof"`
+}
+
+type KaspadMessage_GetFeeEstimateResponse struct {
+	GetFeeEstimateResponse *GetFeeEstimateResponseMessage `protobuf:"bytes,1095,opt,name=getFeeEstimateResponse,proto3,oneof"`
+}
+
+type KaspadMessage_SaveMempoolRequest struct {
+	SaveMempoolRequest *SaveMempoolRequestMessage `protobuf:"bytes,1096,opt,name=saveMempoolRequest,proto3,oneof"`
+}
+
+type KaspadMessage_SaveMempoolResponse struct {
+	SaveMempoolResponse *SaveMempoolResponseMessage `protobuf:"bytes,1097,opt,name=saveMempoolResponse,proto3,oneof"`
+}
+
+type KaspadMessage_LoadMempoolRequest struct {
+	LoadMempoolRequest *LoadMempoolRequestMessage `protobuf:"bytes,1098,opt,name=loadMempoolRequest,proto3,oneof"`
+}
+
+type KaspadMessage_LoadMempoolResponse struct {
+	LoadMempoolResponse *LoadMempoolResponseMessage `protobuf:"bytes,1099,opt,name=loadMempoolResponse,proto3,oneof"`
+}
+
+type KaspadMessage_NotifyMempoolChangedRequest struct {
+	NotifyMempoolChangedRequest *NotifyMempoolChangedRequestMessage `protobuf:"bytes,1100,opt,name=notifyMempoolChangedRequest,proto3,oneof"`
+}
+
+type KaspadMessage_NotifyMempoolChangedResponse struct {
+	NotifyMempoolChangedResponse *NotifyMempoolChangedResponseMessage `protobuf:"bytes,1101,opt,name=notifyMempoolChangedResponse,proto3,oneof"`
+}
+
+type KaspadMessage_MempoolChangedNotification struct {
+	MempoolChangedNotification *MempoolChangedNotificationMessage `protobuf:"bytes,1102,opt,name=mempoolChangedNotification,proto3,oneof"`
+}
+
+type KaspadMessage_GetTransactionsByAddressRequest struct {
+	GetTransactionsByAddressRequest *GetTransactionsByAddressRequestMessage `protobuf:"bytes,1103,opt,name=getTransactionsByAddressRequest,proto3,oneof"`
+}
+
+type KaspadMessage_GetTransactionsByAddressResponse struct {
+	GetTransactionsByAddressResponse *GetTransactionsByAddressResponseMessage `protobuf:"bytes,1104,opt,name=getTransactionsByAddressResponse,proto3,oneof"`
+}
+
+type KaspadMessage_StreamUtxosByAddressesRequest struct {
+	StreamUtxosByAddressesRequest *StreamUtxosByAddressesRequestMessage `protobuf:"bytes,1105,opt,name=streamUtxosByAddressesRequest,proto3,oneof"`
+}
+
+type KaspadMessage_StreamUtxosByAddressesResponse struct {
+	StreamUtxosByAddressesResponse *StreamUtxosByAddressesResponseMessage `protobuf:"bytes,1106,opt,name=streamUtxosByAddressesResponse,proto3,oneof"`
+}
+
+type KaspadMessage_UtxosByAddressesChunkNotification struct {
+	UtxosByAddressesChunkNotification *UtxosByAddressesChunkNotificationMessage `protobuf:"bytes,1107,opt,name=utxosByAddressesChunkNotification,proto3,oneof"`
+}
+
 func (*KaspadMessage_Addresses) isKaspadMessage_Payload() {}
 
 func (*KaspadMessage_Block) isKaspadMessage_Payload() {}
@@ -1892,13 +2132,53 @@ func (*KaspadMessage_GetCoinSupplyRequest) isKaspadMessage_Payload() {}
 
 func (*KaspadMessage_GetCoinSupplyResponse) isKaspadMessage_Payload() {}
 
+func (*KaspadMessage_GetTransactionRequest) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_GetTransactionResponse) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_GetTransactionAcceptanceInfoRequest) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_GetTransactionAcceptanceInfoResponse) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_SubmitTransactionReplacementRequest) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_SubmitTransactionReplacementResponse) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_GetFeeEstimateRequest) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_GetFeeEstimateResponse) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_SaveMempoolRequest) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_SaveMempoolResponse) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_LoadMempoolRequest) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_LoadMempoolResponse) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_NotifyMempoolChangedRequest) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_NotifyMempoolChangedResponse) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_MempoolChangedNotification) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_GetTransactionsByAddressRequest) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_GetTransactionsByAddressResponse) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_StreamUtxosByAddressesRequest) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_StreamUtxosByAddressesResponse) isKaspadMessage_Payload() {}
+
+func (*KaspadMessage_UtxosByAddressesChunkNotification) isKaspadMessage_Payload() {}
+
 var File_messages_proto protoreflect.FileDescriptor
 
 var file_messages_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x1a, 0x09, 0x70, 0x32, 0x70,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x09, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xbf, 0x6d, 0x0a, 0x0d, 0x4b, 0x61, 0x73, 0x70, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x6f, 0x22, 0xcf, 0x7f, 0x0a, 0x0d, 0x4b, 0x61, 0x73, 0x70, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69,
 	0x72, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73,
@@ -2773,7 +3053,152 @@ var file_messages_proto_rawDesc = []byte{
 	0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
 	0x00, 0x52, 0x15, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0xc0, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x77, 0x69, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x15, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x16, 0x67, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0xc1, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x16, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x8a, 0x01, 0x0a, 0x23, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0xc2, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x23, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x8d, 0x01, 0x0a,
+	0x24, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0xc3, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x24, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a,
+	0x23, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0xc4, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x23, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x8d, 0x01, 0x0a, 0x24, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0xc5, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x24, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x15, 0x67, 0x65, 0x74,
+	0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0xc6, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x15, 0x67, 0x65, 0x74, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x16, 0x67,
+	0x65, 0x74, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0xc7, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x16, 0x67, 0x65, 0x74, 0x46, 0x65, 0x65,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x57, 0x0a, 0x12, 0x73, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0xc8, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x65,
+	0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x73, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x13, 0x73, 0x61, 0x76,
+	0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0xc9, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77,
+	0x69, 0x72, 0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x13, 0x73, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x12, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0xca, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x4c,
+	0x6f, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x6c, 0x6f, 0x61, 0x64,
+	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5a,
+	0x0a, 0x13, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0xcb, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x1b, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0xcc, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x1b, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x75,
+	0x0a, 0x1c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0xcd,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72,
+	0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x1c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d,
+	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x1a, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0xce, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x1a, 0x6d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7e, 0x0a, 0x1f, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0xcf, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x1f, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x20, 0x67, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0xd0, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x20, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x1d, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0xd1, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x1d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x74, 0x78,
+	0x6f, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x7b, 0x0a, 0x1e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x74,
+	0x78, 0x6f, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0xd2, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x55, 0x74, 0x78, 0x6f, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x1e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x42, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x84, 0x01, 0x0a, 0x21, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xd3, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x73,
+	0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x21, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x42, 0x79, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
 	0x6f, 0x61, 0x64, 0x32, 0x50, 0x0a, 0x03, 0x50, 0x32, 0x50, 0x12, 0x49, 0x0a, 0x0d, 0x4d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x77, 0x69, 0x72, 0x65, 0x2e, 0x4b, 0x61, 0x73, 0x70, 0x61, 0x64, 0x4d, 0x65,
@@ -2934,6 +3359,26 @@ var file_messages_proto_goTypes = []interface{}{
 	(*GetMempoolEntriesByAddressesResponseMessage)(nil),                // 127: protowire.GetMempoolEntriesByAddressesResponseMessage
 	(*GetCoinSupplyRequestMessage)(nil),                                // 128: protowire.GetCoinSupplyRequestMessage
 	(*GetCoinSupplyResponseMessage)(nil),                               // 129: protowire.GetCoinSupplyResponseMessage
+	(*GetTransactionRequestMessage)(nil),                               // 130: protowire.GetTransactionRequestMessage
+	(*GetTransactionResponseMessage)(nil),                              // 131: protowire.GetTransactionResponseMessage
+	(*GetTransactionAcceptanceInfoRequestMessage)(nil),                 // 132: protowire.GetTransactionAcceptanceInfoRequestMessage
+	(*GetTransactionAcceptanceInfoResponseMessage)(nil),                // 133: protowire.GetTransactionAcceptanceInfoResponseMessage
+	(*SubmitTransactionReplacementRequestMessage)(nil),                 // 134: protowire.SubmitTransactionReplacementRequestMessage
+	(*SubmitTransactionReplacementResponseMessage)(nil),                // 135: protowire.SubmitTransactionReplacementResponseMessage
+	(*GetFeeEstimateRequestMessage)(nil),                               // 136: protowire.GetFeeEstimateRequestMessage
+	(*GetFeeEstimateResponseMessage)(nil),                              // 137: protowire.GetFeeEstimateResponseMessage
+	(*SaveMempoolRequestMessage)(nil),                                  // 138: protowire.SaveMempoolRequestMessage
+	(*SaveMempoolResponseMessage)(nil),                                 // 139: protowire.SaveMempoolResponseMessage
+	(*LoadMempoolRequestMessage)(nil),                                  // 140: protowire.LoadMempoolRequestMessage
+	(*LoadMempoolResponseMessage)(nil),                                 // 141: protowire.LoadMempoolResponseMessage
+	(*NotifyMempoolChangedRequestMessage)(nil),                         // 142: protowire.NotifyMempoolChangedRequestMessage
+	(*NotifyMempoolChangedResponseMessage)(nil),                        // 143: protowire.NotifyMempoolChangedResponseMessage
+	(*MempoolChangedNotificationMessage)(nil),                          // 144: protowire.MempoolChangedNotificationMessage
+	(*GetTransactionsByAddressRequestMessage)(nil),                     // 145: protowire.GetTransactionsByAddressRequestMessage
+	(*GetTransactionsByAddressResponseMessage)(nil),                    // 146: protowire.GetTransactionsByAddressResponseMessage
+	(*StreamUtxosByAddressesRequestMessage)(nil),                       // 147: protowire.StreamUtxosByAddressesRequestMessage
+	(*StreamUtxosByAddressesResponseMessage)(nil),                      // 148: protowire.StreamUtxosByAddressesResponseMessage
+	(*UtxosByAddressesChunkNotificationMessage)(nil),                   // 149: protowire.UtxosByAddressesChunkNotificationMessage
 }
 var file_messages_proto_depIdxs = []int32{
 	1,   // 0: protowire.KaspadMessage.addresses:type_name -> protowire.AddressesMessage
@@ -3066,15 +3511,35 @@ var file_messages_proto_depIdxs = []int32{
 	127, // 127: protowire.KaspadMessage.getMempoolEntriesByAddressesResponse:type_name -> protowire.GetMempoolEntriesByAddressesResponseMessage
 	128, // 128: protowire.KaspadMessage.getCoinSupplyRequest:type_name -> protowire.GetCoinSupplyRequestMessage
 	129, // 129: protowire.KaspadMessage.getCoinSupplyResponse:type_name -> protowire.GetCoinSupplyResponseMessage
-	0,   // 130: protowire.P2P.MessageStream:input_type -> protowire.KaspadMessage
-	0,   // 131: protowire.RPC.MessageStream:input_type -> protowire.KaspadMessage
-	0,   // 132: protowire.P2P.MessageStream:output_type -> protowire.KaspadMessage
-	0,   // 133: protowire.RPC.MessageStream:output_type -> protowire.KaspadMessage
-	132, // [132:134] is the sub-list for method output_type
-	130, // [130:132] is the sub-list for method input_type
-	130, // [130:130] is the sub-list for extension type_name
-	130, // [130:130] is the sub-list for extension extendee
-	0,   // [0:130] is the sub-list for field type_name
+	130, // 130: protowire.KaspadMessage.getTransactionRequest:type_name -> protowire.GetTransactionRequestMessage
+	131, // 131: protowire.KaspadMessage.getTransactionResponse:type_name -> protowire.GetTransactionResponseMessage
+	132, // 132: protowire.KaspadMessage.getTransactionAcceptanceInfoRequest:type_name -> protowire.GetTransactionAcceptanceInfoRequestMessage
+	133, // 133: protowire.KaspadMessage.getTransactionAcceptanceInfoResponse:type_name -> protowire.GetTransactionAcceptanceInfoResponseMessage
+	134, // 134: protowire.KaspadMessage.submitTransactionReplacementRequest:type_name -> protowire.SubmitTransactionReplacementRequestMessage
+	135, // 135: protowire.KaspadMessage.submitTransactionReplacementResponse:type_name -> protowire.SubmitTransactionReplacementResponseMessage
+	136, // 136: protowire.KaspadMessage.getFeeEstimateRequest:type_name -> protowire.GetFeeEstimateRequestMessage
+	137, // 137: protowire.KaspadMessage.getFeeEstimateResponse:type_name -> protowire.GetFeeEstimateResponseMessage
+	138, // 138: protowire.KaspadMessage.saveMempoolRequest:type_name -> protowire.SaveMempoolRequestMessage
+	139, // 139: protowire.KaspadMessage.saveMempoolResponse:type_name -> protowire.SaveMempoolResponseMessage
+	140, // 140: protowire.KaspadMessage.loadMempoolRequest:type_name -> protowire.LoadMempoolRequestMessage
+	141, // 141: protowire.KaspadMessage.loadMempoolResponse:type_name -> protowire.LoadMempoolResponseMessage
+	142, // 142: protowire.KaspadMessage.notifyMempoolChangedRequest:type_name -> protowire.NotifyMempoolChangedRequestMessage
+	143, // 143: protowire.KaspadMessage.notifyMempoolChangedResponse:type_name -> protowire.NotifyMempoolChangedResponseMessage
+	144, // 144: protowire.KaspadMessage.mempoolChangedNotification:type_name -> protowire.MempoolChangedNotificationMessage
+	145, // 145: protowire.KaspadMessage.getTransactionsByAddressRequest:type_name -> protowire.GetTransactionsByAddressRequestMessage
+	146, // 146: protowire.KaspadMessage.getTransactionsByAddressResponse:type_name -> protowire.GetTransactionsByAddressResponseMessage
+	147, // 147: protowire.KaspadMessage.streamUtxosByAddressesRequest:type_name -> protowire.StreamUtxosByAddressesRequestMessage
+	148, // 148: protowire.KaspadMessage.streamUtxosByAddressesResponse:type_name -> protowire.StreamUtxosByAddressesResponseMessage
+	149, // 149: protowire.KaspadMessage.utxosByAddressesChunkNotification:type_name -> protowire.UtxosByAddressesChunkNotificationMessage
+	0,   // 150: protowire.P2P.MessageStream:input_type -> protowire.KaspadMessage
+	0,   // 151: protowire.RPC.MessageStream:input_type -> protowire.KaspadMessage
+	0,   // 152: protowire.P2P.MessageStream:output_type -> protowire.KaspadMessage
+	0,   // 153: protowire.RPC.MessageStream:output_type -> protowire.KaspadMessage
+	152, // [152:154] is the sub-list for method output_type
+	150, // [150:152] is the sub-list for method input_type
+	150, // [150:150] is the sub-list for extension type_name
+	150, // [150:150] is the sub-list for extension extendee
+	0,   // [0:150] is the sub-list for field type_name
 }
 
 func init() { file_messages_proto_init() }
@@ -3229,6 +3694,26 @@ func file_messages_proto_init() {
 		(*KaspadMessage_GetMempoolEntriesByAddressesResponse)(nil),
 		(*KaspadMessage_GetCoinSupplyRequest)(nil),
 		(*KaspadMessage_GetCoinSupplyResponse)(nil),
+		(*KaspadMessage_GetTransactionRequest)(nil),
+		(*KaspadMessage_GetTransactionResponse)(nil),
+		(*KaspadMessage_GetTransactionAcceptanceInfoRequest)(nil),
+		(*KaspadMessage_GetTransactionAcceptanceInfoResponse)(nil),
+		(*KaspadMessage_SubmitTransactionReplacementRequest)(nil),
+		(*KaspadMessage_SubmitTransactionReplacementResponse)(nil),
+		(*KaspadMessage_GetFeeEstimateRequest)(nil),
+		(*KaspadMessage_GetFeeEstimateResponse)(nil),
+		(*KaspadMessage_SaveMempoolRequest)(nil),
+		(*KaspadMessage_SaveMempoolResponse)(nil),
+		(*KaspadMessage_LoadMempoolRequest)(nil),
+		(*KaspadMessage_LoadMempoolResponse)(nil),
+		(*KaspadMessage_NotifyMempoolChangedRequest)(nil),
+		(*KaspadMessage_NotifyMempoolChangedResponse)(nil),
+		(*KaspadMessage_MempoolChangedNotification)(nil),
+		(*KaspadMessage_GetTransactionsByAddressRequest)(nil),
+		(*KaspadMessage_GetTransactionsByAddressResponse)(nil),
+		(*KaspadMessage_StreamUtxosByAddressesRequest)(nil),
+		(*KaspadMessage_StreamUtxosByAddressesResponse)(nil),
+		(*KaspadMessage_UtxosByAddressesChunkNotification)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -0,0 +1,118 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_GetFeeEstimateRequest) toAppMessage() (appmessage.Message, error) {
+	return &appmessage.GetFeeEstimateRequestMessage{}, nil
+}
+
+func (x *KaspadMessage_GetFeeEstimateRequest) fromAppMessage(_ *appmessage.GetFeeEstimateRequestMessage) error {
+	x.GetFeeEstimateRequest = &GetFeeEstimateRequestMessage{}
+	return nil
+}
+
+func (x *KaspadMessage_GetFeeEstimateResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetFeeEstimateResponse is nil")
+	}
+	return x.GetFeeEstimateResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_GetFeeEstimateResponse) fromAppMessage(message *appmessage.GetFeeEstimateResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	var estimate *RpcFeeEstimate
+	if message.Estimate != nil {
+		estimate = &RpcFeeEstimate{}
+		estimate.fromAppMessage(message.Estimate)
+	}
+	x.GetFeeEstimateResponse = &GetFeeEstimateResponseMessage{
+		Estimate: estimate,
+		Error:    err,
+	}
+	return nil
+}
+
+func (x *GetFeeEstimateResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetFeeEstimateResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && x.Estimate != nil {
+		return nil, errors.New("GetFeeEstimateResponseMessage contains both an error and a response")
+	}
+
+	var estimate *appmessage.RPCFeeEstimate
+	if rpcErr == nil {
+		estimate, err = x.Estimate.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetFeeEstimateResponseMessage{
+		Estimate: estimate,
+		Error:    rpcErr,
+	}, nil
+}
+
+func (x *RpcFeeEstimate) toAppMessage() (*appmessage.RPCFeeEstimate, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcFeeEstimate is nil")
+	}
+	priorityBucket, err := x.PriorityBucket.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	normalBucket, err := x.NormalBucket.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	lowBucket, err := x.LowBucket.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.RPCFeeEstimate{
+		PriorityBucket: priorityBucket,
+		NormalBucket:   normalBucket,
+		LowBucket:      lowBucket,
+	}, nil
+}
+
+func (x *RpcFeeEstimate) fromAppMessage(message *appmessage.RPCFeeEstimate) {
+	*x = RpcFeeEstimate{
+		PriorityBucket: &RpcFeeRateBucket{},
+		NormalBucket:   &RpcFeeRateBucket{},
+		LowBucket:      &RpcFeeRateBucket{},
+	}
+	x.PriorityBucket.fromAppMessage(message.PriorityBucket)
+	x.NormalBucket.fromAppMessage(message.NormalBucket)
+	x.LowBucket.fromAppMessage(message.LowBucket)
+}
+
+func (x *RpcFeeRateBucket) toAppMessage() (*appmessage.RPCFeeRateBucket, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcFeeRateBucket is nil")
+	}
+	return &appmessage.RPCFeeRateBucket{
+		FeeRate:          x.FeeRate,
+		EstimatedSeconds: x.EstimatedSeconds,
+	}, nil
+}
+
+func (x *RpcFeeRateBucket) fromAppMessage(message *appmessage.RPCFeeRateBucket) {
+	*x = RpcFeeRateBucket{
+		FeeRate:          message.FeeRate,
+		EstimatedSeconds: message.EstimatedSeconds,
+	}
+}
@@ -27,12 +27,14 @@ func (x *KaspadMessage_GetInfoResponse) fromAppMessage(message *appmessage.GetIn
 		err = &RPCError{Message: message.Error.Message}
 	}
 	x.GetInfoResponse = &GetInfoResponseMessage{
-		P2PId:         message.P2PID,
-		ServerVersion: message.ServerVersion,
-		MempoolSize:   message.MempoolSize,
-		IsUtxoIndexed: message.IsUtxoIndexed,
-		IsSynced:      message.IsSynced,
-		Error:         err,
+		P2PId:                 message.P2PID,
+		ServerVersion:         message.ServerVersion,
+		MempoolSize:           message.MempoolSize,
+		IsUtxoIndexed:         message.IsUtxoIndexed,
+		IsSynced:              message.IsSynced,
+		MempoolMass:           message.MempoolMass,
+		MempoolMinimumFeeRate: message.MempoolMinimumFeeRate,
+		Error:                 err,
 	}
 	return nil
 }
@@ -52,11 +54,13 @@ func (x *GetInfoResponseMessage) toAppMessage() (appmessage.Message, error) {
 	}
 
 	return &appmessage.GetInfoResponseMessage{
-		P2PID:         x.P2PId,
-		MempoolSize:   x.MempoolSize,
-		ServerVersion: x.ServerVersion,
-		IsUtxoIndexed: x.IsUtxoIndexed,
-		IsSynced:      x.IsSynced,
+		P2PID:                 x.P2PId,
+		MempoolSize:           x.MempoolSize,
+		ServerVersion:         x.ServerVersion,
+		IsUtxoIndexed:         x.IsUtxoIndexed,
+		IsSynced:              x.IsSynced,
+		MempoolMass:           x.MempoolMass,
+		MempoolMinimumFeeRate: x.MempoolMinimumFeeRate,
 
 		Error: rpcErr,
 	}, nil
@@ -51,8 +51,9 @@ func (x *KaspadMessage_GetMempoolEntriesResponse) fromAppMessage(message *appmes
 		}
 	}
 	x.GetMempoolEntriesResponse = &GetMempoolEntriesResponseMessage{
-		Entries: entries,
-		Error:   rpcErr,
+		Entries:        entries,
+		MinimumFeeRate: message.MinimumFeeRate,
+		Error:          rpcErr,
 	}
 	return nil
 }
@@ -79,7 +80,8 @@ func (x *GetMempoolEntriesResponseMessage) toAppMessage() (appmessage.Message, e
 	}
 
 	return &appmessage.GetMempoolEntriesResponseMessage{
-		Entries: entries,
-		Error:   rpcErr,
+		Entries:        entries,
+		MinimumFeeRate: x.MinimumFeeRate,
+		Error:          rpcErr,
 	}, nil
 }
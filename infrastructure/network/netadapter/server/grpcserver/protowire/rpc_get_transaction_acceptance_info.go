@@ -0,0 +1,106 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_GetTransactionAcceptanceInfoRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetTransactionAcceptanceInfoRequest is nil")
+	}
+	return x.GetTransactionAcceptanceInfoRequest.toAppMessage()
+}
+
+func (x *KaspadMessage_GetTransactionAcceptanceInfoRequest) fromAppMessage(message *appmessage.GetTransactionAcceptanceInfoRequestMessage) error {
+	x.GetTransactionAcceptanceInfoRequest = &GetTransactionAcceptanceInfoRequestMessage{
+		TransactionIds: message.TransactionIDs,
+	}
+	return nil
+}
+
+func (x *GetTransactionAcceptanceInfoRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionAcceptanceInfoRequestMessage is nil")
+	}
+	return &appmessage.GetTransactionAcceptanceInfoRequestMessage{
+		TransactionIDs: x.TransactionIds,
+	}, nil
+}
+
+func (x *KaspadMessage_GetTransactionAcceptanceInfoResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetTransactionAcceptanceInfoResponse is nil")
+	}
+	return x.GetTransactionAcceptanceInfoResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_GetTransactionAcceptanceInfoResponse) fromAppMessage(message *appmessage.GetTransactionAcceptanceInfoResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = &RPCError{Message: message.Error.Message}
+	}
+	acceptanceInfos := make([]*TransactionAcceptanceInfo, len(message.AcceptanceInfos))
+	for i, acceptanceInfo := range message.AcceptanceInfos {
+		acceptanceInfos[i] = &TransactionAcceptanceInfo{}
+		acceptanceInfos[i].fromAppMessage(acceptanceInfo)
+	}
+	x.GetTransactionAcceptanceInfoResponse = &GetTransactionAcceptanceInfoResponseMessage{
+		AcceptanceInfos: acceptanceInfos,
+		Error:           rpcErr,
+	}
+	return nil
+}
+
+func (x *GetTransactionAcceptanceInfoResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionAcceptanceInfoResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.AcceptanceInfos) != 0 {
+		return nil, errors.New("GetTransactionAcceptanceInfoResponseMessage contains both an error and a response")
+	}
+
+	acceptanceInfos := make([]*appmessage.TransactionAcceptanceInfo, len(x.AcceptanceInfos))
+	for i, acceptanceInfo := range x.AcceptanceInfos {
+		acceptanceInfos[i], err = acceptanceInfo.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetTransactionAcceptanceInfoResponseMessage{
+		AcceptanceInfos: acceptanceInfos,
+		Error:           rpcErr,
+	}, nil
+}
+
+func (x *TransactionAcceptanceInfo) toAppMessage() (*appmessage.TransactionAcceptanceInfo, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "TransactionAcceptanceInfo is nil")
+	}
+	return &appmessage.TransactionAcceptanceInfo{
+		TransactionID:      x.TransactionId,
+		IsAccepted:         x.IsAccepted,
+		AcceptingBlockHash: x.AcceptingBlockHash,
+		IncludingBlockHash: x.IncludingBlockHash,
+		AcceptingBlueScore: x.AcceptingBlueScore,
+		Confirmations:      x.Confirmations,
+	}, nil
+}
+
+func (x *TransactionAcceptanceInfo) fromAppMessage(message *appmessage.TransactionAcceptanceInfo) {
+	*x = TransactionAcceptanceInfo{
+		TransactionId:      message.TransactionID,
+		IsAccepted:         message.IsAccepted,
+		AcceptingBlockHash: message.AcceptingBlockHash,
+		IncludingBlockHash: message.IncludingBlockHash,
+		AcceptingBlueScore: message.AcceptingBlueScore,
+		Confirmations:      message.Confirmations,
+	}
+}
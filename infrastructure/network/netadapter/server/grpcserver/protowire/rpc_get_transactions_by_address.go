@@ -0,0 +1,106 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_GetTransactionsByAddressRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetTransactionsByAddressRequest is nil")
+	}
+	return x.GetTransactionsByAddressRequest.toAppMessage()
+}
+
+func (x *KaspadMessage_GetTransactionsByAddressRequest) fromAppMessage(message *appmessage.GetTransactionsByAddressRequestMessage) error {
+	x.GetTransactionsByAddressRequest = &GetTransactionsByAddressRequestMessage{
+		Address:            message.Address,
+		StartDaaScore:      message.StartDAAScore,
+		StartTransactionId: message.StartTransactionID,
+		Limit:              message.Limit,
+	}
+	return nil
+}
+
+func (x *GetTransactionsByAddressRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionsByAddressRequestMessage is nil")
+	}
+	return &appmessage.GetTransactionsByAddressRequestMessage{
+		Address:            x.Address,
+		StartDAAScore:      x.StartDaaScore,
+		StartTransactionID: x.StartTransactionId,
+		Limit:              x.Limit,
+	}, nil
+}
+
+func (x *KaspadMessage_GetTransactionsByAddressResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetTransactionsByAddressResponse is nil")
+	}
+	return x.GetTransactionsByAddressResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_GetTransactionsByAddressResponse) fromAppMessage(message *appmessage.GetTransactionsByAddressResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = &RPCError{Message: message.Error.Message}
+	}
+	transactions := make([]*AddressTransaction, len(message.Transactions))
+	for i, transaction := range message.Transactions {
+		transactions[i] = &AddressTransaction{}
+		transactions[i].fromAppMessage(transaction)
+	}
+	x.GetTransactionsByAddressResponse = &GetTransactionsByAddressResponseMessage{
+		Transactions: transactions,
+		HasMore:      message.HasMore,
+		Error:        rpcErr,
+	}
+	return nil
+}
+
+func (x *GetTransactionsByAddressResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionsByAddressResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.Transactions) != 0 {
+		return nil, errors.New("GetTransactionsByAddressResponseMessage contains both an error and a response")
+	}
+
+	transactions := make([]*appmessage.AddressTransaction, len(x.Transactions))
+	for i, transaction := range x.Transactions {
+		transactions[i], err = transaction.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetTransactionsByAddressResponseMessage{
+		Transactions: transactions,
+		HasMore:      x.HasMore,
+		Error:        rpcErr,
+	}, nil
+}
+
+func (x *AddressTransaction) toAppMessage() (*appmessage.AddressTransaction, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "AddressTransaction is nil")
+	}
+	return &appmessage.AddressTransaction{
+		TransactionID:     x.TransactionId,
+		AcceptingDAAScore: x.AcceptingDaaScore,
+	}, nil
+}
+
+func (x *AddressTransaction) fromAppMessage(message *appmessage.AddressTransaction) {
+	*x = AddressTransaction{
+		TransactionId:     message.TransactionID,
+		AcceptingDaaScore: message.AcceptingDAAScore,
+	}
+}
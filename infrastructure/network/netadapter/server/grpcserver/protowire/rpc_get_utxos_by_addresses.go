@@ -13,8 +13,22 @@ func (x *KaspadMessage_GetUtxosByAddressesRequest) toAppMessage() (appmessage.Me
 }
 
 func (x *KaspadMessage_GetUtxosByAddressesRequest) fromAppMessage(message *appmessage.GetUTXOsByAddressesRequestMessage) error {
+	var filter *UtxosByAddressesFilter
+	if message.Filter != nil {
+		filter = &UtxosByAddressesFilter{}
+		filter.fromAppMessage(message.Filter)
+	}
+	var startOutpoint *RpcOutpoint
+	if message.StartOutpoint != nil {
+		startOutpoint = &RpcOutpoint{}
+		startOutpoint.fromAppMessage(message.StartOutpoint)
+	}
 	x.GetUtxosByAddressesRequest = &GetUtxosByAddressesRequestMessage{
-		Addresses: message.Addresses,
+		Addresses:     message.Addresses,
+		Filter:        filter,
+		Limit:         message.Limit,
+		StartAddress:  message.StartAddress,
+		StartOutpoint: startOutpoint,
 	}
 	return nil
 }
@@ -23,8 +37,20 @@ func (x *GetUtxosByAddressesRequestMessage) toAppMessage() (appmessage.Message,
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "GetUtxosByAddressesRequestMessage is nil")
 	}
+	var startOutpoint *appmessage.RPCOutpoint
+	if x.StartOutpoint != nil {
+		var err error
+		startOutpoint, err = x.StartOutpoint.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &appmessage.GetUTXOsByAddressesRequestMessage{
-		Addresses: x.Addresses,
+		Addresses:     x.Addresses,
+		Filter:        x.Filter.toAppMessage(),
+		Limit:         x.Limit,
+		StartAddress:  x.StartAddress,
+		StartOutpoint: startOutpoint,
 	}, nil
 }
 
@@ -47,6 +73,7 @@ func (x *KaspadMessage_GetUtxosByAddressesResponse) fromAppMessage(message *appm
 	}
 	x.GetUtxosByAddressesResponse = &GetUtxosByAddressesResponseMessage{
 		Entries: entries,
+		HasMore: message.HasMore,
 		Error:   err,
 	}
 	return nil
@@ -77,6 +104,31 @@ func (x *GetUtxosByAddressesResponseMessage) toAppMessage() (appmessage.Message,
 
 	return &appmessage.GetUTXOsByAddressesResponseMessage{
 		Entries: entries,
+		HasMore: x.HasMore,
 		Error:   rpcErr,
 	}, nil
 }
+
+func (x *UtxosByAddressesFilter) toAppMessage() *appmessage.UTXOsByAddressesFilter {
+	// Filter is an optional field
+	if x == nil {
+		return nil
+	}
+	return &appmessage.UTXOsByAddressesFilter{
+		MinAmount:               x.MinAmount,
+		MaxAmount:               x.MaxAmount,
+		MinBlockDAAScore:        x.MinBlockDaaScore,
+		MaxBlockDAAScore:        x.MaxBlockDaaScore,
+		ExcludeImmatureCoinbase: x.ExcludeImmatureCoinbase,
+	}
+}
+
+func (x *UtxosByAddressesFilter) fromAppMessage(message *appmessage.UTXOsByAddressesFilter) {
+	*x = UtxosByAddressesFilter{
+		MinAmount:               message.MinAmount,
+		MaxAmount:               message.MaxAmount,
+		MinBlockDaaScore:        message.MinBlockDAAScore,
+		MaxBlockDaaScore:        message.MaxBlockDAAScore,
+		ExcludeImmatureCoinbase: message.ExcludeImmatureCoinbase,
+	}
+}
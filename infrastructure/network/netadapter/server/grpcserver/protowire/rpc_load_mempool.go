@@ -0,0 +1,57 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_LoadMempoolRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_LoadMempoolRequest is nil")
+	}
+	return &appmessage.LoadMempoolRequestMessage{}, nil
+}
+
+func (x *KaspadMessage_LoadMempoolRequest) fromAppMessage(message *appmessage.LoadMempoolRequestMessage) error {
+	x.LoadMempoolRequest = &LoadMempoolRequestMessage{}
+	return nil
+}
+
+func (x *KaspadMessage_LoadMempoolResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_LoadMempoolResponse is nil")
+	}
+	return x.LoadMempoolResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_LoadMempoolResponse) fromAppMessage(message *appmessage.LoadMempoolResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.LoadMempoolResponse = &LoadMempoolResponseMessage{
+		LoadedTransactionCount: message.LoadedTransactionCount,
+		Error:                  err,
+	}
+	return nil
+}
+
+func (x *LoadMempoolResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "LoadMempoolResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && x.LoadedTransactionCount != 0 {
+		return nil, errors.New("LoadMempoolResponseMessage contains both an error and a response")
+	}
+
+	return &appmessage.LoadMempoolResponseMessage{
+		LoadedTransactionCount: x.LoadedTransactionCount,
+		Error:                  rpcErr,
+	}, nil
+}
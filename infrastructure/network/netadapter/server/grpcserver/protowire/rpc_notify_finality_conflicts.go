@@ -56,6 +56,7 @@ func (x *KaspadMessage_FinalityConflictNotification) toAppMessage() (appmessage.
 func (x *KaspadMessage_FinalityConflictNotification) fromAppMessage(message *appmessage.FinalityConflictNotificationMessage) error {
 	x.FinalityConflictNotification = &FinalityConflictNotificationMessage{
 		ViolatingBlockHash: message.ViolatingBlockHash,
+		FinalityBlockHash:  message.FinalityBlockHash,
 	}
 	return nil
 }
@@ -66,6 +67,7 @@ func (x *FinalityConflictNotificationMessage) toAppMessage() (appmessage.Message
 	}
 	return &appmessage.FinalityConflictNotificationMessage{
 		ViolatingBlockHash: x.ViolatingBlockHash,
+		FinalityBlockHash:  x.FinalityBlockHash,
 	}, nil
 }
 
@@ -14,7 +14,9 @@ func (x *KaspadMessage_NotifyUtxosChangedRequest) toAppMessage() (appmessage.Mes
 
 func (x *KaspadMessage_NotifyUtxosChangedRequest) fromAppMessage(message *appmessage.NotifyUTXOsChangedRequestMessage) error {
 	x.NotifyUtxosChangedRequest = &NotifyUtxosChangedRequestMessage{
-		Addresses: message.Addresses,
+		Addresses:                 message.Addresses,
+		ResumeAfterSequence:       message.ResumeAfterSequence,
+		ResumeAfterChainBlockHash: message.ResumeAfterChainBlockHash,
 	}
 	return nil
 }
@@ -24,7 +26,9 @@ func (x *NotifyUtxosChangedRequestMessage) toAppMessage() (appmessage.Message, e
 		return nil, errors.Wrapf(errorNil, "NotifyUtxosChangedRequestMessage is nil")
 	}
 	return &appmessage.NotifyUTXOsChangedRequestMessage{
-		Addresses: x.Addresses,
+		Addresses:                 x.Addresses,
+		ResumeAfterSequence:       x.ResumeAfterSequence,
+		ResumeAfterChainBlockHash: x.ResumeAfterChainBlockHash,
 	}, nil
 }
 
@@ -81,8 +85,9 @@ func (x *KaspadMessage_UtxosChangedNotification) fromAppMessage(message *appmess
 	}
 
 	x.UtxosChangedNotification = &UtxosChangedNotificationMessage{
-		Added:   added,
-		Removed: removed,
+		Added:    added,
+		Removed:  removed,
+		Sequence: message.Sequence,
 	}
 	return nil
 }
@@ -114,8 +119,9 @@ func (x *UtxosChangedNotificationMessage) toAppMessage() (appmessage.Message, er
 	}
 
 	return &appmessage.UTXOsChangedNotificationMessage{
-		Added:   added,
-		Removed: removed,
+		Added:    added,
+		Removed:  removed,
+		Sequence: x.Sequence,
 	}, nil
 }
 
@@ -11,12 +11,16 @@ func (x *KaspadMessage_NotifyVirtualSelectedParentChainChangedRequest) toAppMess
 	}
 	return &appmessage.NotifyVirtualSelectedParentChainChangedRequestMessage{
 		IncludeAcceptedTransactionIDs: x.NotifyVirtualSelectedParentChainChangedRequest.IncludeAcceptedTransactionIds,
+		ResumeAfterSequence:           x.NotifyVirtualSelectedParentChainChangedRequest.ResumeAfterSequence,
+		ResumeAfterChainBlockHash:     x.NotifyVirtualSelectedParentChainChangedRequest.ResumeAfterChainBlockHash,
 	}, nil
 }
 
 func (x *KaspadMessage_NotifyVirtualSelectedParentChainChangedRequest) fromAppMessage(appmessage *appmessage.NotifyVirtualSelectedParentChainChangedRequestMessage) error {
 	x.NotifyVirtualSelectedParentChainChangedRequest = &NotifyVirtualSelectedParentChainChangedRequestMessage{
 		IncludeAcceptedTransactionIds: appmessage.IncludeAcceptedTransactionIDs,
+		ResumeAfterSequence:           appmessage.ResumeAfterSequence,
+		ResumeAfterChainBlockHash:     appmessage.ResumeAfterChainBlockHash,
 	}
 	return nil
 }
@@ -65,6 +69,7 @@ func (x *KaspadMessage_VirtualSelectedParentChainChangedNotification) fromAppMes
 		RemovedChainBlockHashes: message.RemovedChainBlockHashes,
 		AddedChainBlockHashes:   message.AddedChainBlockHashes,
 		AcceptedTransactionIds:  make([]*AcceptedTransactionIds, len(message.AcceptedTransactionIDs)),
+		Sequence:                message.Sequence,
 	}
 
 	for i, acceptedTransactionIDs := range message.AcceptedTransactionIDs {
@@ -82,6 +87,7 @@ func (x *VirtualSelectedParentChainChangedNotificationMessage) toAppMessage() (a
 		RemovedChainBlockHashes: x.RemovedChainBlockHashes,
 		AddedChainBlockHashes:   x.AddedChainBlockHashes,
 		AcceptedTransactionIDs:  make([]*appmessage.AcceptedTransactionIDs, len(x.AcceptedTransactionIds)),
+		Sequence:                x.Sequence,
 	}
 
 	for i, acceptedTransactionIds := range x.AcceptedTransactionIds {
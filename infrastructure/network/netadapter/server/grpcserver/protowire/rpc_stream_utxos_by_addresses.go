@@ -0,0 +1,108 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_StreamUtxosByAddressesRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_StreamUtxosByAddressesRequest is nil")
+	}
+	return x.StreamUtxosByAddressesRequest.toAppMessage()
+}
+
+func (x *KaspadMessage_StreamUtxosByAddressesRequest) fromAppMessage(message *appmessage.StreamUTXOsByAddressesRequestMessage) error {
+	var filter *UtxosByAddressesFilter
+	if message.Filter != nil {
+		filter = &UtxosByAddressesFilter{}
+		filter.fromAppMessage(message.Filter)
+	}
+	x.StreamUtxosByAddressesRequest = &StreamUtxosByAddressesRequestMessage{
+		Addresses: message.Addresses,
+		Filter:    filter,
+		ChunkSize: message.ChunkSize,
+	}
+	return nil
+}
+
+func (x *StreamUtxosByAddressesRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "StreamUtxosByAddressesRequestMessage is nil")
+	}
+	return &appmessage.StreamUTXOsByAddressesRequestMessage{
+		Addresses: x.Addresses,
+		Filter:    x.Filter.toAppMessage(),
+		ChunkSize: x.ChunkSize,
+	}, nil
+}
+
+func (x *KaspadMessage_StreamUtxosByAddressesResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_StreamUtxosByAddressesResponse is nil")
+	}
+	return x.StreamUtxosByAddressesResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_StreamUtxosByAddressesResponse) fromAppMessage(message *appmessage.StreamUTXOsByAddressesResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.StreamUtxosByAddressesResponse = &StreamUtxosByAddressesResponseMessage{
+		EntryCount: message.EntryCount,
+		Error:      err,
+	}
+	return nil
+}
+
+func (x *StreamUtxosByAddressesResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "StreamUtxosByAddressesResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.StreamUTXOsByAddressesResponseMessage{
+		EntryCount: x.EntryCount,
+		Error:      rpcErr,
+	}, nil
+}
+
+func (x *KaspadMessage_UtxosByAddressesChunkNotification) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_UtxosByAddressesChunkNotification is nil")
+	}
+	return x.UtxosByAddressesChunkNotification.toAppMessage()
+}
+
+func (x *KaspadMessage_UtxosByAddressesChunkNotification) fromAppMessage(message *appmessage.UTXOsByAddressesChunkNotificationMessage) error {
+	entries := make([]*UtxosByAddressesEntry, len(message.Entries))
+	for i, entry := range message.Entries {
+		entries[i] = &UtxosByAddressesEntry{}
+		entries[i].fromAppMessage(entry)
+	}
+	x.UtxosByAddressesChunkNotification = &UtxosByAddressesChunkNotificationMessage{
+		Entries: entries,
+	}
+	return nil
+}
+
+func (x *UtxosByAddressesChunkNotificationMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "UtxosByAddressesChunkNotificationMessage is nil")
+	}
+	entries := make([]*appmessage.UTXOsByAddressesEntry, len(x.Entries))
+	for i, entry := range x.Entries {
+		entryAsAppMessage, err := entry.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = entryAsAppMessage
+	}
+	return &appmessage.UTXOsByAddressesChunkNotificationMessage{
+		Entries: entries,
+	}, nil
+}
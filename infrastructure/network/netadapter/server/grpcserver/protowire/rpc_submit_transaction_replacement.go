@@ -0,0 +1,82 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_SubmitTransactionReplacementRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_SubmitTransactionReplacementRequest is nil")
+	}
+	return x.SubmitTransactionReplacementRequest.toAppMessage()
+}
+
+func (x *KaspadMessage_SubmitTransactionReplacementRequest) fromAppMessage(message *appmessage.SubmitTransactionReplacementRequestMessage) error {
+	x.SubmitTransactionReplacementRequest = &SubmitTransactionReplacementRequestMessage{
+		Transaction: &RpcTransaction{},
+	}
+	x.SubmitTransactionReplacementRequest.Transaction.fromAppMessage(message.Transaction)
+	return nil
+}
+
+func (x *SubmitTransactionReplacementRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SubmitTransactionReplacementRequestMessage is nil")
+	}
+	rpcTransaction, err := x.Transaction.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.SubmitTransactionReplacementRequestMessage{
+		Transaction: rpcTransaction,
+	}, nil
+}
+
+func (x *KaspadMessage_SubmitTransactionReplacementResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_SubmitTransactionReplacementResponse is nil")
+	}
+	return x.SubmitTransactionReplacementResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_SubmitTransactionReplacementResponse) fromAppMessage(message *appmessage.SubmitTransactionReplacementResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	replacedTransactions := make([]*RpcTransaction, len(message.ReplacedTransactions))
+	for i, replacedTransaction := range message.ReplacedTransactions {
+		replacedTransactions[i] = &RpcTransaction{}
+		replacedTransactions[i].fromAppMessage(replacedTransaction)
+	}
+	x.SubmitTransactionReplacementResponse = &SubmitTransactionReplacementResponseMessage{
+		TransactionId:        message.TransactionID,
+		ReplacedTransactions: replacedTransactions,
+		Error:                err,
+	}
+	return nil
+}
+
+func (x *SubmitTransactionReplacementResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SubmitTransactionReplacementResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	replacedTransactions := make([]*appmessage.RPCTransaction, len(x.ReplacedTransactions))
+	for i, replacedTransaction := range x.ReplacedTransactions {
+		replacedTransactions[i], err = replacedTransaction.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &appmessage.SubmitTransactionReplacementResponseMessage{
+		TransactionID:        x.TransactionId,
+		ReplacedTransactions: replacedTransactions,
+		Error:                rpcErr,
+	}, nil
+}
@@ -1,23 +1,39 @@
 package grpcserver
 
 import (
+	"crypto/tls"
+
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
 	"github.com/kaspanet/kaspad/util/panics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type rpcServer struct {
 	protowire.UnimplementedRPCServer
 	gRPCServer
+	authenticator *rpcauth.Authenticator
 }
 
 // RPCMaxMessageSize is the max message size for the RPC server to send and receive
 const RPCMaxMessageSize = 1024 * 1024 * 1024 // 1 GB
 
-// NewRPCServer creates a new RPCServer
-func NewRPCServer(listeningAddresses []string, rpcMaxInboundConnections int) (server.Server, error) {
-	gRPCServer := newGRPCServer(listeningAddresses, RPCMaxMessageSize, rpcMaxInboundConnections, "RPC")
-	rpcServer := &rpcServer{gRPCServer: *gRPCServer}
+// NewRPCServer creates a new RPCServer. Connections are served over
+// TLS if tlsConfig is not nil
+func NewRPCServer(listeningAddresses []string, rpcMaxInboundConnections int,
+	authenticator *rpcauth.Authenticator, tlsConfig *tls.Config) (server.Server, error) {
+
+	var serverOptions []grpc.ServerOption
+	if tlsConfig != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	gRPCServer := newGRPCServer(listeningAddresses, RPCMaxMessageSize, rpcMaxInboundConnections, "RPC", serverOptions...)
+	rpcServer := &rpcServer{gRPCServer: *gRPCServer, authenticator: authenticator}
 	protowire.RegisterRPCServer(gRPCServer.server, rpcServer)
 	return rpcServer, nil
 }
@@ -25,5 +41,19 @@ func NewRPCServer(listeningAddresses []string, rpcMaxInboundConnections int) (se
 func (r *rpcServer) MessageStream(stream protowire.RPC_MessageStreamServer) error {
 	defer panics.HandlePanic(log, "rpcServer.MessageStream", nil)
 
-	return r.handleInboundConnection(stream.Context(), stream)
+	authorization := ""
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	if ok {
+		values := md.Get(rpcauth.AuthorizationHeader)
+		if len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+	roles, err := r.authenticator.Authenticate(authorization)
+	if err != nil {
+		log.Warnf("Rejected an unauthenticated RPC connection: %s", err)
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return r.handleInboundConnection(stream.Context(), stream, roles)
 }
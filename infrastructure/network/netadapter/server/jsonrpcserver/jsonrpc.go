@@ -0,0 +1,221 @@
+// Package jsonrpcserver implements a JSON-RPC 2.0 transport for the RPC
+// server, over both HTTP and WebSocket.
+//
+// Every RPC request message is exposed as a JSON-RPC method named after its
+// field in the KaspadMessage payload, without the "Request" suffix. For
+// example, GetInfoRequest is called with the method "getInfo". Params and
+// results are the JSON encoding of the request and response messages, as
+// defined by the protobuf JSON mapping. Notifications that were subscribed
+// to with one of the notify methods are sent to WebSocket clients as
+// JSON-RPC notifications, named after their payload field (for example
+// "blockAddedNotification"). Streaming methods, such as
+// streamUtxosByAddresses, are likewise only available over WebSocket: their
+// results are sent as a series of notifications followed by a single response.
+package jsonrpcserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const jsonRPCVersion = "2.0"
+
+// Error codes, as defined by the JSON-RPC 2.0 specification
+const (
+	parseErrorCode     = -32700
+	invalidRequestCode = -32600
+	methodNotFoundCode = -32601
+	invalidParamsCode  = -32602
+	internalErrorCode  = -32603
+
+	// rpcErrorCode is the error code of responses in which the RPC handler returned an error
+	rpcErrorCode = -32000
+)
+
+const (
+	requestFieldSuffix      = "Request"
+	notificationFieldSuffix = "Notification"
+)
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+// isNotification returns whether the request is a JSON-RPC notification,
+// meaning that the client does not expect a response to it
+func (r *request) isNotification() bool {
+	return len(r.ID) == 0
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *responseError  `json:"error,omitempty"`
+}
+
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *responseError) Error() string {
+	return e.Message
+}
+
+type notification struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+var payloadOneof = (&protowire.KaspadMessage{}).ProtoReflect().Descriptor().Oneofs().ByName("payload")
+
+// isSubscriptionMethod returns whether the given method starts or stops a
+// notification subscription, which is only possible over WebSocket
+func isSubscriptionMethod(method string) bool {
+	return strings.HasPrefix(method, "notify") || strings.HasPrefix(method, "stopNotifying")
+}
+
+// isStreamingMethod returns whether the given method sends its results as a
+// series of notifications before its response, which is only possible over WebSocket
+func isStreamingMethod(method string) bool {
+	return strings.HasPrefix(method, "stream")
+}
+
+// isBatch returns whether the given JSON value is an array, and hence a batch of requests
+func isBatch(data []byte) bool {
+	trimmed := bytes.TrimLeft(data, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+// parseRequest parses a single JSON-RPC request
+func parseRequest(data []byte) (*request, *responseError) {
+	if !json.Valid(data) {
+		return nil, &responseError{Code: parseErrorCode, Message: "invalid JSON"}
+	}
+	parsedRequest := &request{}
+	err := json.Unmarshal(data, parsedRequest)
+	if err != nil {
+		return nil, &responseError{Code: invalidRequestCode, Message: err.Error()}
+	}
+	if parsedRequest.JSONRPC != jsonRPCVersion {
+		return nil, &responseError{Code: invalidRequestCode,
+			Message: "the jsonrpc field must be exactly \"" + jsonRPCVersion + "\""}
+	}
+	if parsedRequest.Method == "" {
+		return nil, &responseError{Code: invalidRequestCode, Message: "the method field is required"}
+	}
+	return parsedRequest, nil
+}
+
+// decodeRequestMessage converts the method and params of the given request
+// to the RPC request message they represent
+func decodeRequestMessage(parsedRequest *request) (appmessage.Message, *responseError) {
+	methodNotFoundError := &responseError{Code: methodNotFoundCode,
+		Message: "method " + parsedRequest.Method + " does not exist"}
+
+	field := payloadOneof.Fields().ByJSONName(parsedRequest.Method + requestFieldSuffix)
+	if field == nil {
+		return nil, methodNotFoundError
+	}
+
+	kaspadMessage := &protowire.KaspadMessage{}
+	reflectMessage := kaspadMessage.ProtoReflect()
+	payload := reflectMessage.NewField(field)
+
+	params := parsedRequest.Params
+	if len(params) == 0 || bytes.Equal(params, []byte("null")) {
+		params = []byte("{}")
+	}
+	err := protojson.Unmarshal(params, payload.Message().Interface())
+	if err != nil {
+		return nil, &responseError{Code: invalidParamsCode, Message: err.Error()}
+	}
+	reflectMessage.Set(field, payload)
+
+	message, err := kaspadMessage.ToAppMessage()
+	if err != nil {
+		return nil, &responseError{Code: invalidParamsCode, Message: err.Error()}
+	}
+
+	// P2P messages share the KaspadMessage payload with RPC messages, and must not be reachable
+	if _, ok := appmessage.RPCMessageCommandToString[message.Command()]; !ok {
+		return nil, methodNotFoundError
+	}
+	return message, nil
+}
+
+// encodedMessage is an outgoing RPC message in its JSON-RPC form
+type encodedMessage struct {
+	// name is the name of the message's field in the KaspadMessage payload
+	name string
+
+	// payload is the JSON encoding of the message
+	payload json.RawMessage
+
+	// rpcError is the error the message carries, if any
+	rpcError *responseError
+}
+
+func (m *encodedMessage) isNotification() bool {
+	return strings.HasSuffix(m.name, notificationFieldSuffix)
+}
+
+// encodeMessage converts the given outgoing RPC message to JSON
+func encodeMessage(message appmessage.Message) (*encodedMessage, error) {
+	kaspadMessage, err := protowire.FromAppMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	reflectMessage := kaspadMessage.ProtoReflect()
+	field := reflectMessage.WhichOneof(payloadOneof)
+	if field == nil {
+		return nil, errors.Errorf("message %s has no payload", message.Command())
+	}
+	payload := reflectMessage.Get(field).Message()
+
+	encoded := &encodedMessage{name: field.JSONName()}
+	errorField := payload.Descriptor().Fields().ByName("error")
+	if errorField != nil && errorField.Kind() == protoreflect.MessageKind && payload.Has(errorField) {
+		rpcError := payload.Get(errorField).Message().Interface().(*protowire.RPCError)
+		encoded.rpcError = &responseError{Code: rpcErrorCode, Message: rpcError.Message}
+		return encoded, nil
+	}
+
+	encoded.payload, err = protojson.Marshal(payload.Interface())
+	if err != nil {
+		return nil, err
+	}
+	return encoded, nil
+}
+
+func encodeResponse(id json.RawMessage, result json.RawMessage, responseErr *responseError) []byte {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	// Marshalling these types only fails on invalid raw messages, which are never produced here
+	data, err := json.Marshal(&response{JSONRPC: jsonRPCVersion, ID: id, Result: result, Error: responseErr})
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+func encodeNotification(method string, params json.RawMessage) []byte {
+	data, err := json.Marshal(&notification{JSONRPC: jsonRPCVersion, Method: method, Params: params})
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
@@ -0,0 +1,345 @@
+package jsonrpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+)
+
+var errConnectionClosed = errors.New("connection closed")
+
+// jsonRPCConnection is a connection of a single JSON-RPC client. WebSocket
+// connections live for as long as the WebSocket is open, while HTTP
+// connections only live for the duration of a single HTTP request.
+type jsonRPCConnection struct {
+	address *net.TCPAddr
+	router  *router.Router
+	roles   rpcauth.Roles
+
+	// webSocket is nil for HTTP connections
+	webSocket *websocket.Conn
+
+	// httpResponses receives the responses of HTTP connections. A nil
+	// response is received for requests that expect no response.
+	httpResponses chan []byte
+
+	// pendingRequestIDs are the IDs of the requests that were passed to the
+	// router and were not responded to yet, in the order they were received.
+	// RPC requests are handled in order, so every outgoing response belongs to
+	// the first pending request. An empty ID marks a JSON-RPC notification,
+	// whose response is not sent.
+	pendingRequestIDs     []json.RawMessage
+	pendingRequestIDsLock sync.Mutex
+
+	// sendLock makes sure that WebSocket messages are not interleaved
+	sendLock sync.Mutex
+
+	messageNumber uint64
+
+	stopChan                chan struct{}
+	onDisconnectedHandler   server.OnDisconnectedHandler
+	onInvalidMessageHandler server.OnInvalidMessageHandler
+
+	isConnected uint32
+}
+
+func newWebSocketConnection(address *net.TCPAddr, webSocket *websocket.Conn, roles rpcauth.Roles) *jsonRPCConnection {
+	return &jsonRPCConnection{
+		address:     address,
+		roles:       roles,
+		webSocket:   webSocket,
+		stopChan:    make(chan struct{}),
+		isConnected: 1,
+	}
+}
+
+func newHTTPConnection(address *net.TCPAddr, roles rpcauth.Roles) *jsonRPCConnection {
+	return &jsonRPCConnection{
+		address:       address,
+		roles:         roles,
+		httpResponses: make(chan []byte),
+		stopChan:      make(chan struct{}),
+		isConnected:   1,
+	}
+}
+
+func (c *jsonRPCConnection) Start(router *router.Router) {
+	if c.onDisconnectedHandler == nil {
+		panic(errors.New("onDisconnectedHandler is nil"))
+	}
+
+	c.router = router
+
+	spawn("jsonRPCConnection.Start-sendLoop", func() {
+		err := c.sendLoop()
+		if err != nil {
+			log.Warnf("Error sending JSON-RPC messages to %s: %s", c, err)
+		}
+		c.Disconnect()
+	})
+	if c.isWebSocket() {
+		spawn("jsonRPCConnection.Start-receiveLoop", func() {
+			err := c.receiveLoop()
+			if err != nil {
+				log.Debugf("Error receiving JSON-RPC messages from %s: %s", c, err)
+			}
+			c.Disconnect()
+		})
+	}
+}
+
+func (c *jsonRPCConnection) String() string {
+	return c.Address().String()
+}
+
+func (c *jsonRPCConnection) IsConnected() bool {
+	return atomic.LoadUint32(&c.isConnected) != 0
+}
+
+func (c *jsonRPCConnection) SetOnDisconnectedHandler(onDisconnectedHandler server.OnDisconnectedHandler) {
+	c.onDisconnectedHandler = onDisconnectedHandler
+}
+
+func (c *jsonRPCConnection) SetOnInvalidMessageHandler(onInvalidMessageHandler server.OnInvalidMessageHandler) {
+	c.onInvalidMessageHandler = onInvalidMessageHandler
+}
+
+func (c *jsonRPCConnection) IsOutbound() bool {
+	return false
+}
+
+// Disconnect disconnects the connection
+// Calling this function a second time doesn't do anything
+//
+// This is part of the Connection interface
+func (c *jsonRPCConnection) Disconnect() {
+	if !atomic.CompareAndSwapUint32(&c.isConnected, 1, 0) {
+		return
+	}
+
+	close(c.stopChan)
+
+	if c.isWebSocket() {
+		// ignore error because we don't really know what's the status of the connection
+		_ = c.webSocket.Close()
+	}
+
+	log.Debugf("Disconnecting from %s", c)
+	if c.onDisconnectedHandler != nil {
+		c.onDisconnectedHandler()
+	}
+}
+
+func (c *jsonRPCConnection) Address() *net.TCPAddr {
+	return c.address
+}
+
+func (c *jsonRPCConnection) RPCRoles() rpcauth.Roles {
+	return c.roles
+}
+
+func (c *jsonRPCConnection) isWebSocket() bool {
+	return c.webSocket != nil
+}
+
+// handleRequest parses the given request and passes it to the router.
+// It returns the error response to send to the client if the request
+// could not be passed to the router, and whether it was passed.
+func (c *jsonRPCConnection) handleRequest(data []byte) (errorResponse []byte, isDispatched bool, err error) {
+	parsedRequest, responseErr := parseRequest(data)
+	if responseErr != nil {
+		return encodeResponse(nil, nil, responseErr), false, nil
+	}
+	errorResponseFor := func(responseErr *responseError) []byte {
+		if parsedRequest.isNotification() {
+			return nil
+		}
+		return encodeResponse(parsedRequest.ID, nil, responseErr)
+	}
+
+	if !c.isWebSocket() && isSubscriptionMethod(parsedRequest.Method) {
+		return errorResponseFor(&responseError{Code: methodNotFoundCode,
+			Message: "notifications are only available over WebSocket"}), false, nil
+	}
+	if !c.isWebSocket() && isStreamingMethod(parsedRequest.Method) {
+		return errorResponseFor(&responseError{Code: methodNotFoundCode,
+			Message: "streaming methods are only available over WebSocket"}), false, nil
+	}
+
+	message, responseErr := decodeRequestMessage(parsedRequest)
+	if responseErr != nil {
+		return errorResponseFor(responseErr), false, nil
+	}
+
+	c.messageNumber++
+	message.SetMessageNumber(c.messageNumber)
+	message.SetReceivedAt(time.Now())
+
+	log.Debugf("incoming '%s' message from %s (message number %d)", message.Command(), c,
+		message.MessageNumber())
+
+	c.pushPendingRequestID(parsedRequest.ID)
+	err = c.router.EnqueueIncomingMessage(message)
+	if err != nil {
+		c.removeLastPendingRequestID()
+		if errors.Is(err, router.ErrRouteClosed) || errors.Is(err, router.ErrRouteCapacityReached) {
+			return nil, false, err
+		}
+		return errorResponseFor(&responseError{Code: methodNotFoundCode, Message: err.Error()}), false, nil
+	}
+	return nil, true, nil
+}
+
+// handleHTTPRequest handles a single request received over HTTP, and
+// returns its response. A nil response is returned for JSON-RPC notifications.
+func (c *jsonRPCConnection) handleHTTPRequest(ctx context.Context, data []byte) ([]byte, error) {
+	errorResponse, isDispatched, err := c.handleRequest(data)
+	if err != nil || !isDispatched {
+		return errorResponse, err
+	}
+
+	select {
+	case response := <-c.httpResponses:
+		return response, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.stopChan:
+		return nil, errConnectionClosed
+	}
+}
+
+func (c *jsonRPCConnection) receiveLoop() error {
+	for c.IsConnected() {
+		var data []byte
+		err := websocket.Message.Receive(c.webSocket, &data)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		if isBatch(data) {
+			err = c.send(encodeResponse(nil, nil, &responseError{Code: invalidRequestCode,
+				Message: "batch requests are only available over HTTP"}))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		errorResponse, _, err := c.handleRequest(data)
+		if err != nil {
+			if errors.Is(err, router.ErrRouteClosed) {
+				return nil
+			}
+			return err
+		}
+		if errorResponse != nil {
+			err = c.send(errorResponse)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *jsonRPCConnection) sendLoop() error {
+	outgoingRoute := c.router.OutgoingRoute()
+	for c.IsConnected() {
+		message, err := outgoingRoute.Dequeue()
+		if err != nil {
+			if errors.Is(err, router.ErrRouteClosed) {
+				return nil
+			}
+			return err
+		}
+
+		log.Debugf("outgoing '%s' message to %s", message.Command(), c)
+
+		encoded, err := encodeMessage(message)
+		if err != nil {
+			return err
+		}
+
+		if encoded.isNotification() {
+			err = c.send(encodeNotification(encoded.name, encoded.payload))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		id, ok := c.popPendingRequestID()
+		if !ok {
+			return errors.Errorf("got response '%s' with no pending request", message.Command())
+		}
+		var response []byte
+		if len(id) > 0 {
+			response = encodeResponse(id, encoded.payload, encoded.rpcError)
+		}
+		err = c.send(response)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// send sends the given message to the client. Over WebSocket, nil messages
+// are ignored, while over HTTP they signal that a request was handled
+// without a response.
+func (c *jsonRPCConnection) send(message []byte) error {
+	if !c.isWebSocket() {
+		select {
+		case c.httpResponses <- message:
+			return nil
+		case <-c.stopChan:
+			return errConnectionClosed
+		}
+	}
+
+	if message == nil {
+		return nil
+	}
+	c.sendLock.Lock()
+	defer c.sendLock.Unlock()
+	return websocket.Message.Send(c.webSocket, string(message))
+}
+
+func (c *jsonRPCConnection) pushPendingRequestID(id json.RawMessage) {
+	c.pendingRequestIDsLock.Lock()
+	defer c.pendingRequestIDsLock.Unlock()
+
+	c.pendingRequestIDs = append(c.pendingRequestIDs, id)
+}
+
+func (c *jsonRPCConnection) removeLastPendingRequestID() {
+	c.pendingRequestIDsLock.Lock()
+	defer c.pendingRequestIDsLock.Unlock()
+
+	c.pendingRequestIDs = c.pendingRequestIDs[:len(c.pendingRequestIDs)-1]
+}
+
+func (c *jsonRPCConnection) popPendingRequestID() (json.RawMessage, bool) {
+	c.pendingRequestIDsLock.Lock()
+	defer c.pendingRequestIDsLock.Unlock()
+
+	if len(c.pendingRequestIDs) == 0 {
+		return nil, false
+	}
+	id := c.pendingRequestIDs[0]
+	c.pendingRequestIDs = c.pendingRequestIDs[1:]
+	return id, true
+}
@@ -0,0 +1,296 @@
+package jsonrpcserver
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
+	"github.com/kaspanet/kaspad/util/panics"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+)
+
+// maxHTTPRequestSize is the max size of the body of an HTTP request
+const maxHTTPRequestSize = 32 * 1024 * 1024 // 32 MB
+
+type jsonRPCServer struct {
+	onConnectedHandler server.OnConnectedHandler
+	listeningAddresses []string
+	allowedOrigins     []string
+	authenticator      *rpcauth.Authenticator
+	tlsConfig          *tls.Config
+	httpServer         *http.Server
+	webSocketServer    websocket.Server
+
+	maxWebSockets            int
+	webSocketConnections     map[*jsonRPCConnection]struct{}
+	webSocketConnectionsLock sync.Mutex
+}
+
+// NewJSONRPCServer creates a new server which serves JSON-RPC 2.0 over both
+// HTTP and WebSocket on the given addresses. Browsers are only allowed to
+// connect from the given origins, where "*" allows any origin.
+// Clients are authenticated by the Authorization header of the HTTP request
+// or of the WebSocket handshake. Connections are served over TLS if
+// tlsConfig is not nil.
+func NewJSONRPCServer(listeningAddresses []string, allowedOrigins []string, maxWebSockets int,
+	authenticator *rpcauth.Authenticator, tlsConfig *tls.Config) server.Server {
+
+	s := &jsonRPCServer{
+		listeningAddresses:   listeningAddresses,
+		allowedOrigins:       allowedOrigins,
+		authenticator:        authenticator,
+		tlsConfig:            tlsConfig,
+		maxWebSockets:        maxWebSockets,
+		webSocketConnections: make(map[*jsonRPCConnection]struct{}),
+	}
+	s.httpServer = &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	// The origin is already checked in ServeHTTP, and clients other than
+	// browsers don't send one at all, so no handshake check is required
+	s.webSocketServer = websocket.Server{Handler: s.handleWebSocket}
+	return s
+}
+
+func (s *jsonRPCServer) Start() error {
+	if s.onConnectedHandler == nil {
+		return errors.New("onConnectedHandler is nil")
+	}
+
+	for _, listenAddress := range s.listeningAddresses {
+		err := s.listenOn(listenAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *jsonRPCServer) listenOn(listenAddress string) error {
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return errors.Wrapf(err, "JSON-RPC error listening on %s", listenAddress)
+	}
+	if s.tlsConfig != nil {
+		listener = tls.NewListener(listener, s.tlsConfig)
+	}
+
+	spawn("jsonRPCServer.listenOn-Serve", func() {
+		err := s.httpServer.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panics.Exit(log, fmt.Sprintf("error serving JSON-RPC on %s: %+v", listenAddress, err))
+		}
+	})
+
+	log.Infof("JSON-RPC Server listening on %s", listener.Addr())
+	return nil
+}
+
+func (s *jsonRPCServer) Stop() error {
+	// WebSocket connections are hijacked from the HTTP server, so they are
+	// not closed along with it
+	s.webSocketConnectionsLock.Lock()
+	connections := make([]*jsonRPCConnection, 0, len(s.webSocketConnections))
+	for connection := range s.webSocketConnections {
+		connections = append(connections, connection)
+	}
+	s.webSocketConnectionsLock.Unlock()
+	for _, connection := range connections {
+		connection.Disconnect()
+	}
+
+	return s.httpServer.Close()
+}
+
+func (s *jsonRPCServer) SetOnConnectedHandler(onConnectedHandler server.OnConnectedHandler) {
+	s.onConnectedHandler = onConnectedHandler
+}
+
+func (s *jsonRPCServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	origin := request.Header.Get("Origin")
+	if origin != "" {
+		if !s.isOriginAllowed(origin) {
+			http.Error(writer, "origin not allowed", http.StatusForbidden)
+			return
+		}
+		writer.Header().Set("Access-Control-Allow-Origin", origin)
+		writer.Header().Set("Vary", "Origin")
+	}
+
+	isWebSocket := strings.EqualFold(request.Header.Get("Upgrade"), "websocket")
+
+	// Browsers don't send credentials with preflight requests
+	if request.Method == http.MethodOptions && !isWebSocket {
+		writer.Header().Set("Access-Control-Allow-Methods", http.MethodPost)
+		writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		writer.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	roles, err := s.authenticator.Authenticate(request.Header.Get(rpcauth.AuthorizationHeader))
+	if err != nil {
+		log.Warnf("Rejected an unauthenticated JSON-RPC request from %s: %s", request.RemoteAddr, err)
+		writer.Header().Set("WWW-Authenticate", `Basic realm="kaspad"`)
+		http.Error(writer, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	if isWebSocket {
+		s.webSocketServer.ServeHTTP(writer, request)
+		return
+	}
+
+	switch request.Method {
+	case http.MethodPost:
+		s.handleHTTP(writer, request, roles)
+	default:
+		writer.Header().Set("Allow", http.MethodPost)
+		http.Error(writer, "only POST requests are supported", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *jsonRPCServer) isOriginAllowed(origin string) bool {
+	for _, allowedOrigin := range s.allowedOrigins {
+		if allowedOrigin == "*" || strings.EqualFold(allowedOrigin, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *jsonRPCServer) handleHTTP(writer http.ResponseWriter, request *http.Request, roles rpcauth.Roles) {
+	address, err := net.ResolveTCPAddr("tcp", request.RemoteAddr)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(writer, request.Body, maxHTTPRequestSize))
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	var rawRequests []json.RawMessage
+	batch := isBatch(body)
+	if batch {
+		err = json.Unmarshal(body, &rawRequests)
+		if err != nil {
+			writeJSON(writer, encodeResponse(nil, nil, &responseError{Code: parseErrorCode, Message: err.Error()}))
+			return
+		}
+		if len(rawRequests) == 0 {
+			writeJSON(writer, encodeResponse(nil, nil, &responseError{Code: invalidRequestCode,
+				Message: "empty batch"}))
+			return
+		}
+	} else {
+		rawRequests = []json.RawMessage{body}
+	}
+
+	connection := newHTTPConnection(address, roles)
+	err = s.onConnectedHandler(connection)
+	if err != nil {
+		log.Warnf("Error handling JSON-RPC connection from %s: %s", address, err)
+		http.Error(writer, "internal error", http.StatusInternalServerError)
+		return
+	}
+	defer connection.Disconnect()
+
+	responses := make([]json.RawMessage, 0, len(rawRequests))
+	for _, rawRequest := range rawRequests {
+		response, err := connection.handleHTTPRequest(request.Context(), rawRequest)
+		if err != nil {
+			log.Debugf("Error handling JSON-RPC request from %s: %s", address, err)
+			response = encodeResponse(nil, nil, &responseError{Code: internalErrorCode, Message: err.Error()})
+		}
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+
+	if len(responses) == 0 {
+		writer.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if !batch {
+		writeJSON(writer, responses[0])
+		return
+	}
+	data, err := json.Marshal(responses)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(writer, data)
+}
+
+func writeJSON(writer http.ResponseWriter, data []byte) {
+	writer.Header().Set("Content-Type", "application/json")
+	// The client might have gone away already, in which case there's nothing left to do
+	_, _ = writer.Write(data)
+}
+
+func (s *jsonRPCServer) handleWebSocket(webSocket *websocket.Conn) {
+	request := webSocket.Request()
+	address, err := net.ResolveTCPAddr("tcp", request.RemoteAddr)
+	if err != nil {
+		log.Warnf("Could not resolve WebSocket client address %s: %s", request.RemoteAddr, err)
+		return
+	}
+
+	// The credentials were already checked before the handshake, so this only
+	// resolves the roles they grant
+	roles, err := s.authenticator.Authenticate(request.Header.Get(rpcauth.AuthorizationHeader))
+	if err != nil {
+		log.Warnf("Rejected an unauthenticated WebSocket connection from %s: %s", address, err)
+		return
+	}
+
+	connection := newWebSocketConnection(address, webSocket, roles)
+	if !s.addWebSocketConnection(connection) {
+		log.Warnf("WebSocket connection from %s was rejected: max WebSocket connections (%d) reached",
+			address, s.maxWebSockets)
+		return
+	}
+	defer s.removeWebSocketConnection(connection)
+
+	err = s.onConnectedHandler(connection)
+	if err != nil {
+		log.Warnf("Error handling JSON-RPC connection from %s: %s", address, err)
+		return
+	}
+	log.Infof("JSON-RPC WebSocket connection from %s", address)
+
+	// The WebSocket is closed once this function returns, so wait
+	// until the connection is disconnected
+	<-connection.stopChan
+}
+
+func (s *jsonRPCServer) addWebSocketConnection(connection *jsonRPCConnection) bool {
+	s.webSocketConnectionsLock.Lock()
+	defer s.webSocketConnectionsLock.Unlock()
+
+	if len(s.webSocketConnections) >= s.maxWebSockets {
+		return false
+	}
+	s.webSocketConnections[connection] = struct{}{}
+	return true
+}
+
+func (s *jsonRPCServer) removeWebSocketConnection(connection *jsonRPCConnection) {
+	s.webSocketConnectionsLock.Lock()
+	defer s.webSocketConnectionsLock.Unlock()
+
+	delete(s.webSocketConnections, connection)
+}
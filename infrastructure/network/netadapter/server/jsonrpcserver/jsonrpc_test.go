@@ -0,0 +1,175 @@
+package jsonrpcserver
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+	"github.com/kaspanet/kaspad/util"
+)
+
+func TestDecodeRequestMessage(t *testing.T) {
+	tests := []struct {
+		name              string
+		request           string
+		expectedCommand   appmessage.MessageCommand
+		expectedErrorCode int
+	}{
+		{
+			name:            "no params",
+			request:         `{"jsonrpc":"2.0","id":1,"method":"getInfo"}`,
+			expectedCommand: appmessage.CmdGetInfoRequestMessage,
+		},
+		{
+			name:            "with params",
+			request:         `{"jsonrpc":"2.0","id":"a","method":"getBlock","params":{"hash":"00","includeTransactions":true}}`,
+			expectedCommand: appmessage.CmdGetBlockRequestMessage,
+		},
+		{
+			name:              "invalid JSON",
+			request:           `{"jsonrpc":"2.0","id":1,"method":`,
+			expectedErrorCode: parseErrorCode,
+		},
+		{
+			name:              "wrong version",
+			request:           `{"jsonrpc":"1.0","id":1,"method":"getInfo"}`,
+			expectedErrorCode: invalidRequestCode,
+		},
+		{
+			name:              "unknown method",
+			request:           `{"jsonrpc":"2.0","id":1,"method":"getNothing"}`,
+			expectedErrorCode: methodNotFoundCode,
+		},
+		{
+			name:              "P2P message",
+			request:           `{"jsonrpc":"2.0","id":1,"method":"ping"}`,
+			expectedErrorCode: methodNotFoundCode,
+		},
+		{
+			name:              "positional params",
+			request:           `{"jsonrpc":"2.0","id":1,"method":"getBlock","params":["00"]}`,
+			expectedErrorCode: invalidParamsCode,
+		},
+	}
+
+	for _, test := range tests {
+		parsedRequest, responseErr := parseRequest([]byte(test.request))
+		var message appmessage.Message
+		if responseErr == nil {
+			message, responseErr = decodeRequestMessage(parsedRequest)
+		}
+		if test.expectedErrorCode != 0 {
+			if responseErr == nil {
+				t.Fatalf("%s: expected error code %d but got no error", test.name, test.expectedErrorCode)
+			}
+			if responseErr.Code != test.expectedErrorCode {
+				t.Fatalf("%s: expected error code %d but got %d: %s",
+					test.name, test.expectedErrorCode, responseErr.Code, responseErr.Message)
+			}
+			continue
+		}
+		if responseErr != nil {
+			t.Fatalf("%s: unexpected error: %s", test.name, responseErr.Message)
+		}
+		if message.Command() != test.expectedCommand {
+			t.Fatalf("%s: expected command %s but got %s", test.name, test.expectedCommand, message.Command())
+		}
+	}
+}
+
+func TestEncodeMessage(t *testing.T) {
+	encoded, err := encodeMessage(&appmessage.GetBlockCountResponseMessage{BlockCount: 5, HeaderCount: 6})
+	if err != nil {
+		t.Fatalf("encodeMessage: %s", err)
+	}
+	if encoded.isNotification() || encoded.rpcError != nil {
+		t.Fatalf("Unexpected encoded response: %+v", encoded)
+	}
+	var result struct {
+		BlockCount  string `json:"blockCount"`
+		HeaderCount string `json:"headerCount"`
+	}
+	err = json.Unmarshal(encoded.payload, &result)
+	if err != nil {
+		t.Fatalf("Unmarshal: %s", err)
+	}
+	if result.BlockCount != "5" || result.HeaderCount != "6" {
+		t.Fatalf("Unexpected result %s", encoded.payload)
+	}
+
+	encoded, err = encodeMessage(&appmessage.GetBlockCountResponseMessage{Error: appmessage.RPCErrorf("oops")})
+	if err != nil {
+		t.Fatalf("encodeMessage: %s", err)
+	}
+	if encoded.rpcError == nil || encoded.rpcError.Code != rpcErrorCode || encoded.rpcError.Message != "oops" {
+		t.Fatalf("Unexpected error %+v", encoded.rpcError)
+	}
+
+	encoded, err = encodeMessage(appmessage.NewVirtualDaaScoreChangedNotificationMessage(7))
+	if err != nil {
+		t.Fatalf("encodeMessage: %s", err)
+	}
+	if !encoded.isNotification() || encoded.name != "virtualDaaScoreChangedNotification" {
+		t.Fatalf("Unexpected encoded notification: %+v", encoded)
+	}
+
+	encoded, err = encodeMessage(appmessage.NewUTXOsByAddressesChunkNotificationMessage(nil))
+	if err != nil {
+		t.Fatalf("encodeMessage: %s", err)
+	}
+	if !encoded.isNotification() || encoded.name != "utxosByAddressesChunkNotification" {
+		t.Fatalf("Unexpected encoded notification: %+v", encoded)
+	}
+}
+
+func TestServeOverTLS(t *testing.T) {
+	cert, key, err := util.NewTLSCertPair("test", time.Now().Add(time.Hour), nil)
+	if err != nil {
+		t.Fatalf("NewTLSCertPair: %+v", err)
+	}
+	certificate, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		t.Fatalf("X509KeyPair: %+v", err)
+	}
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{certificate}, MinVersion: tls.VersionTLS12}
+
+	// Find a free port for the server to listen on
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %+v", err)
+	}
+	address := listener.Addr().String()
+	listener.Close()
+
+	jsonRPCServer := NewJSONRPCServer([]string{address}, nil, 1, nil, tlsConfig)
+	jsonRPCServer.SetOnConnectedHandler(func(server.Connection) error { return nil })
+	err = jsonRPCServer.Start()
+	if err != nil {
+		t.Fatalf("Start: %+v", err)
+	}
+	defer jsonRPCServer.Stop()
+
+	// Plain HTTP requests never reach the handler, which only accepts POST requests
+	response, err := http.Get("http://" + address)
+	if err == nil {
+		response.Body.Close()
+		if response.StatusCode == http.StatusMethodNotAllowed {
+			t.Fatalf("A plain HTTP request was served by a server that requires TLS")
+		}
+	}
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	response, err = client.Get("https://" + address)
+	if err != nil {
+		t.Fatalf("Get: %+v", err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("Expected status %d over TLS, got %d", http.StatusMethodNotAllowed, response.StatusCode)
+	}
+}
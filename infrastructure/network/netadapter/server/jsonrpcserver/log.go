@@ -0,0 +1,9 @@
+package jsonrpcserver
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/util/panics"
+)
+
+var log = logger.RegisterSubSystem("RPCS")
+var spawn = panics.GoroutineWrapperFunc(log)
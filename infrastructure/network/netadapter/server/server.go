@@ -5,6 +5,7 @@ import (
 	"net"
 
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
 )
 
 // OnConnectedHandler is a function that is to be called
@@ -43,4 +44,5 @@ type Connection interface {
 	SetOnDisconnectedHandler(onDisconnectedHandler OnDisconnectedHandler)
 	SetOnInvalidMessageHandler(onInvalidMessageHandler OnInvalidMessageHandler)
 	Address() *net.TCPAddr
+	RPCRoles() rpcauth.Roles
 }
@@ -0,0 +1,172 @@
+package rpcauth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// AuthorizationHeader is the name of the header, or gRPC metadata key,
+// in which clients pass their credentials
+const AuthorizationHeader = "authorization"
+
+const (
+	basicScheme  = "Basic "
+	bearerScheme = "Bearer "
+)
+
+// ErrUnauthenticated is returned when the credentials of a client are missing or invalid
+var ErrUnauthenticated = errors.New("invalid or missing RPC credentials")
+
+type user struct {
+	passwordHash [sha256.Size]byte
+	roles        Roles
+}
+
+type token struct {
+	hash  [sha256.Size]byte
+	roles Roles
+}
+
+// Authenticator authenticates RPC clients by either a username and password,
+// or a bearer token, and resolves the roles granted to them
+type Authenticator struct {
+	users  map[string]*user
+	tokens []*token
+}
+
+// NewAuthenticator creates an Authenticator from the given users, each in the form
+// <username>:<password>:<roles>, and tokens, each in the form <token>:<roles>, where
+// <roles> is a comma separated list of role names. If no users or tokens are given,
+// authentication is disabled and every client is granted all roles.
+func NewAuthenticator(users []string, tokens []string) (*Authenticator, error) {
+	authenticator := &Authenticator{users: make(map[string]*user)}
+
+	for _, userString := range users {
+		firstSeparatorIndex := strings.Index(userString, ":")
+		lastSeparatorIndex := strings.LastIndex(userString, ":")
+		if firstSeparatorIndex <= 0 || firstSeparatorIndex == lastSeparatorIndex {
+			return nil, errors.Errorf("RPC user must be in the form <username>:<password>:<roles>")
+		}
+		username := userString[:firstSeparatorIndex]
+		password := userString[firstSeparatorIndex+1 : lastSeparatorIndex]
+		if password == "" {
+			return nil, errors.Errorf("RPC user %s must have a password", username)
+		}
+		roles, err := ParseRoles(userString[lastSeparatorIndex+1:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid roles for RPC user %s", username)
+		}
+		if _, ok := authenticator.users[username]; ok {
+			return nil, errors.Errorf("RPC user %s is defined more than once", username)
+		}
+		authenticator.users[username] = &user{passwordHash: sha256.Sum256([]byte(password)), roles: roles}
+	}
+
+	for _, tokenString := range tokens {
+		separatorIndex := strings.LastIndex(tokenString, ":")
+		if separatorIndex <= 0 {
+			return nil, errors.Errorf("RPC token must be in the form <token>:<roles>")
+		}
+		roles, err := ParseRoles(tokenString[separatorIndex+1:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid roles for RPC token")
+		}
+		authenticator.tokens = append(authenticator.tokens,
+			&token{hash: sha256.Sum256([]byte(tokenString[:separatorIndex])), roles: roles})
+	}
+
+	return authenticator, nil
+}
+
+// IsEnabled returns whether clients are required to authenticate.
+// A nil Authenticator is disabled.
+func (a *Authenticator) IsEnabled() bool {
+	return a != nil && (len(a.users) > 0 || len(a.tokens) > 0)
+}
+
+// Authenticate returns the roles granted to the client with the given value of the
+// authorization header. If authentication is disabled, all roles are granted.
+func (a *Authenticator) Authenticate(authorization string) (Roles, error) {
+	if !a.IsEnabled() {
+		return AllRoles, nil
+	}
+
+	switch {
+	case strings.HasPrefix(authorization, basicScheme):
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(authorization, basicScheme))
+		if err != nil {
+			return 0, ErrUnauthenticated
+		}
+		username, password, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			return 0, ErrUnauthenticated
+		}
+		return a.authenticateUser(username, password)
+	case strings.HasPrefix(authorization, bearerScheme):
+		return a.authenticateToken(strings.TrimPrefix(authorization, bearerScheme))
+	default:
+		return 0, ErrUnauthenticated
+	}
+}
+
+func (a *Authenticator) authenticateUser(username string, password string) (Roles, error) {
+	passwordHash := sha256.Sum256([]byte(password))
+	user, ok := a.users[username]
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if subtle.ConstantTimeCompare(passwordHash[:], user.passwordHash[:]) != 1 {
+		return 0, ErrUnauthenticated
+	}
+	return user.roles, nil
+}
+
+func (a *Authenticator) authenticateToken(tokenString string) (Roles, error) {
+	tokenHash := sha256.Sum256([]byte(tokenString))
+	roles := Roles(0)
+	isFound := false
+	// All the tokens are compared, so that the time it takes doesn't depend on which token matched
+	for _, token := range a.tokens {
+		if subtle.ConstantTimeCompare(tokenHash[:], token.hash[:]) == 1 {
+			roles = token.roles
+			isFound = true
+		}
+	}
+	if !isFound {
+		return 0, ErrUnauthenticated
+	}
+	return roles, nil
+}
+
+// BasicAuthorization returns the value of the authorization header for the given username and password
+func BasicAuthorization(username string, password string) string {
+	return basicScheme + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
+
+// BearerAuthorization returns the value of the authorization header for the given token
+func BearerAuthorization(token string) string {
+	return bearerScheme + token
+}
+
+// ClientAuthorization returns the value of the authorization header for the given
+// client credentials, where either a username and password, a token, or neither,
+// may be set. An empty authorization is returned if no credentials were given.
+func ClientAuthorization(username string, password string, token string) (string, error) {
+	if token != "" {
+		if username != "" || password != "" {
+			return "", errors.New("an RPC token can't be used together with an RPC user")
+		}
+		return BearerAuthorization(token), nil
+	}
+	if username == "" && password == "" {
+		return "", nil
+	}
+	if username == "" || password == "" {
+		return "", errors.New("both an RPC user and an RPC password are required")
+	}
+	return BasicAuthorization(username, password), nil
+}
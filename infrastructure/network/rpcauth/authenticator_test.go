@@ -0,0 +1,81 @@
+package rpcauth
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAuthenticator(t *testing.T) {
+	authenticator, err := NewAuthenticator(
+		[]string{"miner:pass:word:mining", "explorer:secret:read-only"},
+		[]string{"wallettoken:wallet-submit", "admintoken:admin"})
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %s", err)
+	}
+	if !authenticator.IsEnabled() {
+		t.Fatalf("Authentication is unexpectedly disabled")
+	}
+
+	tests := []struct {
+		authorization string
+		expectedRoles Roles
+		expectedError error
+	}{
+		{authorization: BasicAuthorization("miner", "pass:word"), expectedRoles: Roles(RoleMining)},
+		{authorization: BasicAuthorization("explorer", "secret"), expectedRoles: Roles(RoleReadOnly)},
+		{authorization: BasicAuthorization("explorer", "wrong"), expectedError: ErrUnauthenticated},
+		{authorization: BasicAuthorization("unknown", "secret"), expectedError: ErrUnauthenticated},
+		{authorization: BearerAuthorization("wallettoken"), expectedRoles: Roles(RoleWalletSubmit)},
+		{authorization: BearerAuthorization("admintoken"), expectedRoles: Roles(RoleAdmin)},
+		{authorization: BearerAuthorization("wrongtoken"), expectedError: ErrUnauthenticated},
+		{authorization: "Basic not-base64", expectedError: ErrUnauthenticated},
+		{authorization: "", expectedError: ErrUnauthenticated},
+	}
+	for _, test := range tests {
+		roles, err := authenticator.Authenticate(test.authorization)
+		if !errors.Is(err, test.expectedError) {
+			t.Fatalf("Authenticate(%s): expected error %v but got %v", test.authorization, test.expectedError, err)
+		}
+		if roles != test.expectedRoles {
+			t.Fatalf("Authenticate(%s): expected roles %s but got %s", test.authorization, test.expectedRoles, roles)
+		}
+	}
+
+	disabledAuthenticator, err := NewAuthenticator(nil, nil)
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %s", err)
+	}
+	roles, err := disabledAuthenticator.Authenticate("")
+	if err != nil || roles != AllRoles {
+		t.Fatalf("Expected all roles to be granted when authentication is disabled")
+	}
+
+	for _, invalidUser := range []string{"user", "user:roles", ":pass:admin", "user::admin", "user:pass:superuser"} {
+		_, err := NewAuthenticator([]string{invalidUser}, nil)
+		if err == nil {
+			t.Fatalf("Expected an error for the RPC user %s", invalidUser)
+		}
+	}
+}
+
+func TestRolesAllows(t *testing.T) {
+	roles, err := ParseRoles("mining, wallet-submit")
+	if err != nil {
+		t.Fatalf("ParseRoles: %s", err)
+	}
+	if roles.String() != "mining,wallet-submit" {
+		t.Fatalf("Unexpected roles %s", roles)
+	}
+	if !roles.Allows(RoleReadOnly) || !roles.Allows(RoleMining) || !roles.Allows(RoleWalletSubmit) {
+		t.Fatalf("Expected %s to allow read-only, mining and wallet-submit methods", roles)
+	}
+	if roles.Allows(RoleAdmin) {
+		t.Fatalf("Expected %s not to allow admin methods", roles)
+	}
+	if !Roles(RoleAdmin).Allows(RoleMining) {
+		t.Fatalf("Expected admin to allow every method")
+	}
+	if Roles(0).Allows(RoleReadOnly) {
+		t.Fatalf("Expected no roles not to allow any method")
+	}
+}
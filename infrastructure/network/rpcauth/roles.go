@@ -0,0 +1,96 @@
+// Package rpcauth implements the authentication of RPC clients, and the roles
+// that determine which RPC methods an authenticated client may call.
+package rpcauth
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Role is a group of RPC methods that a client may be granted access to
+type Role uint8
+
+const (
+	// RoleReadOnly allows calling methods that only query the state of the node,
+	// and subscribing to notifications
+	RoleReadOnly Role = 1 << iota
+
+	// RoleMining allows getting block templates and submitting blocks
+	RoleMining
+
+	// RoleWalletSubmit allows submitting transactions
+	RoleWalletSubmit
+
+	// RoleAdmin allows calling any method, including ones that affect the
+	// state of the node such as ShutDown, Ban and AddPeer
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleReadOnly:     "read-only",
+	RoleMining:       "mining",
+	RoleWalletSubmit: "wallet-submit",
+	RoleAdmin:        "admin",
+}
+
+func (r Role) String() string {
+	name, ok := roleNames[r]
+	if !ok {
+		return "unknown"
+	}
+	return name
+}
+
+// Roles is a set of roles granted to a client
+type Roles uint8
+
+// AllRoles grants access to every RPC method. It is granted to all clients
+// when authentication is disabled.
+const AllRoles = Roles(RoleReadOnly | RoleMining | RoleWalletSubmit | RoleAdmin)
+
+// Allows returns whether a client with these roles may call methods that require
+// the given role. Every role also allows calling read-only methods, and the admin
+// role allows calling any method.
+func (r Roles) Allows(required Role) bool {
+	if r&Roles(RoleAdmin) != 0 {
+		return true
+	}
+	if required == RoleReadOnly {
+		return r != 0
+	}
+	return r&Roles(required) != 0
+}
+
+func (r Roles) String() string {
+	var names []string
+	for role, name := range roleNames {
+		if r&Roles(role) != 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// ParseRoles parses a comma separated list of role names
+func ParseRoles(roleNamesString string) (Roles, error) {
+	roles := Roles(0)
+	for _, roleName := range strings.Split(roleNamesString, ",") {
+		roleName = strings.TrimSpace(roleName)
+		found := false
+		for role, name := range roleNames {
+			if name == roleName {
+				roles |= Roles(role)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, errors.Errorf("unknown role '%s'. Expected one of read-only, mining, "+
+				"wallet-submit or admin", roleName)
+		}
+	}
+	return roles, nil
+}
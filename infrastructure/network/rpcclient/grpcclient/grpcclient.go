@@ -2,13 +2,17 @@ package grpcclient
 
 import (
 	"context"
+	"crypto/tls"
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
 	"io"
 	"time"
 )
@@ -29,17 +33,50 @@ type GRPCClient struct {
 
 // Connect connects to the RPC server with the given address
 func Connect(address string) (*GRPCClient, error) {
+	return ConnectWithAuthorization(address, "")
+}
+
+// ConnectWithAuthorization connects to the RPC server with the given address,
+// authenticating with the given value of the authorization header. See
+// rpcauth.BasicAuthorization and rpcauth.BearerAuthorization.
+func ConnectWithAuthorization(address string, authorization string) (*GRPCClient, error) {
+	return ConnectWithOptions(address, &ConnectOptions{Authorization: authorization})
+}
+
+// ConnectOptions are the options of a connection to an RPC server
+type ConnectOptions struct {
+	// Authorization is the value of the authorization header the client
+	// authenticates with. See rpcauth.BasicAuthorization and
+	// rpcauth.BearerAuthorization.
+	Authorization string
+
+	// TLSConfig is the TLS configuration of the connection. The
+	// connection is not encrypted if it's nil. See rpctls.ClientConfig.
+	TLSConfig *tls.Config
+}
+
+// ConnectWithOptions connects to the RPC server with the given address
+// using the given options
+func ConnectWithOptions(address string, options *ConnectOptions) (*GRPCClient, error) {
 	const dialTimeout = 5 * time.Second
 	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
 	defer cancel()
 
-	gRPCConnection, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	transportCredentials := grpc.WithInsecure()
+	if options.TLSConfig != nil {
+		transportCredentials = grpc.WithTransportCredentials(credentials.NewTLS(options.TLSConfig))
+	}
+	gRPCConnection, err := grpc.DialContext(ctx, address, transportCredentials, grpc.WithBlock())
 	if err != nil {
 		return nil, errors.Wrapf(err, "error connecting to %s", address)
 	}
 
+	streamContext := context.Background()
+	if options.Authorization != "" {
+		streamContext = metadata.AppendToOutgoingContext(streamContext, rpcauth.AuthorizationHeader, options.Authorization)
+	}
 	grpcClient := protowire.NewRPCClient(gRPCConnection)
-	stream, err := grpcClient.MessageStream(context.Background(), grpc.UseCompressor(gzip.Name),
+	stream, err := grpcClient.MessageStream(streamContext, grpc.UseCompressor(gzip.Name),
 		grpc.MaxCallRecvMsgSize(grpcserver.RPCMaxMessageSize), grpc.MaxCallSendMsgSize(grpcserver.RPCMaxMessageSize))
 	if err != nil {
 		return nil, errors.Wrapf(err, "error getting client stream for %s", address)
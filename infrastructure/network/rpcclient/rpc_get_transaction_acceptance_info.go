@@ -0,0 +1,22 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// GetTransactionAcceptanceInfo sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetTransactionAcceptanceInfo(transactionIDs []string) (
+	*appmessage.GetTransactionAcceptanceInfoResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetTransactionAcceptanceInfoRequestMessage(transactionIDs))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetTransactionAcceptanceInfoResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getTransactionAcceptanceInfoResponse := response.(*appmessage.GetTransactionAcceptanceInfoResponseMessage)
+	if getTransactionAcceptanceInfoResponse.Error != nil {
+		return nil, c.convertRPCError(getTransactionAcceptanceInfoResponse.Error)
+	}
+	return getTransactionAcceptanceInfoResponse, nil
+}
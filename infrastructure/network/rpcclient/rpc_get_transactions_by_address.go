@@ -0,0 +1,23 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// GetTransactionsByAddress sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetTransactionsByAddress(address string, startDAAScore uint64, startTransactionID string,
+	limit uint32) (*appmessage.GetTransactionsByAddressResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(
+		appmessage.NewGetTransactionsByAddressRequestMessage(address, startDAAScore, startTransactionID, limit))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetTransactionsByAddressResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getTransactionsByAddressResponse := response.(*appmessage.GetTransactionsByAddressResponseMessage)
+	if getTransactionsByAddressResponse.Error != nil {
+		return nil, c.convertRPCError(getTransactionsByAddressResponse.Error)
+	}
+	return getTransactionsByAddressResponse, nil
+}
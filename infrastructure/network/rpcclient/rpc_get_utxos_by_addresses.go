@@ -4,7 +4,23 @@ import "github.com/kaspanet/kaspad/app/appmessage"
 
 // GetUTXOsByAddresses sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) GetUTXOsByAddresses(addresses []string) (*appmessage.GetUTXOsByAddressesResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetUTXOsByAddressesRequestMessage(addresses))
+	return c.getUTXOsByAddresses(appmessage.NewGetUTXOsByAddressesRequestMessage(addresses))
+}
+
+// GetUTXOsByAddressesPage sends a GetUTXOsByAddresses RPC request for a single page of up to limit UTXOs
+// that match the given filter, and returns the RPC server's response. Pass the address and outpoint of the
+// last entry of a page as startAddress and startOutpoint to get the next page
+func (c *RPCClient) GetUTXOsByAddressesPage(addresses []string, filter *appmessage.UTXOsByAddressesFilter,
+	limit uint32, startAddress string, startOutpoint *appmessage.RPCOutpoint) (*appmessage.GetUTXOsByAddressesResponseMessage, error) {
+
+	return c.getUTXOsByAddresses(appmessage.NewGetUTXOsByAddressesPageRequestMessage(
+		addresses, filter, limit, startAddress, startOutpoint))
+}
+
+func (c *RPCClient) getUTXOsByAddresses(request *appmessage.GetUTXOsByAddressesRequestMessage) (
+	*appmessage.GetUTXOsByAddressesResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(request)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// LoadMempool sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) LoadMempool() (*appmessage.LoadMempoolResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewLoadMempoolRequestMessage())
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdLoadMempoolResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	loadMempoolResponse := response.(*appmessage.LoadMempoolResponseMessage)
+	if loadMempoolResponse.Error != nil {
+		return nil, c.convertRPCError(loadMempoolResponse.Error)
+	}
+	return loadMempoolResponse, nil
+}
@@ -11,8 +11,27 @@ import (
 func (c *RPCClient) RegisterForVirtualSelectedParentChainChangedNotifications(includeAcceptedTransactionIDs bool,
 	onChainChanged func(notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage)) error {
 
-	err := c.rpcRouter.outgoingRoute().Enqueue(
-		appmessage.NewNotifyVirtualSelectedParentChainChangedRequestMessage(includeAcceptedTransactionIDs))
+	return c.registerForVirtualSelectedParentChainChangedNotifications(
+		appmessage.NewNotifyVirtualSelectedParentChainChangedRequestMessage(includeAcceptedTransactionIDs), onChainChanged)
+}
+
+// ResumeVirtualSelectedParentChainChangedNotifications works like RegisterForVirtualSelectedParentChainChangedNotifications,
+// except that the RPC server first sends the notifications that came after the given sequence or chain block hash.
+// Exactly one of resumeAfterSequence and resumeAfterChainBlockHash must be set
+func (c *RPCClient) ResumeVirtualSelectedParentChainChangedNotifications(includeAcceptedTransactionIDs bool,
+	resumeAfterSequence uint64, resumeAfterChainBlockHash string,
+	onChainChanged func(notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage)) error {
+
+	return c.registerForVirtualSelectedParentChainChangedNotifications(
+		appmessage.NewResumeVirtualSelectedParentChainChangedRequestMessage(
+			includeAcceptedTransactionIDs, resumeAfterSequence, resumeAfterChainBlockHash), onChainChanged)
+}
+
+func (c *RPCClient) registerForVirtualSelectedParentChainChangedNotifications(
+	request *appmessage.NotifyVirtualSelectedParentChainChangedRequestMessage,
+	onChainChanged func(notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage)) error {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(request)
 	if err != nil {
 		return err
 	}
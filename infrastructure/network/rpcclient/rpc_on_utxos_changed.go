@@ -11,18 +11,33 @@ import (
 func (c *RPCClient) RegisterForUTXOsChangedNotifications(addresses []string,
 	onUTXOsChanged func(notification *appmessage.UTXOsChangedNotificationMessage)) error {
 
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyUTXOsChangedRequestMessage(addresses))
+	err := c.AddUTXOsChangedNotificationAddresses(addresses)
 	if err != nil {
 		return err
 	}
-	response, err := c.route(appmessage.CmdNotifyUTXOsChangedResponseMessage).DequeueWithTimeout(c.timeout)
+	c.handleUTXOsChangedNotifications(onUTXOsChanged)
+	return nil
+}
+
+// ResumeUTXOsChangedNotifications works like RegisterForUTXOsChangedNotifications, except that the RPC
+// server first sends the notifications that came after the given sequence or chain block hash. Exactly
+// one of resumeAfterSequence and resumeAfterChainBlockHash must be set
+func (c *RPCClient) ResumeUTXOsChangedNotifications(addresses []string,
+	resumeAfterSequence uint64, resumeAfterChainBlockHash string,
+	onUTXOsChanged func(notification *appmessage.UTXOsChangedNotificationMessage)) error {
+
+	err := c.notifyUTXOsChanged(
+		appmessage.NewResumeUTXOsChangedRequestMessage(addresses, resumeAfterSequence, resumeAfterChainBlockHash))
 	if err != nil {
 		return err
 	}
-	notifyUTXOsChangedResponse := response.(*appmessage.NotifyUTXOsChangedResponseMessage)
-	if notifyUTXOsChangedResponse.Error != nil {
-		return c.convertRPCError(notifyUTXOsChangedResponse.Error)
-	}
+	c.handleUTXOsChangedNotifications(onUTXOsChanged)
+	return nil
+}
+
+func (c *RPCClient) handleUTXOsChangedNotifications(
+	onUTXOsChanged func(notification *appmessage.UTXOsChangedNotificationMessage)) {
+
 	spawn("RegisterForUTXOsChangedNotifications", func() {
 		for {
 			notification, err := c.route(appmessage.CmdUTXOsChangedNotificationMessage).Dequeue()
@@ -36,5 +51,28 @@ func (c *RPCClient) RegisterForUTXOsChangedNotifications(addresses []string,
 			onUTXOsChanged(UTXOsChangedNotification)
 		}
 	})
+}
+
+// AddUTXOsChangedNotificationAddresses sends an RPC request instructing the RPC server to send UTXOs changed
+// notifications for the given addresses, in addition to the addresses it already sends them for. The
+// notifications are passed to the handler given to RegisterForUTXOsChangedNotifications, which must be
+// called first.
+func (c *RPCClient) AddUTXOsChangedNotificationAddresses(addresses []string) error {
+	return c.notifyUTXOsChanged(appmessage.NewNotifyUTXOsChangedRequestMessage(addresses))
+}
+
+func (c *RPCClient) notifyUTXOsChanged(request *appmessage.NotifyUTXOsChangedRequestMessage) error {
+	err := c.rpcRouter.outgoingRoute().Enqueue(request)
+	if err != nil {
+		return err
+	}
+	response, err := c.route(appmessage.CmdNotifyUTXOsChangedResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return err
+	}
+	notifyUTXOsChangedResponse := response.(*appmessage.NotifyUTXOsChangedResponseMessage)
+	if notifyUTXOsChangedResponse.Error != nil {
+		return c.convertRPCError(notifyUTXOsChangedResponse.Error)
+	}
 	return nil
 }
@@ -0,0 +1,33 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// StreamUTXOsByAddresses sends an RPC request respective to the function's name and calls onChunk
+// for every chunk of UTXOs sent by the RPC server. It returns the total amount of UTXOs that were
+// sent once the stream ends
+func (c *RPCClient) StreamUTXOsByAddresses(addresses []string, filter *appmessage.UTXOsByAddressesFilter, chunkSize uint32,
+	onChunk func(entries []*appmessage.UTXOsByAddressesEntry)) (uint64, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewStreamUTXOsByAddressesRequestMessage(addresses, filter, chunkSize))
+	if err != nil {
+		return 0, err
+	}
+	// Chunk notifications and the final response share a single route,
+	// so they're dequeued here in the order that the server sent them
+	route := c.route(appmessage.CmdStreamUTXOsByAddressesResponseMessage)
+	for {
+		message, err := route.DequeueWithTimeout(c.timeout)
+		if err != nil {
+			return 0, err
+		}
+		switch message := message.(type) {
+		case *appmessage.UTXOsByAddressesChunkNotificationMessage:
+			onChunk(message.Entries)
+		case *appmessage.StreamUTXOsByAddressesResponseMessage:
+			if message.Error != nil {
+				return 0, c.convertRPCError(message.Error)
+			}
+			return message.EntryCount, nil
+		}
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/kaspanet/kaspad/util/panics"
 	"github.com/kaspanet/kaspad/version"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const defaultTimeout = 30 * time.Second
@@ -20,6 +22,7 @@ type RPCClient struct {
 	*grpcclient.GRPCClient
 
 	rpcAddress           string
+	options              *grpcclient.ConnectOptions
 	rpcRouter            *rpcRouter
 	isConnected          uint32
 	isClosed             uint32
@@ -31,8 +34,21 @@ type RPCClient struct {
 
 // NewRPCClient сreates a new RPC client with a default call timeout value
 func NewRPCClient(rpcAddress string) (*RPCClient, error) {
+	return NewRPCClientWithAuthorization(rpcAddress, "")
+}
+
+// NewRPCClientWithAuthorization сreates a new RPC client that authenticates with the
+// given value of the authorization header. See rpcauth.BasicAuthorization and
+// rpcauth.BearerAuthorization.
+func NewRPCClientWithAuthorization(rpcAddress string, authorization string) (*RPCClient, error) {
+	return NewRPCClientWithOptions(rpcAddress, &grpcclient.ConnectOptions{Authorization: authorization})
+}
+
+// NewRPCClientWithOptions creates a new RPC client that connects using the given options
+func NewRPCClientWithOptions(rpcAddress string, options *grpcclient.ConnectOptions) (*RPCClient, error) {
 	rpcClient := &RPCClient{
 		rpcAddress: rpcAddress,
+		options:    options,
 		timeout:    defaultTimeout,
 	}
 	err := rpcClient.connect()
@@ -44,7 +60,7 @@ func NewRPCClient(rpcAddress string) (*RPCClient, error) {
 }
 
 func (c *RPCClient) connect() error {
-	rpcClient, err := grpcclient.Connect(c.rpcAddress)
+	rpcClient, err := grpcclient.ConnectWithOptions(c.rpcAddress, c.options)
 	if err != nil {
 		return errors.Wrapf(err, "error connecting to address %s", c.rpcAddress)
 	}
@@ -146,6 +162,16 @@ func (c *RPCClient) handleClientError(err error) {
 	if atomic.LoadUint32(&c.isClosed) == 1 {
 		return
 	}
+	// Reconnecting with the same credentials would be rejected again, so
+	// close the client, which fails all the pending requests
+	if status.Code(err) == codes.Unauthenticated {
+		log.Errorf("The RPC server rejected the credentials of the client: %s", err)
+		closeErr := c.Close()
+		if closeErr != nil {
+			log.Warnf("Error closing the client: %s", closeErr)
+		}
+		return
+	}
 	log.Warnf("Received error from client: %s", err)
 	c.handleClientDisconnected()
 }
@@ -5,6 +5,22 @@ import (
 	routerpkg "github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 )
 
+// sharedRouteMessageTypes lists groups of message types that must be read
+// from a single route, so that their relative order is preserved
+var sharedRouteMessageTypes = [][]appmessage.MessageCommand{
+	{appmessage.CmdUTXOsByAddressesChunkNotificationMessage, appmessage.CmdStreamUTXOsByAddressesResponseMessage},
+}
+
+// resumableNotificationRouteCapacity is the capacity of the routes of notifications
+// that may be replayed when resuming them. Missed notifications are replayed all
+// at once, so these routes must be able to hold more than the usual amount
+const resumableNotificationRouteCapacity = 1 << 14
+
+var resumableNotificationMessageTypes = map[appmessage.MessageCommand]struct{}{
+	appmessage.CmdVirtualSelectedParentChainChangedNotificationMessage: {},
+	appmessage.CmdUTXOsChangedNotificationMessage:                      {},
+}
+
 type rpcRouter struct {
 	router *routerpkg.Router
 	routes map[appmessage.MessageCommand]*routerpkg.Route
@@ -13,8 +29,24 @@ type rpcRouter struct {
 func buildRPCRouter() (*rpcRouter, error) {
 	router := routerpkg.NewRouter("RPC server")
 	routes := make(map[appmessage.MessageCommand]*routerpkg.Route, len(appmessage.RPCMessageCommandToString))
+	for _, messageTypes := range sharedRouteMessageTypes {
+		route, err := router.AddIncomingRoute("rpc client", messageTypes)
+		if err != nil {
+			return nil, err
+		}
+		for _, messageType := range messageTypes {
+			routes[messageType] = route
+		}
+	}
 	for messageType := range appmessage.RPCMessageCommandToString {
-		route, err := router.AddIncomingRoute("rpc client", []appmessage.MessageCommand{messageType})
+		if _, ok := routes[messageType]; ok {
+			continue
+		}
+		capacity := routerpkg.DefaultMaxMessages
+		if _, ok := resumableNotificationMessageTypes[messageType]; ok {
+			capacity = resumableNotificationRouteCapacity
+		}
+		route, err := router.AddIncomingRouteWithCapacity("rpc client", capacity, []appmessage.MessageCommand{messageType})
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,7 @@
+package rpctls
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("RPCS")
@@ -0,0 +1,157 @@
+// Package rpctls builds the TLS configurations of the RPC server and of
+// its clients, and generates the self-signed certificate of the server.
+package rpctls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kaspanet/kaspad/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	certificateOrganization = "kaspad autogenerated cert"
+	certificateValidity     = 10 * 365 * 24 * time.Hour
+)
+
+// ServerConfig creates the TLS configuration of the RPC server from the
+// given certificate and key files. If neither of them exists, a self-signed
+// certificate and key pair is generated into them first. If clientCAFile is
+// not empty, clients are required to present a certificate signed by one of
+// the authorities in it.
+func ServerConfig(certFile, keyFile, clientCAFile string, extraHosts []string) (*tls.Config, error) {
+	certFileExists, err := fileExists(certFile)
+	if err != nil {
+		return nil, err
+	}
+	keyFileExists, err := fileExists(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	if certFileExists != keyFileExists {
+		return nil, errors.Errorf("only one of the RPC certificate file %s and the RPC key file %s "+
+			"exists. Either supply both or remove the existing one to generate a new pair", certFile, keyFile)
+	}
+	if !certFileExists {
+		err := GenerateCertificatePair(certFile, keyFile, extraHosts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load the RPC certificate pair")
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAFile != "" {
+		clientCAs, err := loadCertificatePool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = clientCAs
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+// GenerateCertificatePair generates a self-signed certificate and key pair,
+// valid for the local addresses of the machine along with extraHosts, and
+// writes them into certFile and keyFile
+func GenerateCertificatePair(certFile, keyFile string, extraHosts []string) error {
+	log.Infof("Generating a TLS certificate pair for the RPC server")
+
+	validUntil := time.Now().Add(certificateValidity)
+	cert, key, err := util.NewTLSCertPair(certificateOrganization, validUntil, extraHosts)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range []string{certFile, keyFile} {
+		err := os.MkdirAll(filepath.Dir(file), 0700)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	err = ioutil.WriteFile(certFile, cert, 0666)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = ioutil.WriteFile(keyFile, key, 0600)
+	if err != nil {
+		os.Remove(certFile)
+		return errors.WithStack(err)
+	}
+
+	log.Infof("Wrote the RPC certificate into %s and its key into %s", certFile, keyFile)
+	return nil
+}
+
+// ClientConfig creates the TLS configuration of an RPC client. It returns nil
+// if useTLS is false, in which case the other arguments must be empty.
+//
+// The server certificate is verified against the authorities in caFile, which
+// may simply be the self-signed certificate of the server. If caFile is empty,
+// the authorities of the system are used. The client presents the certificate
+// in clientCertFile, if given, to servers that require client certificates.
+func ClientConfig(useTLS bool, caFile, clientCertFile, clientKeyFile string) (*tls.Config, error) {
+	if !useTLS {
+		if caFile != "" || clientCertFile != "" || clientKeyFile != "" {
+			return nil, errors.New("RPC certificates can only be used when TLS is enabled")
+		}
+		return nil, nil
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		rootCAs, err := loadCertificatePool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = rootCAs
+	}
+
+	if (clientCertFile == "") != (clientKeyFile == "") {
+		return nil, errors.New("both an RPC client certificate and an RPC client key are required")
+	}
+	if clientCertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not load the RPC client certificate pair")
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+	return config, nil
+}
+
+func loadCertificatePool(file string) (*x509.CertPool, error) {
+	pemCertificates, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemCertificates) {
+		return nil, errors.Errorf("no PEM certificates were found in %s", file)
+	}
+	return pool, nil
+}
+
+func fileExists(file string) (bool, error) {
+	_, err := os.Stat(file)
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, errors.WithStack(err)
+}
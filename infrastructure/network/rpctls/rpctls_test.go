@@ -0,0 +1,160 @@
+package rpctls
+
+import (
+	"crypto/tls"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestServerConfigGeneratesCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "rpc.cert")
+	keyFile := filepath.Join(dir, "rpc.key")
+
+	_, err := ServerConfig(certFile, keyFile, "", nil)
+	if err != nil {
+		t.Fatalf("ServerConfig: %+v", err)
+	}
+	generatedCert, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		t.Fatalf("ReadFile: %+v", err)
+	}
+
+	// The existing pair is expected to be reused
+	_, err = ServerConfig(certFile, keyFile, "", nil)
+	if err != nil {
+		t.Fatalf("ServerConfig: %+v", err)
+	}
+	reusedCert, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		t.Fatalf("ReadFile: %+v", err)
+	}
+	if string(generatedCert) != string(reusedCert) {
+		t.Fatalf("The existing certificate was unexpectedly replaced")
+	}
+
+	err = os.Remove(keyFile)
+	if err != nil {
+		t.Fatalf("Remove: %+v", err)
+	}
+	_, err = ServerConfig(certFile, keyFile, "", nil)
+	if err == nil || !strings.Contains(err.Error(), "only one of") {
+		t.Fatalf("Unexpected error: %+v", err)
+	}
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	serverCertFile := filepath.Join(dir, "rpc.cert")
+	serverKeyFile := filepath.Join(dir, "rpc.key")
+	clientCertFile := filepath.Join(dir, "client.cert")
+	clientKeyFile := filepath.Join(dir, "client.key")
+
+	err := GenerateCertificatePair(clientCertFile, clientKeyFile, nil)
+	if err != nil {
+		t.Fatalf("GenerateCertificatePair: %+v", err)
+	}
+	serverConfig, err := ServerConfig(serverCertFile, serverKeyFile, clientCertFile, nil)
+	if err != nil {
+		t.Fatalf("ServerConfig: %+v", err)
+	}
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatalf("Listen: %+v", err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			connection, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			// Complete the handshake so that the client learns whether it succeeded
+			_ = connection.(*tls.Conn).Handshake()
+			connection.Close()
+		}
+	}()
+
+	tests := []struct {
+		name                          string
+		caFile                        string
+		clientCertFile, clientKeyFile string
+		expectedError                 string
+	}{
+		{
+			name:           "trusted server with a client certificate",
+			caFile:         serverCertFile,
+			clientCertFile: clientCertFile,
+			clientKeyFile:  clientKeyFile,
+		},
+		{
+			name:          "trusted server without a client certificate",
+			caFile:        serverCertFile,
+			expectedError: "certificate",
+		},
+		{
+			name:           "untrusted server",
+			caFile:         clientCertFile,
+			clientCertFile: clientCertFile,
+			clientKeyFile:  clientKeyFile,
+			expectedError:  "certificate",
+		},
+	}
+	for _, test := range tests {
+		clientConfig, err := ClientConfig(true, test.caFile, test.clientCertFile, test.clientKeyFile)
+		if err != nil {
+			t.Fatalf("%s: ClientConfig: %+v", test.name, err)
+		}
+		clientConfig.ServerName = "localhost"
+
+		err = func() error {
+			connection, err := tls.Dial("tcp", listener.Addr().String(), clientConfig)
+			if err != nil {
+				return err
+			}
+			defer connection.Close()
+
+			// With TLS 1.3 the client may finish its handshake before the
+			// server verifies its certificate, so a read is required for the
+			// server's verdict to arrive
+			_, err = connection.Read(make([]byte, 1))
+			if err != nil && !errors.Is(err, io.EOF) {
+				return err
+			}
+			return nil
+		}()
+		if test.expectedError == "" {
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %+v", test.name, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), test.expectedError) {
+			t.Fatalf("%s: expected error containing %q but got: %+v", test.name, test.expectedError, err)
+		}
+	}
+}
+
+func TestClientConfig(t *testing.T) {
+	config, err := ClientConfig(false, "", "", "")
+	if err != nil {
+		t.Fatalf("ClientConfig: %+v", err)
+	}
+	if config != nil {
+		t.Fatalf("Expected no TLS configuration when TLS is disabled")
+	}
+
+	_, err = ClientConfig(false, "rpc.cert", "", "")
+	if err == nil {
+		t.Fatalf("Expected an error when passing a certificate without enabling TLS")
+	}
+
+	_, err = ClientConfig(true, "", "client.cert", "")
+	if err == nil {
+		t.Fatalf("Expected an error when passing a client certificate without its key")
+	}
+}
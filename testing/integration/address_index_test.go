@@ -0,0 +1,94 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+)
+
+func TestAddressIndex(t *testing.T) {
+	// Setup a single kaspad instance
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		utxoIndex:               true,
+		addressIndex:            true,
+	}
+	kaspad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	// skip the first block because it's paying to genesis script,
+	// which contains no outputs
+	mineNextBlock(t, kaspad)
+
+	// Mine enough blocks for the coinbase outputs to mature
+	const blockAmountToMine = 100
+	for i := 0; i < blockAmountToMine; i++ {
+		mineNextBlock(t, kaspad)
+	}
+
+	utxosByAddressesResponse, err := kaspad.rpcClient.GetUTXOsByAddresses([]string{miningAddress1})
+	if err != nil {
+		t.Fatalf("Failed to get UTXOs: %s", err)
+	}
+
+	// Submit a transaction that both spends from and pays to miningAddress1,
+	// then mine a block to include it and another one to accept it
+	rpcTransaction := buildTransactionForUTXOIndexTest(t, utxosByAddressesResponse.Entries[0])
+	submitTransactionResponse, err := kaspad.rpcClient.SubmitTransaction(rpcTransaction, false)
+	if err != nil {
+		t.Fatalf("Error submitting transaction: %s", err)
+	}
+	transactionID := submitTransactionResponse.TransactionID
+	mineNextBlock(t, kaspad)
+	mineNextBlock(t, kaspad)
+
+	// Page through all the transactions of miningAddress1
+	const pageSize = 10
+	var transactions []*appmessage.AddressTransaction
+	startDAAScore := uint64(0)
+	startTransactionID := ""
+	for {
+		response, err := kaspad.rpcClient.GetTransactionsByAddress(miningAddress1, startDAAScore, startTransactionID, pageSize)
+		if err != nil {
+			t.Fatalf("Error getting transactions by address: %s", err)
+		}
+		if len(response.Transactions) > pageSize {
+			t.Fatalf("Got %d transactions while the limit is %d", len(response.Transactions), pageSize)
+		}
+		transactions = append(transactions, response.Transactions...)
+		if !response.HasMore {
+			break
+		}
+		lastTransaction := response.Transactions[len(response.Transactions)-1]
+		startDAAScore = lastTransaction.AcceptingDAAScore
+		startTransactionID = lastTransaction.TransactionID
+	}
+
+	if len(transactions) <= pageSize {
+		t.Fatalf("Expected more than a single page of transactions, got %d", len(transactions))
+	}
+
+	foundTransactionAmount := 0
+	for i, transaction := range transactions {
+		if transaction.TransactionID == transactionID {
+			foundTransactionAmount++
+		}
+		if i == 0 {
+			continue
+		}
+		previous := transactions[i-1]
+		if previous.AcceptingDAAScore > transaction.AcceptingDAAScore ||
+			(previous.AcceptingDAAScore == transaction.AcceptingDAAScore &&
+				previous.TransactionID >= transaction.TransactionID) {
+
+			t.Fatalf("Transactions are out of order: %+v came before %+v", previous, transaction)
+		}
+	}
+	if foundTransactionAmount != 1 {
+		t.Fatalf("Expected transaction %s to appear once in the address transactions, but it appeared %d times",
+			transactionID, foundTransactionAmount)
+	}
+}
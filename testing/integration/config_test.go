@@ -2,11 +2,13 @@ package integration
 
 import (
 	"io/ioutil"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/kaspanet/kaspad/domain/dagconfig"
 	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
 )
 
 const (
@@ -22,6 +24,11 @@ const (
 	rpcAddress4 = "127.0.0.1:12348"
 	rpcAddress5 = "127.0.0.1:12349"
 
+	metricsAddress1 = "127.0.0.1:12350"
+	jsonRPCAddress1 = "127.0.0.1:12351"
+
+	jsonRPCAllowedOrigin = "http://localhost"
+
 	miningAddress1           = "kaspasim:qqqqnc0pxg7qw3qkc7l6sge8kfhsvvyt7mkw8uamtndqup27ftnd6c769gn66"
 	miningAddress1PrivateKey = "0d81045b0deb2af36a25403c2154c87aa82d89dd337b575bae27ce7f5de53cee"
 
@@ -40,6 +47,26 @@ func setConfig(t *testing.T, harness *appHarness, protocolVersion uint32) {
 	harness.config.Listeners = []string{harness.p2pAddress}
 	harness.config.RPCListeners = []string{harness.rpcAddress}
 	harness.config.UTXOIndex = harness.utxoIndex
+	harness.config.TXIndex = harness.txIndex
+	harness.config.AddressIndex = harness.addressIndex
+	harness.config.NotificationJournalSize = harness.notificationJournalSize
+	harness.config.MetricsListen = harness.metricsListen
+	if harness.jsonRPCListen != "" {
+		harness.config.JSONRPCListeners = []string{harness.jsonRPCListen}
+		harness.config.JSONRPCAllowedOrigins = []string{jsonRPCAllowedOrigin}
+	}
+	if len(harness.rpcUsers) > 0 {
+		var err error
+		harness.config.RPCAuthenticator, err = rpcauth.NewAuthenticator(harness.rpcUsers, nil)
+		if err != nil {
+			t.Fatalf("NewAuthenticator: %s", err)
+		}
+	}
+	if harness.rpcTLS {
+		harness.config.RPCTLS = true
+		harness.config.RPCCert = filepath.Join(harness.config.AppDir, "rpc.cert")
+		harness.config.RPCKey = filepath.Join(harness.config.AppDir, "rpc.key")
+	}
 	harness.config.AllowSubmitBlockWhenNotSynced = true
 	if protocolVersion != 0 {
 		harness.config.ProtocolVersion = protocolVersion
@@ -58,6 +85,7 @@ func commonConfig() *config.Config {
 	commonConfig.TargetOutboundPeers = 0
 	commonConfig.DisableDNSSeed = true
 	commonConfig.Simnet = true
+	commonConfig.DbType = config.DbTypeMemory
 
 	return commonConfig
 }
@@ -0,0 +1,137 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+)
+
+func TestResolveFinalityConflict(t *testing.T) {
+	overrideDAGParams := dagconfig.SimnetParams
+
+	// Set a finality depth of 10 blocks, so that conflicting chains
+	// violate each other's finality after a few blocks
+	overrideDAGParams.FinalityDuration = 10 * overrideDAGParams.TargetTimePerBlock
+	finalityDepth := int(overrideDAGParams.FinalityDepth())
+
+	harnesses, teardown := setupHarnesses(t, []*harnessParams{
+		{
+			p2pAddress:              p2pAddress1,
+			rpcAddress:              rpcAddress1,
+			miningAddress:           miningAddress1,
+			miningAddressPrivateKey: miningAddress1PrivateKey,
+			overrideDAGParams:       &overrideDAGParams,
+		},
+		{
+			p2pAddress:              p2pAddress2,
+			rpcAddress:              rpcAddress2,
+			miningAddress:           miningAddress3,
+			miningAddressPrivateKey: miningAddress3PrivateKey,
+			overrideDAGParams:       &overrideDAGParams,
+		},
+	})
+	defer teardown()
+
+	kaspad, otherKaspad := harnesses[0], harnesses[1]
+
+	// The two nodes aren't connected, so each of them mines a chain of its own.
+	// The chain of otherKaspad is the heavier one
+	var chain []*externalapi.DomainBlock
+	for i := 0; i < 2*finalityDepth; i++ {
+		chain = append(chain, mineNextBlock(t, kaspad))
+	}
+	var conflictingChain []*externalapi.DomainBlock
+	for i := 0; i < 2*finalityDepth+5; i++ {
+		conflictingChain = append(conflictingChain, mineNextBlock(t, otherKaspad))
+	}
+	chainTipHash := consensushashing.BlockHash(chain[len(chain)-1]).String()
+	conflictingChainTipHash := consensushashing.BlockHash(conflictingChain[len(conflictingChain)-1]).String()
+
+	finalityConflictChan := make(chan *appmessage.FinalityConflictNotificationMessage, len(conflictingChain))
+	finalityConflictResolvedChan := make(chan *appmessage.FinalityConflictResolvedNotificationMessage, 1)
+	err := kaspad.rpcClient.RegisterForFinalityConflictsNotifications(
+		func(notification *appmessage.FinalityConflictNotificationMessage) {
+			finalityConflictChan <- notification
+		},
+		func(notification *appmessage.FinalityConflictResolvedNotificationMessage) {
+			finalityConflictResolvedChan <- notification
+		})
+	if err != nil {
+		t.Fatalf("Failed to register for finality conflicts notifications: %s", err)
+	}
+
+	conflictingChainHashes := make(map[string]struct{}, len(conflictingChain))
+	for _, block := range conflictingChain {
+		conflictingChainHashes[consensushashing.BlockHash(block).String()] = struct{}{}
+		_, err := kaspad.rpcClient.SubmitBlockAlsoIfNonDAA(block)
+		if err != nil {
+			t.Fatalf("Error submitting a block of the conflicting chain: %s", err)
+		}
+	}
+
+	const notificationTimeout = 10 * time.Second
+	finalityBlockHash := consensushashing.BlockHash(conflictingChain[0]).String()
+	select {
+	case notification := <-finalityConflictChan:
+		if _, ok := conflictingChainHashes[notification.ViolatingBlockHash]; !ok {
+			t.Fatalf("Expected the violating block %s to be in the conflicting chain", notification.ViolatingBlockHash)
+		}
+		if notification.FinalityBlockHash != finalityBlockHash {
+			t.Fatalf("Unexpected finality block in the finality conflict notification. Want: %s, got: %s",
+				finalityBlockHash, notification.FinalityBlockHash)
+		}
+	case <-time.After(notificationTimeout):
+		t.Fatalf("Timed out waiting for a finality conflict notification")
+	}
+
+	// The rest of the conflicting chain is a part of the same conflict, so it isn't reported again
+	select {
+	case notification := <-finalityConflictChan:
+		t.Fatalf("Unexpected finality conflict notification for block %s", notification.ViolatingBlockHash)
+	case <-time.After(time.Second):
+	}
+
+	expectSelectedTip := func(expectedSelectedTipHash string) {
+		getSelectedTipHashResponse, err := kaspad.rpcClient.GetSelectedTipHash()
+		if err != nil {
+			t.Fatalf("Error getting the selected tip hash: %s", err)
+		}
+		if getSelectedTipHashResponse.SelectedTipHash != expectedSelectedTipHash {
+			t.Fatalf("Unexpected selected tip. Want: %s, got: %s",
+				expectedSelectedTipHash, getSelectedTipHashResponse.SelectedTipHash)
+		}
+	}
+
+	// The conflicting chain is heavier, but kaspad is expected to stay on its own chain
+	expectSelectedTip(chainTipHash)
+
+	_, err = kaspad.rpcClient.ResolveFinalityConflict(finalityBlockHash)
+	if err != nil {
+		t.Fatalf("Error resolving the finality conflict: %s", err)
+	}
+	select {
+	case notification := <-finalityConflictResolvedChan:
+		if notification.FinalityBlockHash != finalityBlockHash {
+			t.Fatalf("Unexpected finality block in the finality conflict resolved notification. Want: %s, got: %s",
+				finalityBlockHash, notification.FinalityBlockHash)
+		}
+	case <-time.After(notificationTimeout):
+		t.Fatalf("Timed out waiting for a finality conflict resolved notification")
+	}
+	expectSelectedTip(conflictingChainTipHash)
+
+	// The original chain is now the lighter side of the conflict, so kaspad can't switch back to it
+	_, err = kaspad.rpcClient.ResolveFinalityConflict(consensushashing.BlockHash(chain[finalityDepth]).String())
+	if err == nil {
+		t.Fatalf("Expected an error when resolving the finality conflict in favor of the lighter chain")
+	}
+	expectSelectedTip(conflictingChainTipHash)
+
+	// New blocks are expected to be mined on top of the conflicting chain
+	block := mineNextBlock(t, kaspad)
+	expectSelectedTip(consensushashing.BlockHash(block).String())
+}
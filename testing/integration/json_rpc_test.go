@@ -0,0 +1,133 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+type jsonRPCTestResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Result  json.RawMessage `json:"result"`
+	Params  json.RawMessage `json:"params"`
+	Error   *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func TestJSONRPC(t *testing.T) {
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		jsonRPCListen:           jsonRPCAddress1,
+	}
+	kaspad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	mineNextBlock(t, kaspad)
+
+	var response jsonRPCTestResponse
+	postJSONRPC(t, `{"jsonrpc":"2.0","id":1,"method":"getBlockCount"}`, &response)
+	if response.Error != nil {
+		t.Fatalf("getBlockCount returned an error: %s", response.Error.Message)
+	}
+	var blockCount struct {
+		BlockCount string `json:"blockCount"`
+	}
+	err := json.Unmarshal(response.Result, &blockCount)
+	if err != nil {
+		t.Fatalf("Error unmarshalling getBlockCount result: %s", err)
+	}
+	if blockCount.BlockCount != "2" {
+		t.Fatalf("Unexpected block count %s", blockCount.BlockCount)
+	}
+
+	var batchResponses []jsonRPCTestResponse
+	postJSONRPC(t, `[{"jsonrpc":"2.0","id":1,"method":"getInfo"},{"jsonrpc":"2.0","id":2,"method":"getNothing"},`+
+		`{"jsonrpc":"2.0","method":"getInfo"}]`, &batchResponses)
+	if len(batchResponses) != 2 {
+		t.Fatalf("Expected 2 batch responses but got %d", len(batchResponses))
+	}
+	if string(batchResponses[0].ID) != "1" || batchResponses[0].Error != nil {
+		t.Fatalf("Unexpected getInfo response: %+v", batchResponses[0])
+	}
+	if string(batchResponses[1].ID) != "2" || batchResponses[1].Error == nil || batchResponses[1].Error.Code != -32601 {
+		t.Fatalf("Unexpected getNothing response: %+v", batchResponses[1])
+	}
+
+	response = jsonRPCTestResponse{}
+	postJSONRPC(t, `{"jsonrpc":"2.0","id":1,"method":"notifyBlockAdded"}`, &response)
+	if response.Error == nil {
+		t.Fatalf("Subscribing to notifications over HTTP unexpectedly succeeded")
+	}
+
+	_, err = websocket.Dial("ws://"+jsonRPCAddress1, "", "http://example.com")
+	if err == nil {
+		t.Fatalf("WebSocket connection from a disallowed origin unexpectedly succeeded")
+	}
+	webSocket, err := websocket.Dial("ws://"+jsonRPCAddress1, "", jsonRPCAllowedOrigin)
+	if err != nil {
+		t.Fatalf("Error connecting over WebSocket: %s", err)
+	}
+	defer webSocket.Close()
+
+	err = websocket.Message.Send(webSocket, `{"jsonrpc":"2.0","id":"subscribe","method":"notifyBlockAdded"}`)
+	if err != nil {
+		t.Fatalf("Error sending notifyBlockAdded: %s", err)
+	}
+	response = receiveJSONRPC(t, webSocket)
+	if string(response.ID) != `"subscribe"` || response.Error != nil {
+		t.Fatalf("Unexpected notifyBlockAdded response: %+v", response)
+	}
+
+	mineNextBlock(t, kaspad)
+
+	response = receiveJSONRPC(t, webSocket)
+	if response.Method != "blockAddedNotification" || len(response.Params) == 0 {
+		t.Fatalf("Unexpected notification: %+v", response)
+	}
+}
+
+func postJSONRPC(t *testing.T, request string, response interface{}) {
+	httpResponse, err := http.Post("http://"+jsonRPCAddress1, "application/json", bytes.NewBufferString(request))
+	if err != nil {
+		t.Fatalf("Error posting JSON-RPC request: %s", err)
+	}
+	defer httpResponse.Body.Close()
+	body, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		t.Fatalf("Error reading JSON-RPC response: %s", err)
+	}
+	err = json.Unmarshal(body, response)
+	if err != nil {
+		t.Fatalf("Error unmarshalling JSON-RPC response %s: %s", body, err)
+	}
+}
+
+func receiveJSONRPC(t *testing.T, webSocket *websocket.Conn) jsonRPCTestResponse {
+	err := webSocket.SetReadDeadline(time.Now().Add(defaultTimeout))
+	if err != nil {
+		t.Fatalf("Error setting read deadline: %s", err)
+	}
+	var data []byte
+	err = websocket.Message.Receive(webSocket, &data)
+	if err != nil {
+		t.Fatalf("Error receiving JSON-RPC message: %s", err)
+	}
+	var response jsonRPCTestResponse
+	err = json.Unmarshal(data, &response)
+	if err != nil {
+		t.Fatalf("Error unmarshalling JSON-RPC message %s: %s", data, err)
+	}
+	return response
+}
@@ -0,0 +1,53 @@
+package integration
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		metricsListen:           metricsAddress1,
+	}
+	kaspad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	mineNextBlock(t, kaspad)
+
+	response, err := http.Get("http://" + metricsAddress1 + "/metrics")
+	if err != nil {
+		t.Fatalf("Error getting metrics: %s", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("Unexpected status code %d", response.StatusCode)
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("Error reading metrics: %s", err)
+	}
+
+	// Metrics such as the RPC request durations are process-wide, and therefore
+	// also count the requests made by other tests, so only their presence is checked
+	expectedPrefixes := []string{
+		"# TYPE kaspad_block_processing_duration_seconds histogram\n",
+		`kaspad_rpc_request_duration_seconds_count{method="GetBlockTemplateRequest"} `,
+		`kaspad_rpc_request_duration_seconds_count{method="SubmitBlockRequest"} `,
+		"# TYPE kaspad_utxo_cache_hits_total counter\n",
+		"kaspad_mempool_transactions 0\n",
+		"kaspad_peers_inbound 0\n",
+		"kaspad_ibd_running 0\n",
+	}
+	metrics := string(body)
+	for _, expectedPrefix := range expectedPrefixes {
+		if !strings.Contains(metrics, "\n"+expectedPrefix) {
+			t.Fatalf("Metrics don't contain a line starting with %s:\n%s", expectedPrefix, metrics)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+)
+
+func TestResumeNotifications(t *testing.T) {
+	// Setup a single kaspad instance
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		utxoIndex:               true,
+		notificationJournalSize: 1000,
+	}
+	kaspad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	const notificationTimeout = 10 * time.Second
+	receiveChainChanged := func(channel chan *appmessage.VirtualSelectedParentChainChangedNotificationMessage) *appmessage.VirtualSelectedParentChainChangedNotificationMessage {
+		select {
+		case notification := <-channel:
+			return notification
+		case <-time.After(notificationTimeout):
+			t.Fatalf("Timed out waiting for a chain changed notification")
+			return nil
+		}
+	}
+
+	// The harness's client stays connected throughout the test, and is used as a
+	// reference for the notifications the resuming clients are expected to get
+	referenceChainChangedChan := make(chan *appmessage.VirtualSelectedParentChainChangedNotificationMessage, 100)
+	err := kaspad.rpcClient.RegisterForVirtualSelectedParentChainChangedNotifications(false,
+		func(notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage) {
+			referenceChainChangedChan <- notification
+		})
+	if err != nil {
+		t.Fatalf("Failed to register for chain changed notifications: %s", err)
+	}
+	referenceUTXOsChangedChan := make(chan *appmessage.UTXOsChangedNotificationMessage, 100)
+	err = kaspad.rpcClient.RegisterForUTXOsChangedNotifications([]string{miningAddress1},
+		func(notification *appmessage.UTXOsChangedNotificationMessage) {
+			referenceUTXOsChangedChan <- notification
+		})
+	if err != nil {
+		t.Fatalf("Failed to register for UTXOs changed notifications: %s", err)
+	}
+
+	disconnectingClient, err := newTestRPCClient(kaspad.rpcAddress)
+	if err != nil {
+		t.Fatalf("Error getting RPC client: %s", err)
+	}
+	disconnectingClientChainChangedChan := make(chan *appmessage.VirtualSelectedParentChainChangedNotificationMessage, 100)
+	err = disconnectingClient.RegisterForVirtualSelectedParentChainChangedNotifications(false,
+		func(notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage) {
+			disconnectingClientChainChangedChan <- notification
+		})
+	if err != nil {
+		t.Fatalf("Failed to register for chain changed notifications: %s", err)
+	}
+
+	const blockAmountToMine = 5
+	var lastSequence uint64
+	var lastChainBlockHash string
+	for i := 0; i < blockAmountToMine; i++ {
+		block := mineNextBlock(t, kaspad)
+		notification := receiveChainChanged(disconnectingClientChainChangedChan)
+		if notification.Sequence <= lastSequence {
+			t.Fatalf("Expected sequence %d to be larger than the previous sequence %d",
+				notification.Sequence, lastSequence)
+		}
+		lastSequence = notification.Sequence
+		lastChainBlockHash = consensushashing.BlockHash(block).String()
+	}
+	err = disconnectingClient.Close()
+	if err != nil {
+		t.Fatalf("Error closing the RPC client: %s", err)
+	}
+
+	// Mine blocks whose notifications the disconnected client misses
+	var missedChainBlockHashes []string
+	for i := 0; i < blockAmountToMine; i++ {
+		block := mineNextBlock(t, kaspad)
+		missedChainBlockHashes = append(missedChainBlockHashes, consensushashing.BlockHash(block).String())
+	}
+
+	var expectedChainChangedNotifications []*appmessage.VirtualSelectedParentChainChangedNotificationMessage
+	for len(expectedChainChangedNotifications) < blockAmountToMine {
+		notification := receiveChainChanged(referenceChainChangedChan)
+		if notification.Sequence > lastSequence {
+			expectedChainChangedNotifications = append(expectedChainChangedNotifications, notification)
+		}
+	}
+	// UTXOs changed notifications are sent before the chain changed notifications of the
+	// same virtual change, so all the relevant ones are expected to have arrived by now
+	var expectedUTXOsChangedNotifications []*appmessage.UTXOsChangedNotificationMessage
+	for {
+		select {
+		case notification := <-referenceUTXOsChangedChan:
+			if notification.Sequence > lastSequence {
+				expectedUTXOsChangedNotifications = append(expectedUTXOsChangedNotifications, notification)
+			}
+			continue
+		case <-time.After(time.Second):
+		}
+		break
+	}
+	if len(expectedUTXOsChangedNotifications) == 0 {
+		t.Fatalf("Expected some UTXOs changed notifications after sequence %d", lastSequence)
+	}
+
+	// Resume the chain changed notifications by sequence
+	resumedClient, err := newTestRPCClient(kaspad.rpcAddress)
+	if err != nil {
+		t.Fatalf("Error getting RPC client: %s", err)
+	}
+	defer resumedClient.Close()
+	resumedChainChangedChan := make(chan *appmessage.VirtualSelectedParentChainChangedNotificationMessage, 100)
+	err = resumedClient.ResumeVirtualSelectedParentChainChangedNotifications(false, lastSequence, "",
+		func(notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage) {
+			resumedChainChangedChan <- notification
+		})
+	if err != nil {
+		t.Fatalf("Failed to resume chain changed notifications: %s", err)
+	}
+	for i, expectedNotification := range expectedChainChangedNotifications {
+		notification := receiveChainChanged(resumedChainChangedChan)
+		if notification.Sequence != expectedNotification.Sequence {
+			t.Fatalf("Unexpected sequence of replayed notification %d. Want: %d, got: %d",
+				i, expectedNotification.Sequence, notification.Sequence)
+		}
+		if len(notification.AddedChainBlockHashes) != 1 || notification.AddedChainBlockHashes[0] != missedChainBlockHashes[i] {
+			t.Fatalf("Unexpected added chain blocks in replayed notification %d. Want: [%s], got: %s",
+				i, missedChainBlockHashes[i], notification.AddedChainBlockHashes)
+		}
+		if len(notification.AcceptedTransactionIDs) != 0 {
+			t.Fatalf("Replayed notification %d unexpectedly includes accepted transaction IDs", i)
+		}
+	}
+
+	// Resume the UTXOs changed notifications by chain block hash
+	resumedUTXOsChangedChan := make(chan *appmessage.UTXOsChangedNotificationMessage, 100)
+	err = resumedClient.ResumeUTXOsChangedNotifications([]string{miningAddress1}, 0, lastChainBlockHash,
+		func(notification *appmessage.UTXOsChangedNotificationMessage) {
+			resumedUTXOsChangedChan <- notification
+		})
+	if err != nil {
+		t.Fatalf("Failed to resume UTXOs changed notifications: %s", err)
+	}
+	for i, expectedNotification := range expectedUTXOsChangedNotifications {
+		var notification *appmessage.UTXOsChangedNotificationMessage
+		select {
+		case notification = <-resumedUTXOsChangedChan:
+		case <-time.After(notificationTimeout):
+			t.Fatalf("Timed out waiting for replayed UTXOs changed notification %d", i)
+		}
+		if notification.Sequence != expectedNotification.Sequence {
+			t.Fatalf("Unexpected sequence of replayed UTXOs changed notification %d. Want: %d, got: %d",
+				i, expectedNotification.Sequence, notification.Sequence)
+		}
+		if len(notification.Added) != len(expectedNotification.Added) ||
+			len(notification.Removed) != len(expectedNotification.Removed) {
+
+			t.Fatalf("Unexpected replayed UTXOs changed notification %d. Want: %+v, got: %+v",
+				i, expectedNotification, notification)
+		}
+		// The entries of a notification aren't ordered
+		expectedAddedOutpoints := make(map[appmessage.RPCOutpoint]struct{}, len(expectedNotification.Added))
+		for _, entry := range expectedNotification.Added {
+			expectedAddedOutpoints[*entry.Outpoint] = struct{}{}
+		}
+		for _, entry := range notification.Added {
+			if _, ok := expectedAddedOutpoints[*entry.Outpoint]; !ok || entry.Address != miningAddress1 {
+				t.Fatalf("Unexpected added UTXO %+v in replayed UTXOs changed notification %d", entry, i)
+			}
+		}
+	}
+
+	// New notifications are expected to follow the replayed ones
+	block := mineNextBlock(t, kaspad)
+	notification := receiveChainChanged(resumedChainChangedChan)
+	if notification.AddedChainBlockHashes[0] != consensushashing.BlockHash(block).String() {
+		t.Fatalf("Unexpected added chain block after the replayed notifications. Want: %s, got: %s",
+			consensushashing.BlockHash(block), notification.AddedChainBlockHashes[0])
+	}
+	if notification.Sequence <= expectedChainChangedNotifications[len(expectedChainChangedNotifications)-1].Sequence {
+		t.Fatalf("Expected the sequence of a new notification to be larger than the replayed ones, got %d",
+			notification.Sequence)
+	}
+
+	// Resuming after a sequence that was never sent is expected to fail
+	failingClient, err := newTestRPCClient(kaspad.rpcAddress)
+	if err != nil {
+		t.Fatalf("Error getting RPC client: %s", err)
+	}
+	defer failingClient.Close()
+	err = failingClient.ResumeVirtualSelectedParentChainChangedNotifications(false, notification.Sequence+1000, "",
+		func(*appmessage.VirtualSelectedParentChainChangedNotificationMessage) {})
+	if err == nil {
+		t.Fatalf("Expected an error when resuming after a sequence that was never sent")
+	}
+}
@@ -0,0 +1,83 @@
+package integration
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcauth"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient"
+)
+
+func TestRPCAuthentication(t *testing.T) {
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		jsonRPCListen:           jsonRPCAddress1,
+		rpcUsers:                []string{"admin:adminpass:admin", "miner:minerpass:mining"},
+		rpcAuthorization:        rpcauth.BasicAuthorization("admin", "adminpass"),
+	}
+	kaspad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	// The harness itself connects as an admin
+	mineNextBlock(t, kaspad)
+
+	minerClient, err := newTestRPCClientWithAuthorization(rpcAddress1, rpcauth.BasicAuthorization("miner", "minerpass"))
+	if err != nil {
+		t.Fatalf("Error connecting as a miner: %s", err)
+	}
+	defer minerClient.Close()
+
+	_, err = minerClient.GetBlockTemplate(miningAddress1, "")
+	if err != nil {
+		t.Fatalf("GetBlockTemplate: %s", err)
+	}
+	_, err = minerClient.GetBlockCount()
+	if err != nil {
+		t.Fatalf("GetBlockCount: %s", err)
+	}
+	err = minerClient.AddPeer(p2pAddress2, false)
+	if err == nil || !strings.Contains(err.Error(), "Access denied") {
+		t.Fatalf("Expected AddPeer to be denied to a miner, but got: %v", err)
+	}
+
+	_, err = rpcclient.NewRPCClientWithAuthorization(rpcAddress1, rpcauth.BasicAuthorization("miner", "wrongpass"))
+	if err == nil {
+		t.Fatalf("Expected connecting with a wrong password to fail")
+	}
+	_, err = rpcclient.NewRPCClient(rpcAddress1)
+	if err == nil {
+		t.Fatalf("Expected connecting without credentials to fail")
+	}
+
+	body := `{"jsonrpc":"2.0","id":1,"method":"getBlockCount"}`
+	request, err := http.NewRequest(http.MethodPost, "http://"+jsonRPCAddress1, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest: %s", err)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("Error posting JSON-RPC request: %s", err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Expected status code %d without credentials but got %d", http.StatusUnauthorized, response.StatusCode)
+	}
+
+	request, err = http.NewRequest(http.MethodPost, "http://"+jsonRPCAddress1, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest: %s", err)
+	}
+	request.SetBasicAuth("miner", "minerpass")
+	response, err = http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("Error posting JSON-RPC request: %s", err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status code %d with credentials but got %d", http.StatusOK, response.StatusCode)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient/grpcclient"
 )
 
 const rpcTimeout = 10 * time.Second
@@ -16,7 +17,15 @@ type testRPCClient struct {
 }
 
 func newTestRPCClient(rpcAddress string) (*testRPCClient, error) {
-	rpcClient, err := rpcclient.NewRPCClient(rpcAddress)
+	return newTestRPCClientWithAuthorization(rpcAddress, "")
+}
+
+func newTestRPCClientWithAuthorization(rpcAddress string, authorization string) (*testRPCClient, error) {
+	return newTestRPCClientWithOptions(rpcAddress, &grpcclient.ConnectOptions{Authorization: authorization})
+}
+
+func newTestRPCClientWithOptions(rpcAddress string, options *grpcclient.ConnectOptions) (*testRPCClient, error) {
+	rpcClient, err := rpcclient.NewRPCClientWithOptions(rpcAddress, options)
 	if err != nil {
 		return nil, err
 	}
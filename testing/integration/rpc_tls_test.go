@@ -0,0 +1,27 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient"
+)
+
+func TestRPCTLS(t *testing.T) {
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		rpcTLS:                  true,
+	}
+	kaspad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	// The harness itself connects over TLS, trusting the generated certificate
+	mineNextBlock(t, kaspad)
+
+	_, err := rpcclient.NewRPCClient(rpcAddress1)
+	if err == nil {
+		t.Fatalf("Expected connecting without TLS to fail")
+	}
+}
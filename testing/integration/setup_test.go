@@ -7,11 +7,14 @@ import (
 	"github.com/kaspanet/kaspad/domain/dagconfig"
 
 	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/memorydb"
 
 	"github.com/kaspanet/kaspad/infrastructure/db/database"
 
 	"github.com/kaspanet/kaspad/app"
 	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient/grpcclient"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpctls"
 )
 
 type appHarness struct {
@@ -24,6 +27,14 @@ type appHarness struct {
 	config                  *config.Config
 	database                database.Database
 	utxoIndex               bool
+	txIndex                 bool
+	addressIndex            bool
+	notificationJournalSize uint64
+	metricsListen           string
+	jsonRPCListen           string
+	rpcUsers                []string
+	rpcAuthorization        string
+	rpcTLS                  bool
 	overrideDAGParams       *dagconfig.Params
 }
 
@@ -33,6 +44,14 @@ type harnessParams struct {
 	miningAddress           string
 	miningAddressPrivateKey string
 	utxoIndex               bool
+	txIndex                 bool
+	addressIndex            bool
+	notificationJournalSize uint64
+	metricsListen           string
+	jsonRPCListen           string
+	rpcUsers                []string
+	rpcAuthorization        string
+	rpcTLS                  bool
 	overrideDAGParams       *dagconfig.Params
 	protocolVersion         uint32
 }
@@ -45,6 +64,14 @@ func setupHarness(t *testing.T, params *harnessParams) (harness *appHarness, tea
 		miningAddress:           params.miningAddress,
 		miningAddressPrivateKey: params.miningAddressPrivateKey,
 		utxoIndex:               params.utxoIndex,
+		txIndex:                 params.txIndex,
+		addressIndex:            params.addressIndex,
+		notificationJournalSize: params.notificationJournalSize,
+		metricsListen:           params.metricsListen,
+		jsonRPCListen:           params.jsonRPCListen,
+		rpcUsers:                params.rpcUsers,
+		rpcAuthorization:        params.rpcAuthorization,
+		rpcTLS:                  params.rpcTLS,
 		overrideDAGParams:       params.overrideDAGParams,
 	}
 
@@ -101,8 +128,17 @@ func standardSetup(t *testing.T) (appHarness1, appHarness2, appHarness3 *appHarn
 }
 
 func setRPCClient(t *testing.T, harness *appHarness) {
+	options := &grpcclient.ConnectOptions{Authorization: harness.rpcAuthorization}
+	if harness.rpcTLS {
+		var err error
+		options.TLSConfig, err = rpctls.ClientConfig(true, harness.config.RPCCert, "", "")
+		if err != nil {
+			t.Fatalf("Error creating the RPC TLS configuration: %+v", err)
+		}
+	}
+
 	var err error
-	harness.rpcClient, err = newTestRPCClient(harness.rpcAddress)
+	harness.rpcClient, err = newTestRPCClientWithOptions(harness.rpcAddress, options)
 	if err != nil {
 		t.Fatalf("Error getting RPC client %+v", err)
 	}
@@ -135,6 +171,9 @@ func setDatabaseContext(t *testing.T, harness *appHarness) {
 }
 
 func openDB(cfg *config.Config) (database.Database, error) {
+	if cfg.DbType == config.DbTypeMemory {
+		return memorydb.NewMemoryDB(), nil
+	}
 	dbPath := filepath.Join(cfg.AppDir, "db")
 	return ldb.NewLevelDB(dbPath, 8)
 }
@@ -0,0 +1,115 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+)
+
+func TestTXIndex(t *testing.T) {
+	// Setup a single kaspad instance
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		utxoIndex:               true,
+		txIndex:                 true,
+	}
+	kaspad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	// skip the first block because it's paying to genesis script,
+	// which contains no outputs
+	mineNextBlock(t, kaspad)
+
+	// Mine enough blocks for the coinbase outputs to mature
+	const blockAmountToMine = 100
+	for i := 0; i < blockAmountToMine; i++ {
+		mineNextBlock(t, kaspad)
+	}
+
+	utxosByAddressesResponse, err := kaspad.rpcClient.GetUTXOsByAddresses([]string{miningAddress1})
+	if err != nil {
+		t.Fatalf("Failed to get UTXOs: %s", err)
+	}
+
+	// Submit a transaction and make sure it's unknown to the TX index
+	// while it's in the mempool
+	rpcTransaction := buildTransactionForUTXOIndexTest(t, utxosByAddressesResponse.Entries[0])
+	submitTransactionResponse, err := kaspad.rpcClient.SubmitTransaction(rpcTransaction, false)
+	if err != nil {
+		t.Fatalf("Error submitting transaction: %s", err)
+	}
+	transactionID := submitTransactionResponse.TransactionID
+
+	_, err = kaspad.rpcClient.GetTransaction(transactionID)
+	if err == nil {
+		t.Fatalf("GetTransaction unexpectedly succeeded for a mempool transaction")
+	}
+
+	// Mine a block to include the transaction, and another one to accept it
+	includingBlock := mineNextBlock(t, kaspad)
+	acceptingBlock := mineNextBlock(t, kaspad)
+
+	getTransactionResponse, err := kaspad.rpcClient.GetTransaction(transactionID)
+	if err != nil {
+		t.Fatalf("Error getting transaction: %s", err)
+	}
+	if getTransactionResponse.Transaction.VerboseData.TransactionID != transactionID {
+		t.Fatalf("Unexpected transaction ID. Want: %s, got: %s",
+			transactionID, getTransactionResponse.Transaction.VerboseData.TransactionID)
+	}
+	includingBlockHash := consensushashing.BlockHash(includingBlock).String()
+	if len(getTransactionResponse.ContainingBlockHashes) != 1 ||
+		getTransactionResponse.ContainingBlockHashes[0] != includingBlockHash {
+
+		t.Fatalf("Unexpected containing blocks. Want: [%s], got: %s",
+			includingBlockHash, getTransactionResponse.ContainingBlockHashes)
+	}
+	acceptingBlockHash := consensushashing.BlockHash(acceptingBlock).String()
+	if getTransactionResponse.AcceptingBlockHash != acceptingBlockHash {
+		t.Fatalf("Unexpected accepting block. Want: %s, got: %s",
+			acceptingBlockHash, getTransactionResponse.AcceptingBlockHash)
+	}
+	if getTransactionResponse.Confirmations != 1 {
+		t.Fatalf("Unexpected amount of confirmations. Want: 1, got: %d",
+			getTransactionResponse.Confirmations)
+	}
+
+	// Mine a few more blocks and make sure the amount of confirmations grows accordingly
+	const confirmingBlockAmountToMine = 5
+	for i := 0; i < confirmingBlockAmountToMine; i++ {
+		mineNextBlock(t, kaspad)
+	}
+
+	const unknownTransactionID = "0000000000000000000000000000000000000000000000000000000000000000"
+	getTransactionAcceptanceInfoResponse, err := kaspad.rpcClient.GetTransactionAcceptanceInfo(
+		[]string{transactionID, unknownTransactionID})
+	if err != nil {
+		t.Fatalf("Error getting transaction acceptance info: %s", err)
+	}
+	if len(getTransactionAcceptanceInfoResponse.AcceptanceInfos) != 2 {
+		t.Fatalf("Unexpected amount of acceptance infos. Want: 2, got: %d",
+			len(getTransactionAcceptanceInfoResponse.AcceptanceInfos))
+	}
+
+	acceptanceInfo := getTransactionAcceptanceInfoResponse.AcceptanceInfos[0]
+	expectedAcceptanceInfo := &appmessage.TransactionAcceptanceInfo{
+		TransactionID:      transactionID,
+		IsAccepted:         true,
+		AcceptingBlockHash: acceptingBlockHash,
+		IncludingBlockHash: includingBlockHash,
+		AcceptingBlueScore: acceptanceInfo.AcceptingBlueScore,
+		Confirmations:      confirmingBlockAmountToMine + 1,
+	}
+	if *acceptanceInfo != *expectedAcceptanceInfo {
+		t.Fatalf("Unexpected acceptance info. Want: %+v, got: %+v", expectedAcceptanceInfo, acceptanceInfo)
+	}
+
+	unknownAcceptanceInfo := getTransactionAcceptanceInfoResponse.AcceptanceInfos[1]
+	if unknownAcceptanceInfo.IsAccepted {
+		t.Fatalf("Unknown transaction %s is unexpectedly accepted", unknownTransactionID)
+	}
+}
@@ -0,0 +1,130 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+)
+
+func TestUTXOIndexPaging(t *testing.T) {
+	// Setup a single kaspad instance
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		utxoIndex:               true,
+	}
+	kaspad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	// skip the first block because it's paying to genesis script,
+	// which contains no outputs
+	mineNextBlock(t, kaspad)
+
+	// Mine blocks that pay to both miningAddress1 and miningAddress3, so that
+	// pages span more than a single address
+	const blockAmountToMinePerAddress = 30
+	for _, miningAddress := range []string{miningAddress1, miningAddress3, miningAddress1} {
+		kaspad.miningAddress = miningAddress
+		for i := 0; i < blockAmountToMinePerAddress; i++ {
+			mineNextBlock(t, kaspad)
+		}
+	}
+
+	// The duplicate address is expected to be ignored
+	addresses := []string{miningAddress3, miningAddress1, miningAddress3}
+	allUTXOsResponse, err := kaspad.rpcClient.GetUTXOsByAddresses(addresses)
+	if err != nil {
+		t.Fatalf("Failed to get UTXOs: %s", err)
+	}
+	if allUTXOsResponse.HasMore {
+		t.Fatalf("Unexpected HasMore in an unlimited response")
+	}
+	allEntries := allUTXOsResponse.Entries
+	if len(allEntries) == 0 {
+		t.Fatalf("Expected some UTXOs")
+	}
+
+	getAllPages := func(filter *appmessage.UTXOsByAddressesFilter, limit uint32) []*appmessage.UTXOsByAddressesEntry {
+		var entries []*appmessage.UTXOsByAddressesEntry
+		startAddress := ""
+		var startOutpoint *appmessage.RPCOutpoint
+		for {
+			response, err := kaspad.rpcClient.GetUTXOsByAddressesPage(addresses, filter, limit, startAddress, startOutpoint)
+			if err != nil {
+				t.Fatalf("Failed to get a page of UTXOs: %s", err)
+			}
+			if len(response.Entries) > int(limit) {
+				t.Fatalf("Got %d UTXOs while the limit is %d", len(response.Entries), limit)
+			}
+			entries = append(entries, response.Entries...)
+			if !response.HasMore {
+				return entries
+			}
+			lastEntry := response.Entries[len(response.Entries)-1]
+			startAddress = lastEntry.Address
+			startOutpoint = lastEntry.Outpoint
+		}
+	}
+
+	const pageSize = 7
+	pagedEntries := getAllPages(nil, pageSize)
+	if len(pagedEntries) != len(allEntries) {
+		t.Fatalf("Expected %d paged UTXOs, got %d", len(allEntries), len(pagedEntries))
+	}
+	for i := range pagedEntries {
+		if pagedEntries[i].Address != allEntries[i].Address || *pagedEntries[i].Outpoint != *allEntries[i].Outpoint {
+			t.Fatalf("Unexpected UTXO at position %d. Want: %+v, got: %+v", i, allEntries[i], pagedEntries[i])
+		}
+	}
+
+	getBlockDAGInfoResponse, err := kaspad.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		t.Fatalf("Failed to get block DAG info: %s", err)
+	}
+	coinbaseMaturity := kaspad.config.ActiveNetParams.BlockCoinbaseMaturity
+	expectedMatureCount := 0
+	for _, entry := range allEntries {
+		if !entry.UTXOEntry.IsCoinbase ||
+			entry.UTXOEntry.BlockDAAScore+coinbaseMaturity <= getBlockDAGInfoResponse.VirtualDAAScore {
+
+			expectedMatureCount++
+		}
+	}
+	if expectedMatureCount == 0 || expectedMatureCount == len(allEntries) {
+		t.Fatalf("Expected only some of the UTXOs to be mature, got %d out of %d",
+			expectedMatureCount, len(allEntries))
+	}
+	matureEntries := getAllPages(&appmessage.UTXOsByAddressesFilter{ExcludeImmatureCoinbase: true}, pageSize)
+	if len(matureEntries) != expectedMatureCount {
+		t.Fatalf("Expected %d mature UTXOs, got %d", expectedMatureCount, len(matureEntries))
+	}
+
+	_, err = kaspad.rpcClient.GetUTXOsByAddressesPage(addresses, nil, pageSize, miningAddress1PrivateKey, nil)
+	if err == nil {
+		t.Fatalf("Expected an error for a start address that isn't one of the requested addresses")
+	}
+
+	const chunkSize = 9
+	var streamedEntries []*appmessage.UTXOsByAddressesEntry
+	entryCount, err := kaspad.rpcClient.StreamUTXOsByAddresses(addresses, nil, chunkSize,
+		func(entries []*appmessage.UTXOsByAddressesEntry) {
+			if len(entries) > chunkSize {
+				t.Fatalf("Got a chunk of %d UTXOs while the chunk size is %d", len(entries), chunkSize)
+			}
+			streamedEntries = append(streamedEntries, entries...)
+		})
+	if err != nil {
+		t.Fatalf("Failed to stream UTXOs: %s", err)
+	}
+	if entryCount != uint64(len(allEntries)) || len(streamedEntries) != len(allEntries) {
+		t.Fatalf("Expected %d streamed UTXOs, got %d with an entry count of %d",
+			len(allEntries), len(streamedEntries), entryCount)
+	}
+	for i := range streamedEntries {
+		if streamedEntries[i].Address != allEntries[i].Address || *streamedEntries[i].Outpoint != *allEntries[i].Outpoint {
+			t.Fatalf("Unexpected streamed UTXO at position %d. Want: %+v, got: %+v", i, allEntries[i], streamedEntries[i])
+		}
+	}
+}